@@ -0,0 +1,81 @@
+package common
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type requestIDKey struct{}
+
+type headersKey struct{}
+
+// WithRequestID - returns a copy of ctx that makes the requests sent with it carry the specified
+// X-Request-Id, instead of the one generated by GetSdkHeaders, so that SDK calls can be correlated
+// with the caller's own requests.
+//
+// The context is honored by the ...WithContext methods of the service clients, such as VpcV1. The
+// request ID that was sent is available from the response, or the error, with GetRequestID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext - returns the request ID set on ctx with WithRequestID, if any.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// WithHeaders - returns a copy of ctx that adds the specified headers to the requests sent with it.
+// The headers are added to those already set on ctx, and replace any header of the same name set
+// by the SDK or by the options of the call. A request ID set with WithRequestID takes precedence
+// over an X-Request-Id header.
+//
+// The context is honored by the ...WithContext methods of the service clients, such as VpcV1.
+func WithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := make(map[string]string)
+	for name, value := range HeadersFromContext(ctx) {
+		merged[http.CanonicalHeaderKey(name)] = value
+	}
+	for name, value := range headers {
+		merged[http.CanonicalHeaderKey(name)] = value
+	}
+	return context.WithValue(ctx, headersKey{}, merged)
+}
+
+// HeadersFromContext - returns the headers set on ctx with WithHeaders, if any. The returned map
+// must not be modified.
+func HeadersFromContext(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey{}).(map[string]string)
+	return headers
+}
+
+// ApplyContextHeaders - sets the headers and the request ID carried by the context of req on req.
+// It returns req itself if the context carries none, and a copy of req otherwise.
+func ApplyContextHeaders(req *http.Request) *http.Request {
+	ctx := req.Context()
+	headers := HeadersFromContext(ctx)
+	requestID, hasRequestID := RequestIDFromContext(ctx)
+	if len(headers) == 0 && !hasRequestID {
+		return req
+	}
+
+	req = req.Clone(ctx)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	if hasRequestID {
+		req.Header.Set(X_REQUEST_ID, requestID)
+	}
+	return req
+}
+
+// GetRequestID - returns the X-Request-Id of the request that produced response, as echoed by
+// the service, or "" if response is nil.
+func GetRequestID(response *core.DetailedResponse) string {
+	if response == nil {
+		return ""
+	}
+	return response.GetHeaders().Get(X_REQUEST_ID)
+}
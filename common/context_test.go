@@ -0,0 +1,44 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextHeaders(t *testing.T) {
+	ctx := context.Background()
+	_, found := RequestIDFromContext(ctx)
+	assert.False(t, found)
+	assert.Nil(t, HeadersFromContext(ctx))
+
+	ctx = WithHeaders(ctx, map[string]string{"x-tenant": "a", "X-Request-Id": "from-headers"})
+	ctx = WithHeaders(ctx, map[string]string{"X-Tenant": "b", "X-Feature": "on"})
+	assert.Equal(t, map[string]string{"X-Tenant": "b", "X-Feature": "on", "X-Request-Id": "from-headers"}, HeadersFromContext(ctx))
+
+	ctx = WithRequestID(ctx, "my-request-id")
+	requestID, found := RequestIDFromContext(ctx)
+	assert.True(t, found)
+	assert.Equal(t, "my-request-id", requestID)
+}
+
+func TestApplyContextHeaders(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "https://us-south.iaas.cloud.ibm.com/v1/vpcs", nil)
+	req.Header.Set(X_REQUEST_ID, "generated")
+	assert.Same(t, req, ApplyContextHeaders(req))
+
+	ctx := WithRequestID(WithHeaders(context.Background(), map[string]string{"X-Tenant": "a"}), "mine")
+	applied := ApplyContextHeaders(req.WithContext(ctx))
+	assert.Equal(t, "mine", applied.Header.Get(X_REQUEST_ID))
+	assert.Equal(t, "a", applied.Header.Get("X-Tenant"))
+	assert.Equal(t, "generated", req.Header.Get(X_REQUEST_ID))
+}
+
+func TestGetRequestID(t *testing.T) {
+	assert.Equal(t, "", GetRequestID(nil))
+	response := &core.DetailedResponse{Headers: http.Header{X_REQUEST_ID: []string{"abc"}}}
+	assert.Equal(t, "abc", GetRequestID(response))
+}
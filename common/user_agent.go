@@ -0,0 +1,51 @@
+package common
+
+import (
+	"strings"
+)
+
+// BuildUserAgent - returns the User-Agent of an SDK client used by the application "name" at
+// "version", followed by any additional product tokens (for example "terraform/1.9.0").
+// The application is identified as "name/version", or "name" if version is empty. Characters that
+// are not allowed in product tokens are replaced with "-".
+func BuildUserAgent(name string, version string, products ...string) string {
+	userAgent := GetUserAgentInfo()
+	if name == "" {
+		return userAgent
+	}
+
+	application := productToken(name)
+	if version != "" {
+		application += "/" + productToken(version)
+	}
+	tokens := []string{userAgent, application}
+	for _, product := range products {
+		if product != "" {
+			tokens = append(tokens, productToken(product))
+		}
+	}
+	return strings.Join(tokens, " ")
+}
+
+// productToken returns "s" with the characters that are not allowed in a User-Agent product token
+// (RFC 9110) replaced with "-", except the "/" separating the product from its version.
+func productToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || isTokenChar(r) {
+			return r
+		}
+		return '-'
+	}, s)
+}
+
+// isTokenChar returns true if "r" is allowed in an HTTP token.
+func isTokenChar(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r > 0x7e || r < 0x21:
+		return false
+	default:
+		return strings.ContainsRune("!#$%&'*+-.^_`|~", r)
+	}
+}
@@ -0,0 +1,16 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildUserAgent(t *testing.T) {
+	assert.Equal(t, GetUserAgentInfo(), BuildUserAgent("", "1.0.0"))
+	assert.Equal(t, GetUserAgentInfo()+" my-tool/1.2.3", BuildUserAgent("my-tool", "1.2.3"))
+	assert.Equal(t, GetUserAgentInfo()+" my-tool", BuildUserAgent("my-tool", ""))
+	assert.Equal(t, GetUserAgentInfo()+" my-tool/1.2.3 terraform/1.9.0 ci",
+		BuildUserAgent("my-tool", "1.2.3", "terraform/1.9.0", "", "ci"))
+	assert.Equal(t, GetUserAgentInfo()+" my-tool--beta-/1.0-rc1", BuildUserAgent("my tool (beta)", "1.0 rc1"))
+}
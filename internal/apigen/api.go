@@ -0,0 +1,776 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// header is the start of every generated file.
+const header = `/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+`
+
+// family is a group of methods that get their own interface.
+type family struct {
+	name string
+
+	// What the methods of the family operate on, for the interface documentation.
+	description string
+
+	// The resource names that the methods of the family operate on, matched as prefixes regardless
+	// of case, as in ListFloatingIps.
+	resources []string
+}
+
+// families are the resource families, in matching order.
+var families = []family{
+	{
+		name:        "InstancesAPI",
+		description: "instances and their templates, groups and profiles, bare metal servers, dedicated hosts, placement groups, cluster networks, images and keys",
+		resources: []string{
+			"Instance", "BareMetalServer", "DedicatedHost", "PlacementGroup", "ClusterNetwork",
+			"Image", "OperatingSystem", "Key", "Reservation",
+		},
+	},
+	{
+		name:        "StorageAPI",
+		description: "volumes, snapshots, file shares and backup policies",
+		resources:   []string{"Volume", "Snapshot", "Share", "BackupPolic"},
+	},
+	{
+		name:        "NetworkingAPI",
+		description: "VPCs, routing tables, subnets, security groups, network ACLs, gateways, load balancers, VPNs, floating IPs and flow logs",
+		resources: []string{
+			"VPC", "RoutingTable", "Route", "Subnet", "SecurityGroup", "NetworkACL", "PublicGateway", "FloatingIP",
+			"LoadBalancer", "VPN", "IkePolic", "IpsecPolic", "EndpointGateway",
+			"PrivatePathServiceGateway", "FlowLogCollector", "VirtualNetworkInterface",
+			"NetworkInterface", "PublicAddressRange", "ReservedIP",
+		},
+	},
+}
+
+// method is an exported method of the service type.
+type method struct {
+	name    string
+	summary string
+	params  *ast.FieldList
+	results *ast.FieldList
+	imports map[string]string
+}
+
+// pagerType is a pager type, with a GetNextWithContext method returning a page of items, or an
+// alias of the generic Pager.
+type pagerType struct {
+	name     string
+	itemType ast.Expr
+	imports  map[string]string
+
+	// Whether the pager type is an alias of Pager, which has the methods of the pagers.
+	alias bool
+
+	// The names of the methods of the pager type.
+	methods map[string]bool
+}
+
+// modelType is a type declared by the package, which is a model if it is a struct with an
+// Unmarshal function.
+type modelType struct {
+	name      string
+	isStruct  bool
+	unmarshal bool
+
+	// The names of the methods of the type.
+	methods map[string]bool
+}
+
+// api is the exported method set of the service type.
+type api struct {
+	packageName string
+	serviceType string
+	receiver    string
+	methods     []*method
+
+	// The names of the types declared by the package.
+	types map[string]bool
+
+	// The pager types, by name.
+	pagers map[string]*pagerType
+
+	// The candidate model types, by name (see modelType).
+	models map[string]*modelType
+}
+
+// loadAPI parses the non-test Go files in "dir" and returns the exported methods of "serviceType".
+func loadAPI(dir string, serviceType string) (*api, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	result := &api{
+		serviceType: serviceType,
+		types:       make(map[string]bool),
+		pagers:      make(map[string]*pagerType),
+		models:      make(map[string]*modelType),
+	}
+	fset := token.NewFileSet()
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		if ast.IsGenerated(file) && strings.Contains(string(source), "generated by apigen") {
+			continue
+		}
+		result.packageName = file.Name.Name
+		result.addFile(file)
+	}
+	if len(result.methods) == 0 {
+		return nil, fmt.Errorf("no exported methods of %s found in %s", serviceType, dir)
+	}
+	slices.SortFunc(result.methods, func(a, b *method) int { return strings.Compare(a.name, b.name) })
+	return result, nil
+}
+
+// addFile collects the declared types and the service methods of "file".
+func (api *api) addFile(file *ast.File) {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					api.types[spec.Name.Name] = true
+					if _, ok := spec.Type.(*ast.StructType); ok && spec.TypeParams == nil {
+						api.model(spec.Name.Name).isStruct = true
+					}
+					if index, ok := spec.Type.(*ast.IndexExpr); ok && spec.Assign.IsValid() && types.ExprString(index.X) == "Pager" {
+						pager := api.pager(spec.Name.Name)
+						pager.alias = true
+						pager.itemType = index.Index
+						pager.imports = imports
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				if model, found := strings.CutPrefix(decl.Name.Name, "Unmarshal"); found && model != "" {
+					api.model(model).unmarshal = true
+				}
+				continue
+			}
+			api.model(receiverType(decl.Recv)).methods[decl.Name.Name] = true
+			if !decl.Name.IsExported() {
+				continue
+			}
+			if name := receiverType(decl.Recv); strings.HasSuffix(name, "Pager") {
+				api.addPagerMethod(name, decl, imports)
+				continue
+			} else if name != api.serviceType || returnsType(decl.Type.Results, api.serviceType) {
+				// The methods returning the service type, such as Clone, are left out, as a mock
+				// could not implement them.
+				continue
+			}
+			if api.receiver == "" && len(decl.Recv.List[0].Names) > 0 {
+				api.receiver = decl.Recv.List[0].Names[0].Name
+			}
+			summary := ""
+			if decl.Doc != nil {
+				summary, _, _ = strings.Cut(decl.Doc.Text(), "\n")
+			}
+			api.methods = append(api.methods, &method{
+				name:    decl.Name.Name,
+				summary: summary,
+				params:  decl.Type.Params,
+				results: decl.Type.Results,
+				imports: imports,
+			})
+		}
+	}
+}
+
+// pager returns the pager type "name", which is added if needed.
+func (api *api) pager(name string) *pagerType {
+	pager := api.pagers[name]
+	if pager == nil {
+		pager = &pagerType{name: name, methods: make(map[string]bool)}
+		api.pagers[name] = pager
+	}
+	return pager
+}
+
+// model returns the type "name", which is added if needed.
+func (api *api) model(name string) *modelType {
+	model := api.models[name]
+	if model == nil {
+		model = &modelType{name: name, methods: make(map[string]bool)}
+		api.models[name] = model
+	}
+	return model
+}
+
+// addPagerMethod records the method "decl" of the pager type "name".
+func (api *api) addPagerMethod(name string, decl *ast.FuncDecl, imports map[string]string) {
+	pager := api.pager(name)
+	pager.methods[decl.Name.Name] = true
+	if decl.Name.Name != "GetNextWithContext" {
+		return
+	}
+	// The page is the first result: GetNextWithContext(ctx context.Context) (page []T, err error)
+	results := decl.Type.Results
+	if results == nil || len(results.List) != 2 {
+		return
+	}
+	if page, ok := results.List[0].Type.(*ast.ArrayType); ok && page.Len == nil {
+		pager.itemType = page.Elt
+		pager.imports = imports
+	}
+}
+
+// receiverType returns the name of the receiver type, without the pointer.
+func receiverType(recv *ast.FieldList) string {
+	expr := recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// returnsType returns true if one of "results" is the type "name" or a pointer to it.
+func returnsType(results *ast.FieldList, name string) bool {
+	if results == nil {
+		return false
+	}
+	for _, result := range results.List {
+		expr := result.Type
+		if star, ok := expr.(*ast.StarExpr); ok {
+			expr = star.X
+		}
+		if ident, ok := expr.(*ast.Ident); ok && ident.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// familyOf returns the family of the method named "name", or nil.
+func familyOf(name string) *family {
+	name = strings.TrimPrefix(name, "WaitFor")
+	if rest, ok := strings.CutPrefix(name, "New"); ok && rest != "" {
+		name = rest
+	}
+	candidates := []string{name}
+	isUpper := func(r rune) bool { return r >= 'A' && r <= 'Z' }
+	// Skip the verb, as in "CreateInstance".
+	if i := strings.IndexFunc(name[1:], isUpper); i >= 0 {
+		candidates = append(candidates, name[i+1:])
+	}
+	// Skip what the method does to the resource, as in "UpdateFirmwareForBareMetalServer".
+	if _, rest, ok := strings.Cut(name, "For"); ok && strings.IndexFunc(rest, isUpper) == 0 {
+		candidates = append(candidates, rest)
+	}
+	for _, candidate := range candidates {
+		for i := range families {
+			for _, resource := range families[i].resources {
+				if len(candidate) >= len(resource) && strings.EqualFold(candidate[:len(resource)], resource) {
+					return &families[i]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// interfaceFile returns the source of the file declaring the interfaces.
+func (api *api) interfaceFile() ([]byte, error) {
+	var body bytes.Buffer
+	imports := make(map[string]string)
+	byFamily := make(map[string][]*method)
+	var others []*method
+	for _, method := range api.methods {
+		if family := familyOf(method.name); family != nil {
+			byFamily[family.name] = append(byFamily[family.name], method)
+		} else {
+			others = append(others, method)
+		}
+	}
+
+	writeMethods := func(methods []*method) {
+		for i, method := range methods {
+			if i > 0 {
+				body.WriteString("\n")
+			}
+			if method.summary != "" {
+				fmt.Fprintf(&body, "\t// %s\n", method.summary)
+			}
+			fmt.Fprintf(&body, "\t%s%s\n", method.name, api.signature(method, false, imports))
+		}
+	}
+
+	mainInterface := api.serviceType + "API"
+	var embedded []string
+	for _, family := range families {
+		if len(byFamily[family.name]) == 0 {
+			continue
+		}
+		embedded = append(embedded, family.name)
+		fmt.Fprintf(&body, "// %s : The methods of %s that operate on %s.\n", family.name, api.serviceType, family.description)
+		fmt.Fprintf(&body, "type %s interface {\n", family.name)
+		writeMethods(byFamily[family.name])
+		body.WriteString("}\n\n")
+	}
+
+	fmt.Fprintf(&body, "// %s : The methods of %s.\n", mainInterface, api.serviceType)
+	fmt.Fprintf(&body, "// *%s satisfies %s and each of the interfaces it embeds, so code can depend on the narrowest\n", api.serviceType, mainInterface)
+	fmt.Fprintf(&body, "// one it needs and be tested with a mock.\n")
+	fmt.Fprintf(&body, "type %s interface {\n", mainInterface)
+	for _, name := range embedded {
+		fmt.Fprintf(&body, "\t%s\n", name)
+	}
+	if len(embedded) > 0 && len(others) > 0 {
+		body.WriteString("\n")
+	}
+	writeMethods(others)
+	body.WriteString("}\n\n")
+	fmt.Fprintf(&body, "var _ %s = (*%s)(nil)\n", mainInterface, api.serviceType)
+
+	return api.file(api.packageName, imports, body.Bytes())
+}
+
+// mockFile returns the source of the file declaring the mock, in package "mockPackage".
+func (api *api) mockFile(mockPackage string) ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]string{api.packageName: importPath + "/" + api.packageName}
+	mockType := "Mock" + api.serviceType + "API"
+
+	fmt.Fprintf(&body, "// %s : A mock %s.%sAPI.\n", mockType, api.packageName, api.serviceType)
+	fmt.Fprintf(&body, "// Each method calls the function in the field of the same name with the Func suffix, and panics if\n")
+	fmt.Fprintf(&body, "// it is not set.\n")
+	fmt.Fprintf(&body, "type %s struct {\n", mockType)
+	for _, method := range api.methods {
+		fmt.Fprintf(&body, "\t%sFunc func%s\n", method.name, api.signature(method, true, imports))
+	}
+	body.WriteString("}\n\n")
+	fmt.Fprintf(&body, "var _ %s.%sAPI = (*%s)(nil)\n", api.packageName, api.serviceType, mockType)
+
+	for _, method := range api.methods {
+		params := namedFields(method.params, "arg")
+		call := fmt.Sprintf("mock.%sFunc(%s)", method.name, arguments(params))
+		if method.results != nil && len(method.results.List) > 0 {
+			call = "return " + call
+		}
+
+		fmt.Fprintf(&body, "\n// %s calls %sFunc.\n", method.name, method.name)
+		fmt.Fprintf(&body, "func (mock *%s) %s%s {\n", mockType, method.name, api.signatureWith(params, method.results, true, imports, method.imports))
+		fmt.Fprintf(&body, "\tif mock.%sFunc == nil {\n", method.name)
+		fmt.Fprintf(&body, "\t\tpanic(\"%s: unexpected call to %s\")\n", mockPackage, method.name)
+		body.WriteString("\t}\n")
+		fmt.Fprintf(&body, "\t%s\n", call)
+		body.WriteString("}\n")
+	}
+
+	return api.file(mockPackage, imports, body.Bytes())
+}
+
+// pagerConstructor is a pager type with its constructor, a method of the service type.
+type pagerConstructor struct {
+	pager       *pagerType
+	constructor *method
+	itemType    string
+}
+
+// pagerConstructors returns the pager types that have a constructor named after them returning
+// them, sorted by name. The packages used by the item types are added to "imports".
+func (api *api) pagerConstructors(imports map[string]string) []pagerConstructor {
+	var result []pagerConstructor
+	for _, name := range slices.Sorted(maps.Keys(api.pagers)) {
+		pager := api.pagers[name]
+		if pager.itemType == nil || (!pager.alias && !pager.methods["HasNext"]) {
+			continue
+		}
+		itemType := api.fields(&ast.FieldList{List: []*ast.Field{{Type: pager.itemType}}}, false, imports, pager.imports)
+		constructor := api.method("New" + pager.name)
+		if constructor != nil {
+			if results := constructor.results; results == nil || len(results.List) != 2 || types.ExprString(results.List[0].Type) != "*"+pager.name {
+				constructor = nil
+			}
+		}
+		result = append(result, pagerConstructor{pager: pager, constructor: constructor, itemType: itemType})
+	}
+	return result
+}
+
+// receiverName returns the name of the receiver of the service methods.
+func (api *api) receiverName() string {
+	if api.receiver == "" {
+		return "service"
+	}
+	return api.receiver
+}
+
+// iteratorFile returns the source of the file declaring the iterators over the items of the pagers:
+// an All method on each pager type that is not an alias of Pager, and a method of the service type
+// named after each pager, which creates the pager and iterates over its items (for example
+// Instances for NewInstancesPager). Methods that would conflict with existing ones are not
+// generated.
+func (api *api) iteratorFile() ([]byte, error) {
+	var body bytes.Buffer
+	imports := map[string]string{"context": "context", "iter": "iter"}
+	receiver := api.receiverName()
+
+	for _, paged := range api.pagerConstructors(imports) {
+		pager, constructor, itemType := paged.pager, paged.constructor, paged.itemType
+		if !pager.alias && !pager.methods["All"] {
+			fmt.Fprintf(&body, "// All : Return an iterator over the items of the remaining pages\n")
+			fmt.Fprintf(&body, "// Pages are retrieved with \"ctx\" as the iteration progresses (see PagerItems).\n")
+			fmt.Fprintf(&body, "func (pager *%s) All(ctx context.Context) iter.Seq2[%s, error] {\n", pager.name, itemType)
+			fmt.Fprintf(&body, "\treturn PagerItems[%s](ctx, pager)\n", itemType)
+			body.WriteString("}\n\n")
+		}
+
+		methodName := strings.TrimSuffix(pager.name, "Pager")
+		if constructor == nil || api.method(methodName) != nil || api.method(methodName+"WithContext") != nil {
+			continue
+		}
+		params := namedFields(constructor.params, "arg")
+		paramList := api.fields(params, false, imports, constructor.imports)
+		if paramList != "" {
+			paramList = ", " + paramList
+		}
+		fmt.Fprintf(&body, "// %s : Return an iterator over the items of all pages of %s\n", methodName, constructor.name)
+		fmt.Fprintf(&body, "// Pages are retrieved with \"ctx\" as the iteration progresses (see PagerItems). An error\n")
+		fmt.Fprintf(&body, "// creating the pager is yielded by the iterator.\n")
+		fmt.Fprintf(&body, "func (%s *%s) %s(ctx context.Context%s) iter.Seq2[%s, error] {\n", receiver, api.serviceType, methodName, paramList, itemType)
+		fmt.Fprintf(&body, "\tpager, err := %s.%s(%s)\n", receiver, constructor.name, arguments(params))
+		fmt.Fprintf(&body, "\treturn pagerItems[%s](ctx, pager, err)\n", itemType)
+		body.WriteString("}\n\n")
+	}
+
+	return api.file(api.packageName, imports, body.Bytes())
+}
+
+// checkpointFile returns the source of the file declaring the constructors of the service type
+// resuming the pagers from a checkpoint (for example ResumeInstancesPager), returned by the
+// Checkpoint method of Pager. Only the pagers that are aliases of Pager, whose constructor takes
+// the options of the list operation, are supported. Methods that would conflict with existing ones
+// are not generated.
+func (api *api) checkpointFile() ([]byte, error) {
+	var body bytes.Buffer
+	imports := make(map[string]string)
+	receiver := api.receiverName()
+
+	for _, paged := range api.pagerConstructors(imports) {
+		pager, constructor := paged.pager, paged.constructor
+		if constructor == nil || !pager.alias {
+			continue
+		}
+		if len(constructor.params.List) != 1 || len(constructor.params.List[0].Names) > 1 {
+			continue
+		}
+		optionsType, ok := constructor.params.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		resume := "Resume" + pager.name
+		if api.method(resume) != nil {
+			continue
+		}
+		fmt.Fprintf(&body, "// %s : Instantiate %s continuing from \"checkpoint\", returned by its Checkpoint method\n", resume, pager.name)
+		fmt.Fprintf(&body, "func (%s *%s) %s(checkpoint *PagerCheckpoint) (pager *%s, err error) {\n", receiver, api.serviceType, resume, pager.name)
+		fmt.Fprintf(&body, "\toptions := &%s{}\n", types.ExprString(optionsType.X))
+		fmt.Fprintf(&body, "\tif err = checkpoint.decode(%q, options); err != nil {\n", pager.name)
+		body.WriteString("\t\treturn\n")
+		body.WriteString("\t}\n")
+		fmt.Fprintf(&body, "\tpager, err = %s.%s(options)\n", receiver, constructor.name)
+		body.WriteString("\tif err != nil {\n")
+		body.WriteString("\t\treturn\n")
+		body.WriteString("\t}\n")
+		body.WriteString("\tif err = pager.SetCheckpoint(checkpoint); err != nil {\n")
+		body.WriteString("\t\treturn nil, err\n")
+		body.WriteString("\t}\n")
+		body.WriteString("\treturn\n")
+		body.WriteString("}\n\n")
+	}
+
+	return api.file(api.packageName, imports, body.Bytes())
+}
+
+// modelFile returns the source of the file declaring a DeepCopy and an Equal method on each model
+// and union variant, the structs with an Unmarshal function (for example Instance for
+// UnmarshalInstance). Methods that would conflict with existing ones are not generated.
+func (api *api) modelFile() ([]byte, error) {
+	var body bytes.Buffer
+	names := slices.Sorted(maps.Keys(api.models))
+	for _, name := range names {
+		model := api.models[name]
+		if !model.isStruct || !model.unmarshal {
+			continue
+		}
+		if !model.methods["DeepCopy"] {
+			fmt.Fprintf(&body, "// DeepCopy : Return a deep copy of the model, sharing nothing with it\n")
+			fmt.Fprintf(&body, "func (model *%s) DeepCopy() *%s {\n", name, name)
+			body.WriteString("\treturn deepCopy(model)\n")
+			body.WriteString("}\n\n")
+		}
+		if !model.methods["Equal"] {
+			fmt.Fprintf(&body, "// Equal : Return true if the model is semantically equal to \"other\" (see ModelsEqual)\n")
+			fmt.Fprintf(&body, "func (model *%s) Equal(other *%s, options ...EqualOption) bool {\n", name, name)
+			body.WriteString("\treturn modelsEqual(model, other, options)\n")
+			body.WriteString("}\n\n")
+		}
+	}
+
+	return api.file(api.packageName, nil, body.Bytes())
+}
+
+// method returns the method of the service type named "name", or nil.
+func (api *api) method(name string) *method {
+	for _, method := range api.methods {
+		if method.name == name {
+			return method
+		}
+	}
+	return nil
+}
+
+// arguments returns the arguments passing the parameters "params", which must be named, to a call.
+func arguments(params *ast.FieldList) string {
+	var args []string
+	for i, field := range params.List {
+		for _, name := range field.Names {
+			arg := name.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok && i == len(params.List)-1 {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// importPath is the import path of the module's packages.
+const importPath = "github.com/IBM/vpc-go-sdk"
+
+// file returns the formatted source of a generated file.
+func (api *api) file(packageName string, imports map[string]string, body []byte) ([]byte, error) {
+	var source bytes.Buffer
+	source.WriteString(header)
+	fmt.Fprintf(&source, "package %s\n\n", packageName)
+	if len(imports) > 0 {
+		names := make([]string, 0, len(imports))
+		for name := range imports {
+			names = append(names, name)
+		}
+		// Standard library packages first, as goimports does.
+		isStandard := func(path string) bool {
+			first, _, _ := strings.Cut(path, "/")
+			return !strings.Contains(first, ".")
+		}
+		slices.SortFunc(names, func(a, b string) int {
+			if isStandard(imports[a]) != isStandard(imports[b]) {
+				if isStandard(imports[a]) {
+					return -1
+				}
+				return 1
+			}
+			return strings.Compare(imports[a], imports[b])
+		})
+		source.WriteString("import (\n")
+		for i, name := range names {
+			path := imports[name]
+			if i > 0 && isStandard(imports[names[i-1]]) && !isStandard(path) {
+				source.WriteString("\n")
+			}
+			if filepath.Base(path) == name {
+				fmt.Fprintf(&source, "\t%q\n", path)
+			} else {
+				fmt.Fprintf(&source, "\t%s %q\n", name, path)
+			}
+		}
+		source.WriteString(")\n\n")
+	}
+	source.Write(body)
+	return format.Source(source.Bytes())
+}
+
+// signature returns the parameters and results of "method". If "qualify" is true, the types
+// declared by the service package are qualified with its name.
+func (api *api) signature(method *method, qualify bool, imports map[string]string) string {
+	return api.signatureWith(method.params, method.results, qualify, imports, method.imports)
+}
+
+// signatureWith returns the signature with "params" and "results", adding the packages they use
+// to "imports", as found in "fileImports".
+func (api *api) signatureWith(params *ast.FieldList, results *ast.FieldList, qualify bool, imports map[string]string, fileImports map[string]string) string {
+	signature := "(" + api.fields(params, qualify, imports, fileImports) + ")"
+	if results != nil && len(results.List) > 0 {
+		list := api.fields(results, qualify, imports, fileImports)
+		if len(results.List) == 1 && len(results.List[0].Names) == 0 {
+			signature += " " + list
+		} else {
+			signature += " (" + list + ")"
+		}
+	}
+	return signature
+}
+
+// fields returns the source of a parameter or result list.
+func (api *api) fields(list *ast.FieldList, qualify bool, imports map[string]string, fileImports map[string]string) string {
+	if list == nil {
+		return ""
+	}
+	var parts []string
+	for _, field := range list.List {
+		fieldType := field.Type
+		if qualify {
+			fieldType = api.qualify(fieldType)
+		}
+		ast.Inspect(fieldType, func(node ast.Node) bool {
+			if selector, ok := node.(*ast.SelectorExpr); ok {
+				if pkg, ok := selector.X.(*ast.Ident); ok && pkg.Name != api.packageName {
+					imports[pkg.Name] = fileImports[pkg.Name]
+				}
+				return false
+			}
+			return true
+		})
+
+		typeString := types.ExprString(fieldType)
+		if len(field.Names) == 0 {
+			parts = append(parts, typeString)
+			continue
+		}
+		var names []string
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+		parts = append(parts, strings.Join(names, ", ")+" "+typeString)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// namedFields returns a copy of "list" in which unnamed fields are named "prefix" and their index.
+func namedFields(list *ast.FieldList, prefix string) *ast.FieldList {
+	named := &ast.FieldList{}
+	if list == nil {
+		return named
+	}
+	for i, field := range list.List {
+		copied := *field
+		if len(copied.Names) == 0 || copied.Names[0].Name == "_" {
+			copied.Names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("%s%d", prefix, i))}
+		}
+		named.List = append(named.List, &copied)
+	}
+	return named
+}
+
+// qualify returns a copy of the type expression "expr" in which the types declared by the service
+// package are qualified with its name.
+func (api *api) qualify(expr ast.Expr) ast.Expr {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if api.types[expr.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent(api.packageName), Sel: ast.NewIdent(expr.Name)}
+		}
+		return expr
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: api.qualify(expr.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: expr.Len, Elt: api.qualify(expr.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: api.qualify(expr.Key), Value: api.qualify(expr.Value)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: expr.Dir, Value: api.qualify(expr.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: api.qualify(expr.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: api.qualify(expr.X), Index: api.qualify(expr.Index)}
+	case *ast.IndexListExpr:
+		indices := make([]ast.Expr, len(expr.Indices))
+		for i, index := range expr.Indices {
+			indices[i] = api.qualify(index)
+		}
+		return &ast.IndexListExpr{X: api.qualify(expr.X), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: api.qualifyFields(expr.Params), Results: api.qualifyFields(expr.Results)}
+	default:
+		return expr
+	}
+}
+
+// qualifyFields returns a copy of "list" with qualified types.
+func (api *api) qualifyFields(list *ast.FieldList) *ast.FieldList {
+	if list == nil {
+		return nil
+	}
+	qualified := &ast.FieldList{}
+	for _, field := range list.List {
+		copied := *field
+		copied.Type = api.qualify(field.Type)
+		qualified.List = append(qualified.List, &copied)
+	}
+	return qualified
+}
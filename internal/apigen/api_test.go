@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFamilyOf(t *testing.T) {
+	cases := map[string]string{
+		"CreateInstance":                   "InstancesAPI",
+		"CreateInstanceVolumeAttachment":   "InstancesAPI",
+		"NewInstancesPager":                "InstancesAPI",
+		"NewGetInstanceOptions":            "InstancesAPI",
+		"ListKeys":                         "InstancesAPI",
+		"WaitForVolumeAvailable":           "StorageAPI",
+		"DeleteSnapshotsWithContext":       "StorageAPI",
+		"ListVpcs":                         "NetworkingAPI",
+		"GetVPCDefaultSecurityGroup":       "NetworkingAPI",
+		"UpdateLoadBalancerListenerPolicy": "NetworkingAPI",
+		"ListSubnetReservedIps":            "NetworkingAPI",
+		"CreateFloatingIPAsyncWithContext": "NetworkingAPI",
+		"ListEndpointGatewayIps":           "NetworkingAPI",
+		"ListFloatingIps":                  "NetworkingAPI",
+		"NewNetworkAclsPager":              "NetworkingAPI",
+		"AddNetworkInterfaceFloatingIP":    "NetworkingAPI",
+		"ListVpnServerClients":             "NetworkingAPI",
+		"UpdateFirmwareForBareMetalServer": "InstancesAPI",
+		"SetServiceURL":                    "",
+		"EnableRetries":                    "",
+		"ListRegions":                      "",
+	}
+	for name, expected := range cases {
+		family := familyOf(name)
+		if expected == "" {
+			assert.Nil(t, family, name)
+		} else if assert.NotNil(t, family, name) {
+			assert.Equal(t, expected, family.name, name)
+		}
+	}
+}
+
+// update rewrites the golden files of the tests with their output.
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestFamiliesGolden checks the interface of each method of VpcV1, other than the WithContext ones,
+// against testdata/families.golden. Run it with -update after changing the families.
+func TestFamiliesGolden(t *testing.T) {
+	api, err := loadAPI("../../vpcv1", "VpcV1")
+	require.Nil(t, err)
+
+	var output strings.Builder
+	for _, method := range api.methods {
+		if strings.HasSuffix(method.name, "WithContext") {
+			continue
+		}
+		name := "VpcV1API"
+		if family := familyOf(method.name); family != nil {
+			name = family.name
+		}
+		fmt.Fprintf(&output, "%s %s\n", method.name, name)
+	}
+	if *update {
+		require.Nil(t, os.WriteFile("testdata/families.golden", []byte(output.String()), 0644))
+	}
+	golden, err := os.ReadFile("testdata/families.golden")
+	require.Nil(t, err)
+	assert.Equal(t, string(golden), output.String())
+}
+
+func TestGenerate(t *testing.T) {
+	api, err := loadAPI("testdata/service", "Service")
+	require.Nil(t, err)
+	assert.Len(t, api.methods, 7)
+
+	source, err := api.interfaceFile()
+	require.Nil(t, err)
+	file, err := parser.ParseFile(token.NewFileSet(), "service_api.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, "service", file.Name.Name)
+	text := string(source)
+	assert.Contains(t, text, "// Code generated by apigen. DO NOT EDIT.")
+	assert.Contains(t, text, "type InstancesAPI interface {")
+	assert.Contains(t, text, "\t// GetInstance : Retrieve an instance\n\tGetInstance(getInstanceOptions *GetInstanceOptions) (result *Instance, response *core.DetailedResponse, err error)\n")
+	assert.Contains(t, text, "NewInstancesPager(ids ...string) (*Operation[*Instance], error)")
+	assert.Contains(t, text, "type StorageAPI interface {")
+	assert.Contains(t, text, "type NetworkingAPI interface {")
+	assert.Contains(t, text, "type ServiceAPI interface {\n\tInstancesAPI\n\tStorageAPI\n\tNetworkingAPI\n\n\tSetServiceURL(url string) error\n}")
+	assert.Contains(t, text, "var _ ServiceAPI = (*Service)(nil)")
+	assert.NotContains(t, text, "unexported")
+	assert.NotContains(t, text, "GetName")
+	assert.NotContains(t, text, "Clone")
+
+	source, err = api.mockFile("servicemock")
+	require.Nil(t, err)
+	file, err = parser.ParseFile(token.NewFileSet(), "service_mock.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, "servicemock", file.Name.Name)
+	text = string(source)
+	assert.Contains(t, text, "\t\"context\"\n\n\t\"github.com/IBM/go-sdk-core/v5/core\"\n\t\"github.com/IBM/vpc-go-sdk/service\"\n")
+	assert.Contains(t, text, "type MockServiceAPI struct {")
+	assert.Contains(t, text, "var _ service.ServiceAPI = (*MockServiceAPI)(nil)")
+	assert.Contains(t, text, "func (mock *MockServiceAPI) NewInstancesPager(ids ...string) (*service.Operation[*service.Instance], error) {")
+	assert.Contains(t, text, "\treturn mock.NewInstancesPagerFunc(ids...)\n")
+	assert.Contains(t, text, "func (mock *MockServiceAPI) ListVpcs(arg0 map[string]interface{}) {")
+	assert.Contains(t, text, "\tmock.ListVpcsFunc(arg0)\n")
+	assert.Contains(t, text, "panic(\"servicemock: unexpected call to GetInstance\")")
+
+	source, err = api.iteratorFile()
+	require.Nil(t, err)
+	file, err = parser.ParseFile(token.NewFileSet(), "service_iterators_gen.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, "service", file.Name.Name)
+	text = string(source)
+	assert.Contains(t, text, "import (\n\t\"context\"\n\t\"iter\"\n)")
+	assert.Contains(t, text, "func (pager *KeysPager) All(ctx context.Context) iter.Seq2[Instance, error] {\n\treturn PagerItems[Instance](ctx, pager)\n}")
+	assert.Contains(t, text, "func (service *Service) Volumes(ctx context.Context, listVolumesOptions *ListVolumesOptions) iter.Seq2[Volume, error] {\n"+
+		"\tpager, err := service.NewVolumesPager(listVolumesOptions)\n"+
+		"\treturn pagerItems[Volume](ctx, pager, err)\n}")
+	// VolumesPager is an alias of Pager, which has an All method, SnapshotsPager has an All method and
+	// no constructor, and NewInstancesPager does not return a pager.
+	assert.NotContains(t, text, "*VolumesPager")
+	assert.NotContains(t, text, "SnapshotsPager")
+	assert.NotContains(t, text, "Instances")
+
+	source, err = api.checkpointFile()
+	require.Nil(t, err)
+	file, err = parser.ParseFile(token.NewFileSet(), "service_checkpoints_gen.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, "service", file.Name.Name)
+	text = string(source)
+	assert.Contains(t, text, "func (service *Service) ResumeVolumesPager(checkpoint *PagerCheckpoint) (pager *VolumesPager, err error) {\n"+
+		"\toptions := &ListVolumesOptions{}\n"+
+		"\tif err = checkpoint.decode(\"VolumesPager\", options); err != nil {\n")
+	assert.Contains(t, text, "\tpager, err = service.NewVolumesPager(options)\n")
+	assert.Contains(t, text, "\tif err = pager.SetCheckpoint(checkpoint); err != nil {\n\t\treturn nil, err\n\t}\n")
+	assert.NotContains(t, text, "Checkpoint()")
+	// KeysPager and SnapshotsPager are not aliases of Pager.
+	assert.NotContains(t, text, "KeysPager")
+	assert.NotContains(t, text, "SnapshotsPager")
+
+	source, err = api.modelFile()
+	require.Nil(t, err)
+	file, err = parser.ParseFile(token.NewFileSet(), "service_models_gen.go", source, parser.ParseComments)
+	require.Nil(t, err)
+	assert.Equal(t, "service", file.Name.Name)
+	text = string(source)
+	assert.Contains(t, text, "func (model *Instance) DeepCopy() *Instance {\n\treturn deepCopy(model)\n}")
+	assert.Contains(t, text, "func (model *Instance) Equal(other *Instance, options ...EqualOption) bool {\n"+
+		"\treturn modelsEqual(model, other, options)\n}")
+	assert.Contains(t, text, "func (model *Volume) Equal(other *Volume, options ...EqualOption) bool {")
+	// Volume has a DeepCopy method, and the other types have no Unmarshal function.
+	assert.NotContains(t, text, "func (model *Volume) DeepCopy")
+	assert.NotContains(t, text, "Options")
+	assert.NotContains(t, text, "Pager")
+}
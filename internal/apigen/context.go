@@ -0,0 +1,93 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+)
+
+// operationFunction is the function of the service package that returns a copy of a context
+// carrying the operation of the requests sent with it.
+const operationFunction = "withOperation"
+
+// rewriteContexts rewrites the methods in the Go file "path" with the content "source", so that
+// they pass their operation on the context of their request: the operation ID they pass to
+// common.GetSdkHeaders, and the path template and path parameters they pass to
+// builder.ResolveRequestURL, as in
+// builder.WithContext(withOperation(ctx, "GetInstance", `/instances/{id}`, pathParamsMap)). It
+// returns the rewritten source and the names of the rewritten methods. Rewritten methods are left
+// unchanged.
+func rewriteContexts(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	insertions := make(map[int]string)
+	var methods []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Body == nil {
+			continue
+		}
+		operationID, pathTemplate, pathParams := "", "", ""
+		var contexts []ast.Expr
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			switch fun := exprString(call.Fun); {
+			case fun == "common.GetSdkHeaders" && len(call.Args) == 3:
+				if literal, ok := call.Args[2].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+					operationID = literal.Value
+				}
+			case fun == "builder.ResolveRequestURL" && len(call.Args) == 3:
+				if literal, ok := call.Args[1].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+					pathTemplate, pathParams = literal.Value, exprString(call.Args[2])
+				}
+			case fun == "builder.WithContext" && len(call.Args) == 1:
+				if _, ok := call.Args[0].(*ast.Ident); ok {
+					contexts = append(contexts, call.Args[0])
+				}
+			}
+			return true
+		})
+		if operationID == "" || pathTemplate == "" || len(contexts) == 0 {
+			continue
+		}
+		for _, ctx := range contexts {
+			insertions[fset.Position(ctx.Pos()).Offset] = operationFunction + "("
+			insertions[fset.Position(ctx.End()).Offset] = ", " + operationID + ", " + pathTemplate + ", " + pathParams + ")"
+		}
+		methods = append(methods, receiverType(decl.Recv)+"."+decl.Name.Name)
+	}
+	if len(methods) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := insertText(source, insertions)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(methods)
+	return formatted, methods, nil
+}
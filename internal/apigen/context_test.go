@@ -0,0 +1,50 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteContexts(t *testing.T) {
+	path := "testdata/methods/service.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, methods, err := rewriteContexts(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"VpcV1.DeleteVolumeWithContext", "VpcV1.GetInstanceWithContext"}, methods)
+	text := string(rewritten)
+	assert.Contains(t, text, "\tbuilder := core.NewRequestBuilder(core.GET)\n"+
+		"\tbuilder = builder.WithContext(withOperation(ctx, \"GetInstance\", `/instances/{id}`, pathParamsMap))\n")
+	assert.Contains(t, text, "\tbuilder = builder.WithContext(withOperation(ctx, \"DeleteVolume\", `/volumes/{id}`, pathParamsMap))\n")
+	assert.Equal(t, 2, strings.Count(text, "withOperation("))
+
+	// The SDK headers are left unchanged.
+	assert.Contains(t, text, "\tsdkHeaders := common.GetSdkHeaders(\"vpc\", \"V1\", \"GetInstance\")\n")
+
+	// The rewritten methods are left unchanged.
+	again, methods, err := rewriteContexts(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, methods)
+	assert.Equal(t, rewritten, again)
+}
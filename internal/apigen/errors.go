@@ -0,0 +1,107 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+)
+
+// errorFunction is the function of the service package that returns the error of a request as
+// the cause of the problem returned by a method, which unwraps to an *APIError if the error
+// resulted from an error response.
+const errorFunction = "withAPIError"
+
+// requestErrorDiscriminator is the discriminator of the problems of the failed requests.
+const requestErrorDiscriminator = `"http-request-err"`
+
+// rewriteErrors rewrites the methods and functions in the Go file "path" with the content "source",
+// so that the problems they return for error responses unwrap to *APIError values: it passes the
+// error of a failed request to withAPIError before it becomes the cause of the problem, as in
+// core.SDKErrorf(withAPIError(err), "", "http-request-err", ...). The problems remain
+// *core.SDKProblem values. It returns the rewritten source and the names of the rewritten
+// functions. Rewritten functions are left unchanged.
+func rewriteErrors(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	insertions := make(map[int]string)
+	var methods []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Body == nil {
+			continue
+		}
+		rewritten := false
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			block, ok := node.(*ast.BlockStmt)
+			if !ok {
+				return true
+			}
+			for _, stmt := range block.List {
+				call := errorAssignment(stmt)
+				if call == nil || exprString(call.Fun) != "core.SDKErrorf" || len(call.Args) != 4 {
+					continue
+				}
+				if exprString(call.Args[2]) != requestErrorDiscriminator {
+					continue
+				}
+				if cause, ok := call.Args[0].(*ast.CallExpr); ok && exprString(cause.Fun) == errorFunction {
+					continue
+				}
+				insertions[fset.Position(call.Args[0].Pos()).Offset] = errorFunction + "("
+				insertions[fset.Position(call.Args[0].End()).Offset] = ")"
+				rewritten = true
+			}
+			return true
+		})
+		if rewritten && decl.Recv != nil {
+			methods = append(methods, receiverType(decl.Recv)+"."+decl.Name.Name)
+		} else if rewritten {
+			methods = append(methods, decl.Name.Name)
+		}
+	}
+	if len(methods) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := insertText(source, insertions)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(methods)
+	return formatted, methods, nil
+}
+
+// errorAssignment returns the call of "stmt" if it assigns the result of a call to err, as in
+// err = core.SDKErrorf(...), or nil.
+func errorAssignment(stmt ast.Stmt) *ast.CallExpr {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return nil
+	}
+	if ident, ok := assign.Lhs[0].(*ast.Ident); !ok || ident.Name != "err" {
+		return nil
+	}
+	call, _ := assign.Rhs[0].(*ast.CallExpr)
+	return call
+}
@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// extraField is the field added to the models to hold the properties they do not declare.
+const extraField = "Extra"
+
+// rewriteModels rewrites the models in the Go file "path" with the content "source", so that they
+// keep the JSON properties they do not declare: it adds an Extra field to each model that has an
+// Unmarshal function, sets it in that function, and adds a MarshalJSON method emitting it. The base
+// models of the discriminated unions rewritten by rewriteUnions get the field and the method, the
+// field being set by unmarshalUnknownVariant. It returns the rewritten source and the names of the
+// rewritten models. Models that already have an Extra field are left unchanged, and a model to
+// rewrite that already has a MarshalJSON method is an error, since that method would not emit the
+// field.
+func rewriteModels(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	hasMarshal := make(map[string]bool)
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					if structType, ok := spec.Type.(*ast.StructType); ok && spec.TypeParams == nil {
+						structs[spec.Name.Name] = structType
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil && decl.Name.Name == "MarshalJSON" {
+				hasMarshal[receiverType(decl.Recv)] = true
+			}
+		}
+	}
+
+	// Insertions of text at offsets of the source.
+	insertions := make(map[int]string)
+	var models []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv != nil || decl.Body == nil || !strings.HasPrefix(decl.Name.Name, "Unmarshal") {
+			continue
+		}
+		model := strings.TrimPrefix(decl.Name.Name, "Unmarshal")
+		structType := structs[model]
+		params := decl.Type.Params.List
+		if structType == nil || hasField(structType, extraField) || len(params) != 2 || len(params[0].Names) != 1 {
+			continue
+		}
+		store := storeStatement(decl.Body, model)
+		if store != nil {
+			insertions[fset.Position(store.Pos()).Offset] = fmt.Sprintf("obj.%s = unknownProperties(%s, obj)\n\t", extraField, params[0].Names[0].Name)
+		} else if !callsFunction(decl.Body, unknownVariantFunction) {
+			continue
+		}
+		insertions[fset.Position(structType.Fields.Closing).Offset] = fmt.Sprintf(
+			"\n\t// The properties that the model does not declare (see VpcV1.SetRetainUnknownProperties).\n\t%s map[string]json.RawMessage `json:\"-\"`\n",
+			extraField)
+		if hasMarshal[model] {
+			return nil, nil, fmt.Errorf("%s: %s has a MarshalJSON method, which would not emit its %s field", path, model, extraField)
+		}
+		insertions[fset.Position(decl.End()).Offset] = fmt.Sprintf(
+			"\n\n// MarshalJSON returns the JSON encoding of the model, with the properties in its %s field.\n"+
+				"func (model %s) MarshalJSON() ([]byte, error) {\n\ttype plain %s\n"+
+				"\tif len(model.%s) == 0 {\n\t\treturn json.Marshal(plain(model))\n\t}\n"+
+				"\treturn marshalWithExtra(plain(model), model.%s)\n}",
+			extraField, model, model, extraField, extraField)
+		models = append(models, model)
+	}
+	if len(models) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := insertText(source, insertions)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(models)
+	return formatted, models, nil
+}
+
+// insertText returns "source" with the text of "insertions" inserted at their offsets, formatted.
+func insertText(source []byte, insertions map[int]string) ([]byte, error) {
+	replacements := make(map[int]replacement, len(insertions))
+	for offset, text := range insertions {
+		replacements[offset] = replacement{end: offset, text: text}
+	}
+	return replaceText(source, replacements)
+}
+
+// replacement is the text replacing the source from its offset to "end".
+type replacement struct {
+	end  int
+	text string
+}
+
+// replaceText returns "source" with the text between the offsets of "replacements" and their end
+// replaced, formatted. The replaced ranges must not overlap.
+func replaceText(source []byte, replacements map[int]replacement) ([]byte, error) {
+	var result bytes.Buffer
+	previous := 0
+	for _, offset := range slices.Sorted(maps.Keys(replacements)) {
+		result.Write(source[previous:offset])
+		result.WriteString(replacements[offset].text)
+		previous = replacements[offset].end
+	}
+	result.Write(source[previous:])
+	return format.Source(result.Bytes())
+}
+
+// hasField returns true if "structType" has a field named "name".
+func hasField(structType *ast.StructType, name string) bool {
+	for _, field := range structType.Fields.List {
+		for _, ident := range field.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// storeStatement returns the statement of the body of a generated Unmarshal function of "model"
+// that stores the unmarshalled model in the result, after creating it with obj := new(model), as in
+// reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj)), or nil.
+func storeStatement(body *ast.BlockStmt, model string) ast.Stmt {
+	created := false
+	for _, stmt := range body.List {
+		if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && len(assign.Rhs) == 1 {
+			if ident, ok := assign.Lhs[0].(*ast.Ident); ok && ident.Name == "obj" {
+				if call, ok := assign.Rhs[0].(*ast.CallExpr); ok && len(call.Args) == 1 {
+					fun, isIdent := call.Fun.(*ast.Ident)
+					arg, isArgIdent := call.Args[0].(*ast.Ident)
+					created = isIdent && fun.Name == "new" && isArgIdent && arg.Name == model
+				}
+			}
+		}
+		expr, ok := stmt.(*ast.ExprStmt)
+		if !ok || !created {
+			continue
+		}
+		call, ok := expr.X.(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		if selector, ok := call.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "Set" && strings.HasPrefix(exprString(call), "reflect.ValueOf(") {
+			return stmt
+		}
+	}
+	return nil
+}
+
+// callsFunction returns true if "body" calls the function "name", with or without type arguments.
+func callsFunction(body *ast.BlockStmt, name string) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			fun := call.Fun
+			if index, ok := fun.(*ast.IndexExpr); ok {
+				fun = index.X
+			}
+			if ident, ok := fun.(*ast.Ident); ok && ident.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// exprString returns the source of "expr".
+func exprString(expr ast.Expr) string {
+	var buffer bytes.Buffer
+	_ = format.Node(&buffer, token.NewFileSet(), expr)
+	return buffer.String()
+}
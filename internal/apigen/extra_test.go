@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteModels(t *testing.T) {
+	path := "testdata/models/model.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, models, err := rewriteModels(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"Volume", "VolumeIdentity", "VolumeSpec"}, models)
+	text := string(rewritten)
+	assert.Contains(t, text, "\tCapacity *int64 `json:\"capacity\" validate:\"required\"`\n\n"+
+		"\t// The properties that the model does not declare (see VpcV1.SetRetainUnknownProperties).\n"+
+		"\tExtra map[string]json.RawMessage `json:\"-\"`\n}\n")
+	assert.Contains(t, text, "\tobj.Extra = unknownProperties(m, obj)\n"+
+		"\treflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))\n")
+	assert.Contains(t, text, "func (model Volume) MarshalJSON() ([]byte, error) {\n"+
+		"\ttype plain Volume\n"+
+		"\tif len(model.Extra) == 0 {\n\t\treturn json.Marshal(plain(model))\n\t}\n"+
+		"\treturn marshalWithExtra(plain(model), model.Extra)\n}\n")
+	assert.Contains(t, text, "func (model VolumeIdentity) MarshalJSON() ([]byte, error) {\n")
+	assert.Equal(t, 3, strings.Count(text, "Extra map[string]json.RawMessage"))
+	assert.Equal(t, 2, strings.Count(text, "obj.Extra = unknownProperties(m, obj)"))
+	assert.Equal(t, 3, strings.Count(text, ") MarshalJSON() ([]byte, error) {"))
+
+	// The rewritten models are left unchanged.
+	again, models, err := rewriteModels(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, models)
+	assert.Equal(t, rewritten, again)
+}
+
+func TestRewriteModelsWithMarshalJSON(t *testing.T) {
+	path := "testdata/models/model.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	// A model with its own MarshalJSON method would not emit its Extra field.
+	source = append(source, "\nfunc (spec VolumeSpec) MarshalJSON() ([]byte, error) {\n\treturn nil, nil\n}\n"...)
+	_, _, err = rewriteModels(path, source)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "VolumeSpec has a MarshalJSON method")
+}
@@ -0,0 +1,232 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Command apigen generates the interfaces satisfied by a service type, grouped by resource family,
+// and a mock implementation of them.
+//
+// It reads the non-test Go files of the package in the current directory and, from the exported
+// methods of the service type and the models, writes:
+//
+//   - one interface per resource family (for example InstancesAPI), plus an interface embedding all
+//     of them and declaring the remaining methods (for example VpcV1API), to the -output file. The
+//     methods returning the service type, such as VpcV1.Clone, are left out;
+//   - a mock implementing that interface with one function field per method, to the -mock file;
+//   - iterators over the items of the pager types (for example VpcV1.Instances), to the -iterators
+//     file;
+//   - constructors resuming the pager types from a checkpoint (for example
+//     VpcV1.ResumeInstancesPager), to the -checkpoints file;
+//   - DeepCopy and Equal methods on the models and union variants, to the -models file.
+//
+// With -unions, it first rewrites the Unmarshal functions of the discriminated unions in the
+// package, so that values with a discriminator value unknown to the SDK are unmarshalled as the
+// base model of the union instead of failing, and so that the ones already falling back to a
+// function unmarshalling the base model report these values. With -extra, it then rewrites the
+// models in the package, so that they keep the JSON properties unknown to the SDK and marshal them
+// again. With -errors, it rewrites the methods in the package, so that the problems they return for
+// error responses unwrap to *APIError values. With -context, it rewrites the methods in the
+// package, so that they pass their operation (ID, path template and path parameters) on the context
+// of their requests. With -pagers, it rewrites the pager types in the package, so that they are
+// aliases of
+// the generic Pager (for example InstancesPager, an alias of Pager[Instance]). With -unmarshal, it
+// rewrites the methods in the package, so that they unmarshal their response with the
+// unmarshalModel method of the service type, which applies the settings of the client for the
+// values unknown to the SDK. With -options, it rewrites the constructor of the service type, so
+// that its options have the fields set on the service after it is constructed (for example
+// VpcV1Options.EndpointResolver), applied before the service is configured from the external
+// configuration. With -requests, it rewrites the methods in the package, so that they send their
+// request with the request method of the service type, which reports the operation once all of its
+// attempts are made.
+//
+// It is run by "go generate" in the vpcv1 directory, after the service code is regenerated.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	serviceType := flag.String("type", "VpcV1", "the service type")
+	output := flag.String("output", "vpc_v1_api.go", "the interface file to write")
+	mock := flag.String("mock", "", "the mock file to write, if any")
+	mockPackage := flag.String("mock-package", "", "the package of the mock (default: the name of its directory)")
+	iterators := flag.String("iterators", "", "the iterator file to write, if any")
+	checkpoints := flag.String("checkpoints", "", "the checkpoint file to write, if any")
+	models := flag.String("models", "", "the model file to write, if any")
+	unions := flag.Bool("unions", false, "rewrite the Unmarshal functions of the discriminated unions to accept unknown variants")
+	extra := flag.Bool("extra", false, "rewrite the models to keep the JSON properties they do not declare")
+	apiErrors := flag.Bool("errors", false, "rewrite the methods to return problems unwrapping to *APIError values for error responses")
+	contexts := flag.Bool("context", false, "rewrite the methods to pass their operation on the context of their requests")
+	pagers := flag.Bool("pagers", false, "rewrite the pager types to be aliases of the generic Pager")
+	unmarshal := flag.Bool("unmarshal", false, "rewrite the methods to unmarshal their response with the unmarshalModel method")
+	options := flag.Bool("options", false, "rewrite the constructor of the service type to apply the options set on the service")
+	requests := flag.Bool("requests", false, "rewrite the methods to send their request with the request method")
+	flag.Parse()
+
+	if *unions {
+		count, err := rewriteFiles(".", rewriteUnions)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d discriminated unions rewritten\n", count)
+	}
+	if *extra {
+		count, err := rewriteFiles(".", rewriteModels)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d models rewritten\n", count)
+	}
+	if *apiErrors {
+		count, err := rewriteFiles(".", rewriteErrors)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d methods rewritten to return API errors\n", count)
+	}
+	if *contexts {
+		count, err := rewriteFiles(".", rewriteContexts)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d methods rewritten to pass their operation\n", count)
+	}
+	if *pagers {
+		count, err := rewriteFiles(".", rewritePagers)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d pager types rewritten\n", count)
+	}
+	if *unmarshal {
+		count, err := rewriteFiles(".", rewriteUnmarshal)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d methods rewritten to unmarshal with unmarshalModel\n", count)
+	}
+	if *options {
+		count, err := rewriteFiles(".", rewriteOptions)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d service options added\n", count)
+	}
+	if *requests {
+		count, err := rewriteFiles(".", rewriteRequests)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		fmt.Printf("apigen: %d methods rewritten to send their request with request\n", count)
+	}
+
+	api, err := loadAPI(".", *serviceType)
+	if err != nil {
+		log.Fatalf("apigen: %v", err)
+	}
+
+	source, err := api.interfaceFile()
+	if err != nil {
+		log.Fatalf("apigen: %v", err)
+	}
+	if err = os.WriteFile(*output, source, 0o644); err != nil {
+		log.Fatalf("apigen: %v", err)
+	}
+
+	if *mock != "" {
+		if *mockPackage == "" {
+			absolute, err := filepath.Abs(*mock)
+			if err != nil {
+				log.Fatalf("apigen: %v", err)
+			}
+			*mockPackage = filepath.Base(filepath.Dir(absolute))
+		}
+		source, err = api.mockFile(*mockPackage)
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		if err = os.MkdirAll(filepath.Dir(*mock), 0o755); err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		if err = os.WriteFile(*mock, source, 0o644); err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+	}
+	if *iterators != "" {
+		source, err = api.iteratorFile()
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		if err = os.WriteFile(*iterators, source, 0o644); err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+	}
+	if *checkpoints != "" {
+		source, err = api.checkpointFile()
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		if err = os.WriteFile(*checkpoints, source, 0o644); err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+	}
+	if *models != "" {
+		source, err = api.modelFile()
+		if err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+		if err = os.WriteFile(*models, source, 0o644); err != nil {
+			log.Fatalf("apigen: %v", err)
+		}
+	}
+	fmt.Printf("apigen: %d methods of %s\n", len(api.methods), *serviceType)
+}
+
+// rewriteFiles rewrites the non-test Go files in "dir" with "rewrite" (rewriteUnions,
+// rewriteModels, rewriteErrors, rewriteContexts, rewritePagers, rewriteUnmarshal, rewriteOptions
+// or rewriteRequests), and returns the number of rewritten declarations.
+func rewriteFiles(dir string, rewrite func(path string, source []byte) ([]byte, []string, error)) (int, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return count, err
+		}
+		rewritten, names, err := rewrite(path, source)
+		if err != nil {
+			return count, err
+		}
+		if len(names) == 0 {
+			continue
+		}
+		if err = os.WriteFile(path, rewritten, 0o644); err != nil {
+			return count, err
+		}
+		count += len(names)
+	}
+	return count, nil
+}
@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// serviceOption is a group of fields added to the options of the service type, which the
+// constructor of the service type applies with a method of the service, if they are set.
+type serviceOption struct {
+	fields []optionField
+
+	// The statements applying the fields, formatted with the names of the options and of the
+	// service, as in "if options.X != nil {\nservice.SetX(options.X)\n}\n".
+	apply string
+}
+
+// optionField is a field added to the options of the service type.
+type optionField struct {
+	name    string
+	typ     string
+	comment string
+}
+
+// configureServiceMethod is the method of the service type that configures it from the external
+// configuration, as core.BaseService.ConfigureService does, on the transport that the options of
+// the service may have wrapped.
+const configureServiceMethod = "configureService"
+
+// serviceOptions are the fields added to the options of the service type.
+var serviceOptions = []serviceOption{
+	{
+		fields: []optionField{{
+			name: "EndpointResolver",
+			typ:  "*EndpointResolver",
+			comment: "// The resolver selecting the endpoints that the requests are sent to (see\n" +
+				"// EndpointResolver). If nil, the requests are sent to the service URL.\n",
+		}},
+		apply: "if %[1]s.EndpointResolver != nil {\n" +
+			"err = %[2]s.SetEndpointResolver(%[1]s.EndpointResolver)\n" +
+			"if err != nil {\nreturn nil, err\n}\n}\n",
+	},
+	{
+		fields: []optionField{
+			{
+				name: "Application",
+				typ:  "string",
+				comment: "// The name of the application using the service, identified in the User-Agent\n" +
+					"// of the requests (see VpcV1.SetApplication). If empty, the SDK's User-Agent\n" +
+					"// is sent.\n",
+			},
+			{
+				name:    "ApplicationVersion",
+				typ:     "string",
+				comment: "// The version of the application.\n",
+			},
+			{
+				name:    "ApplicationProducts",
+				typ:     "[]string",
+				comment: "// Additional product tokens identifying the application, as in \"terraform/1.9.0\".\n",
+			},
+		},
+		apply: "if %[1]s.Application != \"\" {\n" +
+			"%[2]s.SetApplication(%[1]s.Application, %[1]s.ApplicationVersion, %[1]s.ApplicationProducts...)\n" +
+			"}\n",
+	},
+	{
+		fields: []optionField{{
+			name: "RateLimiter",
+			typ:  "*RateLimiter",
+			comment: "// The limit on the rate at which the requests are sent (see RateLimiter). If\n" +
+				"// nil, the requests are not limited.\n",
+		}},
+		apply: "if %[1]s.RateLimiter != nil {\n%[2]s.SetRateLimiter(%[1]s.RateLimiter)\n}\n",
+	},
+}
+
+// rewriteOptions rewrites the constructor of the service type in the Go file "path" with the
+// content "source", a function such as NewVpcV1 creating the service with core.NewBaseService, so
+// that its options have the fields of serviceOptions, which it applies to the service before
+// returning it. The functions configuring the service created by the constructor with
+// core.BaseService.ConfigureService, such as NewVpcV1UsingExternalConfig, are rewritten to call
+// the configureService method of the service type instead, as the options are applied before. It
+// returns the rewritten source and the names of the added fields, as in
+// VpcV1Options.EndpointResolver. The fields already in the options are left unchanged.
+func rewriteOptions(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	var constructors, functions []*ast.FuncDecl
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					if structType, ok := spec.Type.(*ast.StructType); ok {
+						structs[spec.Name.Name] = structType
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil || decl.Body == nil || !strings.HasPrefix(decl.Name.Name, "New") {
+				continue
+			}
+			if callsSelector(decl.Body, "core.NewBaseService") {
+				constructors = append(constructors, decl)
+			} else {
+				functions = append(functions, decl)
+			}
+		}
+	}
+
+	replacements := make(map[int]replacement)
+	var fields []string
+	for _, constructor := range constructors {
+		params, results := constructor.Type.Params.List, constructor.Type.Results
+		if len(params) != 1 || len(params[0].Names) != 1 || results == nil || len(results.List) == 0 || len(results.List[0].Names) != 1 {
+			continue
+		}
+		optionsType, ok := params[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		optionsName := exprString(optionsType.X)
+		structType := structs[optionsName]
+		last := len(constructor.Body.List) - 1
+		if structType == nil || last < 0 {
+			continue
+		}
+		if _, ok := constructor.Body.List[last].(*ast.ReturnStmt); !ok {
+			continue
+		}
+		options, service := params[0].Names[0].Name, results.List[0].Names[0].Name
+
+		var fieldText, applyText strings.Builder
+		for _, option := range serviceOptions {
+			if hasField(structType, option.fields[0].name) {
+				continue
+			}
+			for _, field := range option.fields {
+				fmt.Fprintf(&fieldText, "\n%s%s %s\n", field.comment, field.name, field.typ)
+				fields = append(fields, optionsName+"."+field.name)
+			}
+			fmt.Fprintf(&applyText, option.apply+"\n", options, service)
+		}
+		if fieldText.Len() == 0 {
+			continue
+		}
+		insertions := map[token.Pos]string{
+			structType.Fields.Closing:         fieldText.String(),
+			constructor.Body.List[last].Pos(): applyText.String(),
+		}
+		for pos, text := range insertions {
+			offset := fset.Position(pos).Offset
+			replacements[offset] = replacement{end: offset, text: text}
+		}
+	}
+	for _, function := range functions {
+		called := false
+		for _, constructor := range constructors {
+			called = called || callsFunction(function.Body, constructor.Name.Name)
+		}
+		if !called {
+			continue
+		}
+		ast.Inspect(function.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != "ConfigureService" {
+				return true
+			}
+			if service, ok := selector.X.(*ast.SelectorExpr); ok && service.Sel.Name == "Service" {
+				replacements[fset.Position(service.Sel.Pos()).Offset] = replacement{
+					end:  fset.Position(selector.End()).Offset,
+					text: configureServiceMethod,
+				}
+			}
+			return true
+		})
+	}
+	if len(replacements) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := replaceText(source, replacements)
+	if err != nil {
+		return nil, nil, err
+	}
+	return formatted, fields, nil
+}
+
+// callsSelector returns true if "body" calls the function or method "name", as in
+// core.NewBaseService.
+func callsSelector(body *ast.BlockStmt, name string) bool {
+	found := false
+	ast.Inspect(body, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok && exprString(call.Fun) == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
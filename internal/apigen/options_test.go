@@ -0,0 +1,94 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteOptions(t *testing.T) {
+	path := "testdata/options/service.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, fields, err := rewriteOptions(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{
+		"VpcV1Options.EndpointResolver",
+		"VpcV1Options.Application",
+		"VpcV1Options.ApplicationVersion",
+		"VpcV1Options.ApplicationProducts",
+		"VpcV1Options.RateLimiter",
+	}, fields)
+	text := string(rewritten)
+	assert.Contains(t, text, "\t// The API version, in format `YYYY-MM-DD`.\n"+
+		"\tVersion *string\n"+
+		"\n"+
+		"\t// The resolver selecting the endpoints that the requests are sent to (see\n"+
+		"\t// EndpointResolver). If nil, the requests are sent to the service URL.\n"+
+		"\tEndpointResolver *EndpointResolver\n"+
+		"\n"+
+		"\t// The name of the application using the service, identified in the User-Agent\n"+
+		"\t// of the requests (see VpcV1.SetApplication). If empty, the SDK's User-Agent\n"+
+		"\t// is sent.\n"+
+		"\tApplication string\n"+
+		"\n"+
+		"\t// The version of the application.\n"+
+		"\tApplicationVersion string\n"+
+		"\n"+
+		"\t// Additional product tokens identifying the application, as in \"terraform/1.9.0\".\n"+
+		"\tApplicationProducts []string\n"+
+		"\n"+
+		"\t// The limit on the rate at which the requests are sent (see RateLimiter). If\n"+
+		"\t// nil, the requests are not limited.\n"+
+		"\tRateLimiter *RateLimiter\n"+
+		"}\n")
+	assert.Contains(t, text, "\t\tVersion: options.Version,\n"+
+		"\t}\n"+
+		"\n"+
+		"\tif options.EndpointResolver != nil {\n"+
+		"\t\terr = service.SetEndpointResolver(options.EndpointResolver)\n"+
+		"\t\tif err != nil {\n"+
+		"\t\t\treturn nil, err\n"+
+		"\t\t}\n"+
+		"\t}\n"+
+		"\n"+
+		"\tif options.Application != \"\" {\n"+
+		"\t\tservice.SetApplication(options.Application, options.ApplicationVersion, options.ApplicationProducts...)\n"+
+		"\t}\n"+
+		"\n"+
+		"\tif options.RateLimiter != nil {\n"+
+		"\t\tservice.SetRateLimiter(options.RateLimiter)\n"+
+		"\t}\n"+
+		"\n"+
+		"\treturn\n"+
+		"}\n")
+
+	// The service is configured after the options are applied.
+	assert.Contains(t, text, "\terr = vpc.configureService(options.ServiceName)\n")
+	assert.NotContains(t, text, "ConfigureService(")
+
+	// The fields already in the options are left unchanged.
+	again, fields, err := rewriteOptions(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, fields)
+	assert.Equal(t, rewritten, again)
+}
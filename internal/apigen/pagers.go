@@ -0,0 +1,203 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// pagerFunction is the function of the service package that creates the Pager of a list
+// operation from its name, its options and the function retrieving its pages.
+const pagerFunction = "newListPager"
+
+// generatedPager is a pager type with the layout of the generated ones, a struct with the options
+// of its list operation and the start token of the next page.
+type generatedPager struct {
+	spec        *ast.TypeSpec
+	constructor *ast.FuncDecl
+	methods     []*ast.FuncDecl
+
+	// The items of a page, the method listing them, and the field of its result holding them.
+	itemType   string
+	listMethod string
+	itemsField string
+}
+
+// rewritePagers rewrites the pager types in the Go file "path" with the content "source", so that
+// they are aliases of the generic Pager: each generated pager type, such as VolumesPager, becomes
+// an alias of Pager[Volume], its methods are removed, since Pager has them, and its constructor
+// creates the Pager with newListPager, retrieving the pages with the list operation. It returns
+// the rewritten source and the names of the rewritten pager types. Aliases are left unchanged.
+func rewritePagers(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structs := make(map[string]*ast.StructType)
+	pagers := make(map[string]*generatedPager)
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec, ok := spec.(*ast.TypeSpec)
+			if !ok || spec.TypeParams != nil {
+				continue
+			}
+			structType, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			structs[spec.Name.Name] = structType
+			if strings.HasSuffix(spec.Name.Name, "Pager") && hasField(structType, "hasNext") && hasField(structType, "options") &&
+				hasField(structType, "client") && hasField(structType, "pageContext") {
+				pagers[spec.Name.Name] = &generatedPager{spec: spec}
+			}
+		}
+	}
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Body == nil {
+			continue
+		}
+		if pager := pagers[receiverType(decl.Recv)]; pager != nil {
+			pager.methods = append(pager.methods, decl)
+			if decl.Name.Name == "GetNextWithContext" {
+				pager.inspectGetNext(decl)
+			}
+		} else if pager := pagers[strings.TrimPrefix(decl.Name.Name, "New")]; pager != nil && len(decl.Recv.List[0].Names) == 1 {
+			pager.constructor = decl
+		}
+	}
+
+	replacements := make(map[int]replacement)
+	var names []string
+	for name, pager := range pagers {
+		if pager.constructor == nil || pager.itemType == "" || pager.listMethod == "" || pager.itemsField == "" {
+			continue
+		}
+		params := pager.constructor.Type.Params.List
+		if len(params) != 1 || len(params[0].Names) != 1 {
+			continue
+		}
+		optionsType, ok := params[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		copyStmt := optionsCopyStatement(pager.constructor.Body)
+		if copyStmt == nil {
+			continue
+		}
+		options := params[0].Names[0].Name
+		withLimit := false
+		if structType := structs[exprString(optionsType.X)]; structType != nil {
+			withLimit = hasField(structType, "Limit")
+		}
+
+		var body strings.Builder
+		fmt.Fprintf(&body, "optionsCopy := *%s\n", options)
+		fmt.Fprintf(&body, "pager = %s(%q, &optionsCopy, func(ctx context.Context, start *string, limit *int64) (page []%s, next *string, err error) {\n", pagerFunction, name, pager.itemType)
+		body.WriteString("pageOptions := optionsCopy\n")
+		if withLimit {
+			body.WriteString("pageOptions.Start, pageOptions.Limit = start, limit\n")
+		} else {
+			body.WriteString("pageOptions.Start = start\n")
+		}
+		fmt.Fprintf(&body, "result, _, err := %s.%s(ctx, &pageOptions)\n", pager.constructor.Recv.List[0].Names[0].Name, pager.listMethod)
+		body.WriteString("if err != nil {\nreturn\n}\n")
+		body.WriteString("if result.Next != nil {\nnext, err = GetStartToken(result.Next.Href)\n}\n")
+		fmt.Fprintf(&body, "page = result.%s\n", pager.itemsField)
+		body.WriteString("return\n})\n")
+		if withLimit {
+			fmt.Fprintf(&body, "if %s.Limit != nil {\npager.PageSize = *%s.Limit\n}\n", options, options)
+		}
+		body.WriteString("return\n")
+		replacements[fset.Position(copyStmt.Pos()).Offset] = replacement{
+			end:  fset.Position(pager.constructor.Body.Rbrace).Offset,
+			text: body.String(),
+		}
+
+		replacements[fset.Position(pager.spec.Name.End()).Offset] = replacement{
+			end:  fset.Position(pager.spec.Type.End()).Offset,
+			text: fmt.Sprintf(" = Pager[%s]", pager.itemType),
+		}
+		for _, method := range pager.methods {
+			start := method.Pos()
+			if method.Doc != nil {
+				start = method.Doc.Pos()
+			}
+			replacements[fset.Position(start).Offset] = replacement{end: fset.Position(method.End()).Offset}
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := replaceText(source, replacements)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(names)
+	return formatted, names, nil
+}
+
+// inspectGetNext records the item type, the list method and the field of its result holding the
+// items, from the GetNextWithContext method "decl" of the pager, in which they appear as in
+// result, _, err := pager.client.ListVolumesWithContext(ctx, pager.options) and
+// page = result.Volumes.
+func (pager *generatedPager) inspectGetNext(decl *ast.FuncDecl) {
+	if results := decl.Type.Results; results != nil && len(results.List) == 2 {
+		if page, ok := results.List[0].Type.(*ast.ArrayType); ok && page.Len == nil {
+			pager.itemType = exprString(page.Elt)
+		}
+	}
+	ast.Inspect(decl.Body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.CallExpr:
+			if selector, ok := node.Fun.(*ast.SelectorExpr); ok && exprString(selector.X) == "pager.client" {
+				pager.listMethod = selector.Sel.Name
+			}
+		case *ast.AssignStmt:
+			if len(node.Lhs) == 1 && len(node.Rhs) == 1 && exprString(node.Lhs[0]) == "page" {
+				if selector, ok := node.Rhs[0].(*ast.SelectorExpr); ok && exprString(selector.X) == "result" {
+					pager.itemsField = selector.Sel.Name
+				}
+			}
+		}
+		return true
+	})
+}
+
+// optionsCopyStatement returns the statement of the body of a generated pager constructor that
+// copies its options, as in optionsCopy := *options, or nil.
+func optionsCopyStatement(body *ast.BlockStmt) ast.Stmt {
+	for _, stmt := range body.List {
+		if assign, ok := stmt.(*ast.AssignStmt); ok && len(assign.Lhs) == 1 && exprString(assign.Lhs[0]) == "optionsCopy" {
+			return stmt
+		}
+	}
+	return nil
+}
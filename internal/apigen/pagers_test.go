@@ -0,0 +1,61 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewritePagers(t *testing.T) {
+	path := "testdata/methods/service.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, pagers, err := rewritePagers(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"VolumesPager"}, pagers)
+	text := string(rewritten)
+	assert.Contains(t, text, "// VolumesPager can be used to simplify the use of the \"ListVolumes\" method.\n"+
+		"type VolumesPager = Pager[Volume]\n")
+	assert.Contains(t, text, "\toptionsCopy := *options\n"+
+		"\tpager = newListPager(\"VolumesPager\", &optionsCopy, func(ctx context.Context, start *string, limit *int64) (page []Volume, next *string, err error) {\n"+
+		"\t\tpageOptions := optionsCopy\n"+
+		"\t\tpageOptions.Start, pageOptions.Limit = start, limit\n"+
+		"\t\tresult, _, err := vpc.ListVolumesWithContext(ctx, &pageOptions)\n"+
+		"\t\tif err != nil {\n\t\t\treturn\n\t\t}\n"+
+		"\t\tif result.Next != nil {\n\t\t\tnext, err = GetStartToken(result.Next.Href)\n\t\t}\n"+
+		"\t\tpage = result.Volumes\n"+
+		"\t\treturn\n"+
+		"\t})\n"+
+		"\tif options.Limit != nil {\n\t\tpager.PageSize = *options.Limit\n\t}\n"+
+		"\treturn\n}\n")
+
+	// The validation of the options is kept, and the methods of the pager are removed.
+	assert.Contains(t, text, "\"the 'options.Start' field should not be set\"")
+	assert.NotContains(t, text, "func (pager *VolumesPager)")
+	assert.NotContains(t, text, "GetNext invokes")
+
+	// The rewritten pagers are left unchanged.
+	again, pagers, err := rewritePagers(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, pagers)
+	assert.Equal(t, rewritten, again)
+}
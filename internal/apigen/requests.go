@@ -0,0 +1,77 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+)
+
+// requestMethod is the method of the service type that sends the request of a method, as
+// core.BaseService.Request does, and reports the operation once all of its attempts are made.
+const requestMethod = "request"
+
+// rewriteRequests rewrites the methods in the Go file "path" with the content "source", so that
+// they send their request with the request method of their receiver, as in
+// vpc.request(request, &rawResponse), rather than with vpc.Service.Request. The request method
+// itself, which calls vpc.Service.Request, is left unchanged. It returns the rewritten source and
+// the names of the rewritten methods. Rewritten methods are left unchanged.
+func rewriteRequests(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replacements := make(map[int]replacement)
+	var methods []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Body == nil || len(decl.Recv.List[0].Names) != 1 || decl.Name.Name == requestMethod {
+			continue
+		}
+		receiver := decl.Recv.List[0].Names[0].Name
+		rewritten := false
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || exprString(call.Fun) != receiver+".Service.Request" || len(call.Args) != 2 {
+				return true
+			}
+			replacements[fset.Position(call.Fun.Pos()).Offset] = replacement{
+				end:  fset.Position(call.Fun.End()).Offset,
+				text: receiver + "." + requestMethod,
+			}
+			rewritten = true
+			return true
+		})
+		if rewritten {
+			methods = append(methods, receiverType(decl.Recv)+"."+decl.Name.Name)
+		}
+	}
+	if len(methods) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := replaceText(source, replacements)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(methods)
+	return formatted, methods, nil
+}
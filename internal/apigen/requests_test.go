@@ -0,0 +1,48 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteRequests(t *testing.T) {
+	path := "testdata/methods/service.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, methods, err := rewriteRequests(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"VpcV1.DeleteVolumeWithContext", "VpcV1.GetInstanceWithContext"}, methods)
+	text := string(rewritten)
+	assert.Contains(t, text, "\tresponse, err = vpc.request(request, &rawResponse)\n")
+	assert.Contains(t, text, "\tresponse, err = vpc.request(request, nil)\n")
+	assert.Equal(t, 2, strings.Count(text, "vpc.request("))
+	// The request method still sends the request with the base service.
+	assert.Equal(t, 1, strings.Count(text, "vpc.Service.Request("))
+
+	// The rewritten methods are left unchanged.
+	again, methods, err := rewriteRequests(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, methods)
+	assert.Equal(t, rewritten, again)
+}
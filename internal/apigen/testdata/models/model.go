@@ -0,0 +1,95 @@
+package models
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Volume : Volume struct
+type Volume struct {
+	// The name for this volume.
+	Name *string `json:"name" validate:"required"`
+
+	// The capacity to use for the volume (in gigabytes).
+	Capacity *int64 `json:"capacity" validate:"required"`
+}
+
+// UnmarshalVolume unmarshals an instance of Volume from the specified map of raw messages.
+func UnmarshalVolume(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Volume)
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "capacity", &obj.Capacity)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// VolumeIdentity : Identifies a volume by a unique property.
+type VolumeIdentity struct {
+	// The unique identifier for this volume.
+	ID *string `json:"id,omitempty"`
+}
+
+type VolumeIdentityIntf interface {
+	isaVolumeIdentity() bool
+}
+
+// UnmarshalVolumeIdentity unmarshals an instance of VolumeIdentity from the specified map of raw messages.
+func UnmarshalVolumeIdentity(m map[string]json.RawMessage, result interface{}) (err error) {
+	var discValue string
+	err = core.UnmarshalPrimitive(m, "kind", &discValue)
+	if err != nil {
+		return
+	}
+	if discValue == "id" {
+		err = core.UnmarshalModel(m, "", result, UnmarshalVolume)
+	} else {
+		err = unmarshalUnknownVariant[VolumeIdentity](m, "kind", result)
+	}
+	return
+}
+
+// VolumeSpec : VolumeSpec struct
+type VolumeSpec struct {
+	// The name for this volume.
+	Name *string `json:"name,omitempty"`
+}
+
+// UnmarshalVolumeSpec unmarshals an instance of VolumeSpec from the specified map of raw messages.
+func UnmarshalVolumeSpec(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(VolumeSpec)
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// VolumeReference : VolumeReference struct
+type VolumeReference struct {
+	// The extra properties of the volume.
+	Extra *string `json:"extra,omitempty"`
+}
+
+// UnmarshalVolumeReference unmarshals an instance of VolumeReference from the specified map of raw messages.
+func UnmarshalVolumeReference(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(VolumeReference)
+	err = core.UnmarshalPrimitive(m, "extra", &obj.Extra)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+func unmarshalUnknownVariant[T any](m map[string]json.RawMessage, discriminator string, result interface{}) error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package vpcv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// VpcV1Options : Service options
+type VpcV1Options struct {
+	ServiceName   string
+	URL           string
+	Authenticator core.Authenticator
+
+	// The API version, in format `YYYY-MM-DD`.
+	Version *string
+}
+
+// NewVpcV1UsingExternalConfig : constructs an instance of VpcV1 with passed in options and external configuration.
+func NewVpcV1UsingExternalConfig(options *VpcV1Options) (vpc *VpcV1, err error) {
+	vpc, err = NewVpcV1(options)
+	err = core.RepurposeSDKProblem(err, "new-client-error")
+	if err != nil {
+		return
+	}
+
+	err = vpc.Service.ConfigureService(options.ServiceName)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "client-config-error", common.GetComponentInfo())
+		return
+	}
+	return
+}
+
+// NewVpcV1 : constructs an instance of VpcV1 with passed in options.
+func NewVpcV1(options *VpcV1Options) (service *VpcV1, err error) {
+	serviceOptions := &core.ServiceOptions{
+		URL:           DefaultServiceURL,
+		Authenticator: options.Authenticator,
+	}
+
+	baseService, err := core.NewBaseService(serviceOptions)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "new-base-error", common.GetComponentInfo())
+		return
+	}
+
+	service = &VpcV1{
+		Service: baseService,
+		Version: options.Version,
+	}
+
+	return
+}
+
+// NewInstancesPager returns a new InstancesPager instance.
+func (vpc *VpcV1) NewInstancesPager(options *ListInstancesOptions) (pager *InstancesPager, err error) {
+	return
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+type Service struct{}
+
+type Instance struct{}
+
+type GetInstanceOptions struct{}
+
+type Operation[T any] struct{}
+
+type Volume struct{}
+
+type ListVolumesOptions struct {
+	Start *string
+}
+
+type Pager[T any] struct{}
+
+type VolumesPager = Pager[Volume]
+
+type SnapshotsPager struct{}
+
+type KeysPager struct{}
+
+// GetInstance : Retrieve an instance
+// This request retrieves a single instance.
+func (service *Service) GetInstance(getInstanceOptions *GetInstanceOptions) (result *Instance, response *core.DetailedResponse, err error) {
+	return
+}
+
+// GetInstanceWithContext is an alternate form of the GetInstance method which supports a Context parameter
+func (service *Service) GetInstanceWithContext(ctx context.Context, getInstanceOptions *GetInstanceOptions) (result *Instance, response *core.DetailedResponse, err error) {
+	return
+}
+
+func (service *Service) NewInstancesPager(ids ...string) (*Operation[*Instance], error) {
+	return nil, nil
+}
+
+func (service *Service) WaitForVolumeAvailable(ctx context.Context, id string) (*Volume, error) {
+	return nil, nil
+}
+
+func (service *Service) NewVolumesPager(listVolumesOptions *ListVolumesOptions) (pager *VolumesPager, err error) {
+	return nil, nil
+}
+
+func (pager *KeysPager) HasNext() bool {
+	return false
+}
+
+func (pager *KeysPager) GetNextWithContext(ctx context.Context) (page []Instance, err error) {
+	return nil, nil
+}
+
+func (pager *SnapshotsPager) HasNext() bool {
+	return false
+}
+
+func (pager *SnapshotsPager) GetNextWithContext(ctx context.Context) (page []Volume, err error) {
+	return nil, nil
+}
+
+func (pager *SnapshotsPager) All(ctx context.Context) {
+}
+
+func (service *Service) ListVpcs(map[string]interface{}) {
+}
+
+func (service *Service) SetServiceURL(url string) error {
+	return nil
+}
+
+func (service *Service) Clone() *Service {
+	return service
+}
+
+func (service *Service) unexported() {
+}
+
+func (instance *Instance) GetName() string {
+	return ""
+}
+
+func (volume *Volume) DeepCopy() *Volume {
+	return volume
+}
+
+func UnmarshalInstance(m map[string]json.RawMessage, result interface{}) (err error) {
+	return
+}
+
+func UnmarshalVolume(m map[string]json.RawMessage, result interface{}) (err error) {
+	return
+}
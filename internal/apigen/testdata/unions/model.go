@@ -0,0 +1,91 @@
+package unions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// InstanceGroupManager : InstanceGroupManager struct
+type InstanceGroupManager struct {
+	ManagerType *string `json:"manager_type,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+type InstanceGroupManagerIntf interface {
+	isaInstanceGroupManager() bool
+}
+
+func (*InstanceGroupManager) isaInstanceGroupManager() bool {
+	return true
+}
+
+// UnmarshalInstanceGroupManager unmarshals an instance of InstanceGroupManager from the specified map of raw messages.
+func UnmarshalInstanceGroupManager(m map[string]json.RawMessage, result interface{}) (err error) {
+	// Retrieve discriminator value to determine correct "subclass".
+	var discValue string
+	err = core.UnmarshalPrimitive(m, "manager_type", &discValue)
+	if err != nil {
+		errMsg := fmt.Sprintf("error unmarshalling discriminator property 'manager_type': %s", err.Error())
+		err = core.SDKErrorf(err, errMsg, "discriminator-unmarshal-error", nil)
+		return
+	}
+	if discValue == "" {
+		err = core.SDKErrorf(err, "required discriminator property 'manager_type' not found in JSON object", "missing-discriminator", nil)
+		return
+	}
+	if discValue == "autoscale" {
+		err = core.UnmarshalModel(m, "", result, UnmarshalInstanceGroupManagerAutoScale)
+	} else if discValue == "scheduled" {
+		err = core.UnmarshalModel(m, "", result, UnmarshalInstanceGroupManagerAutoScale)
+	} else {
+		errMsg := fmt.Sprintf("unrecognized value for discriminator property 'manager_type': %s", discValue)
+		err = core.SDKErrorf(nil, errMsg, "invalid-discriminator", nil)
+	}
+	return
+}
+
+// UnmarshalInstanceGroupManagerAutoScale unmarshals an instance of InstanceGroupManagerAutoScale from the specified map of raw messages.
+func UnmarshalInstanceGroupManagerAutoScale(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(InstanceGroupManager)
+	err = core.UnmarshalPrimitive(m, "manager_type", &obj.ManagerType)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// SecurityGroupRule : SecurityGroupRule struct
+type SecurityGroupRule struct {
+	Protocol *string `json:"protocol,omitempty"`
+}
+
+// UnmarshalSecurityGroupRule unmarshals an instance of SecurityGroupRule from the specified map of raw messages.
+func UnmarshalSecurityGroupRule(m map[string]json.RawMessage, result interface{}) (err error) {
+	// Retrieve discriminator value to determine correct "subclass".
+	var discValue string
+	err = core.UnmarshalPrimitive(m, "protocol", &discValue)
+	if err != nil {
+		errMsg := fmt.Sprintf("error unmarshalling discriminator property 'protocol': %s", err.Error())
+		err = core.SDKErrorf(err, errMsg, "discriminator-unmarshal-error", nil)
+		return
+	}
+	if discValue == "all" {
+		err = core.UnmarshalModel(m, "", result, UnmarshalSecurityGroupRuleGeneric)
+	} else {
+		// Fallback to base SecurityGroupRule for unknown protocols
+		err = core.UnmarshalModel(m, "", result, UnmarshalSecurityGroupRuleGeneric)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "unmarshal-SecurityGroupRuleGeneric-error", nil)
+		}
+	}
+	return
+}
+
+// UnmarshalSecurityGroupRuleGeneric unmarshals the base SecurityGroupRule fields for unknown protocol types
+func UnmarshalSecurityGroupRuleGeneric(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SecurityGroupRule)
+	err = core.UnmarshalPrimitive(m, "protocol", &obj.Protocol)
+	return
+}
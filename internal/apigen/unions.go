@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// unknownDiscriminatorMessage is the start of the error message with which the generated Unmarshal
+// functions of discriminated unions reject unknown discriminator values.
+const unknownDiscriminatorMessage = "unrecognized value for discriminator property"
+
+// unknownVariantFunction is the function of the service package that unmarshals a value of a
+// discriminated union with an unknown discriminator value as the base model.
+const unknownVariantFunction = "unmarshalUnknownVariant"
+
+// markUnknownVariantFunction is the function of the service package that records that the base
+// model of a discriminated union is unmarshalled for an unknown discriminator value by a fallback
+// function, such as UnmarshalSecurityGroupRuleGeneric.
+const markUnknownVariantFunction = "markUnknownVariant"
+
+// rewriteUnions rewrites the Unmarshal functions of the discriminated unions in the Go file "path"
+// with the content "source", so that they unmarshal values with an unknown discriminator value as
+// the base model of the union instead of failing. The functions that already fall back to a
+// function unmarshalling the base model, as UnmarshalSecurityGroupRule does with
+// UnmarshalSecurityGroupRuleGeneric, are rewritten to record it with markUnknownVariant, so that
+// the values are reported like the others. It returns the rewritten source and the names of the
+// rewritten functions. Rewritten functions are left unchanged.
+func rewriteUnions(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+	structs := make(map[string]bool)
+	for _, decl := range file.Decls {
+		if decl, ok := decl.(*ast.GenDecl); ok {
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok {
+					if _, ok := spec.Type.(*ast.StructType); ok {
+						structs[spec.Name.Name] = true
+					}
+				}
+			}
+		}
+	}
+
+	type replacement struct {
+		start, end int
+		text       string
+	}
+	var replacements []replacement
+	var rewritten []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv != nil || decl.Body == nil || !strings.HasPrefix(decl.Name.Name, "Unmarshal") {
+			continue
+		}
+		model := strings.TrimPrefix(decl.Name.Name, "Unmarshal")
+		params := decl.Type.Params.List
+		if !structs[model] || len(params) != 2 || len(params[0].Names) != 1 || len(params[1].Names) != 1 {
+			continue
+		}
+		discriminator := discriminatorOf(decl.Body)
+		if discriminator == "" {
+			continue
+		}
+		rejection := unknownDiscriminatorBranch(decl.Body)
+		if rejection == nil {
+			fallback := fallbackBranch(decl.Body, "Unmarshal"+model+"Generic")
+			if fallback == nil || callsFunction(fallback, markUnknownVariantFunction) {
+				continue
+			}
+			offset := fset.Position(fallback.Lbrace).Offset + 1
+			replacements = append(replacements, replacement{
+				start: offset,
+				end:   offset,
+				text:  fmt.Sprintf("\n%s[%s](%q)", markUnknownVariantFunction, model, discriminator),
+			})
+			rewritten = append(rewritten, decl.Name.Name)
+			continue
+		}
+
+		text := fmt.Sprintf("{\n\terr = %s[%s](%s, %q, %s)\n}", unknownVariantFunction, model,
+			params[0].Names[0].Name, discriminator, params[1].Names[0].Name)
+		replacements = append(replacements, replacement{
+			start: fset.Position(rejection.Lbrace).Offset,
+			end:   fset.Position(rejection.Rbrace).Offset + 1,
+			text:  text,
+		})
+		rewritten = append(rewritten, decl.Name.Name)
+	}
+	if len(replacements) == 0 {
+		return source, nil, nil
+	}
+
+	var result bytes.Buffer
+	previous := 0
+	for _, replacement := range replacements {
+		result.Write(source[previous:replacement.start])
+		result.WriteString(replacement.text)
+		previous = replacement.end
+	}
+	result.Write(source[previous:])
+	formatted, err := format.Source(result.Bytes())
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(rewritten)
+	return formatted, rewritten, nil
+}
+
+// discriminatorOf returns the discriminator property read by the body of a generated Unmarshal
+// function, as in core.UnmarshalPrimitive(m, "protocol", &discValue), or "".
+func discriminatorOf(body *ast.BlockStmt) string {
+	discriminator := ""
+	ast.Inspect(body, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || discriminator != "" || len(call.Args) != 3 {
+			return discriminator == ""
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || selector.Sel.Name != "UnmarshalPrimitive" {
+			return true
+		}
+		property, ok := call.Args[1].(*ast.BasicLit)
+		target, isAddress := call.Args[2].(*ast.UnaryExpr)
+		if !ok || property.Kind != token.STRING || !isAddress || target.Op != token.AND {
+			return true
+		}
+		if ident, ok := target.X.(*ast.Ident); ok && ident.Name == "discValue" {
+			discriminator, _ = strconv.Unquote(property.Value)
+		}
+		return discriminator == ""
+	})
+	return discriminator
+}
+
+// unknownDiscriminatorBranch returns the final else branch of the if-else chain in "body" that
+// rejects unknown discriminator values, or nil.
+func unknownDiscriminatorBranch(body *ast.BlockStmt) *ast.BlockStmt {
+	var branch *ast.BlockStmt
+	ast.Inspect(body, func(node ast.Node) bool {
+		ifStmt, ok := node.(*ast.IfStmt)
+		if !ok || branch != nil {
+			return branch == nil
+		}
+		elseBlock, ok := ifStmt.Else.(*ast.BlockStmt)
+		if ok && rejectsDiscriminator(elseBlock) {
+			branch = elseBlock
+			return false
+		}
+		return true
+	})
+	return branch
+}
+
+// fallbackBranch returns the final else branch of the if-else chain in "body" that unmarshals
+// unknown discriminator values with the function "fallback", or nil.
+func fallbackBranch(body *ast.BlockStmt, fallback string) *ast.BlockStmt {
+	var branch *ast.BlockStmt
+	ast.Inspect(body, func(node ast.Node) bool {
+		ifStmt, ok := node.(*ast.IfStmt)
+		if !ok || branch != nil {
+			return branch == nil
+		}
+		elseBlock, ok := ifStmt.Else.(*ast.BlockStmt)
+		if ok && passesFunction(elseBlock, fallback) {
+			branch = elseBlock
+			return false
+		}
+		return true
+	})
+	return branch
+}
+
+// passesFunction returns true if "block" passes the function "name" to a call, as in
+// core.UnmarshalModel(m, "", result, UnmarshalSecurityGroupRuleGeneric).
+func passesFunction(block *ast.BlockStmt, name string) bool {
+	found := false
+	ast.Inspect(block, func(node ast.Node) bool {
+		if call, ok := node.(*ast.CallExpr); ok {
+			for _, arg := range call.Args {
+				if ident, ok := arg.(*ast.Ident); ok && ident.Name == name {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// rejectsDiscriminator returns true if "block" builds the error of an unknown discriminator value.
+func rejectsDiscriminator(block *ast.BlockStmt) bool {
+	found := false
+	ast.Inspect(block, func(node ast.Node) bool {
+		if literal, ok := node.(*ast.BasicLit); ok && literal.Kind == token.STRING {
+			value, _ := strconv.Unquote(literal.Value)
+			found = found || strings.HasPrefix(value, unknownDiscriminatorMessage)
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,59 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteUnions(t *testing.T) {
+	path := "testdata/unions/model.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, functions, err := rewriteUnions(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"UnmarshalInstanceGroupManager", "UnmarshalSecurityGroupRule"}, functions)
+	text := string(rewritten)
+	assert.Contains(t, text, "\t} else if discValue == \"scheduled\" {\n"+
+		"\t\terr = core.UnmarshalModel(m, \"\", result, UnmarshalInstanceGroupManagerAutoScale)\n"+
+		"\t} else {\n"+
+		"\t\terr = unmarshalUnknownVariant[InstanceGroupManager](m, \"manager_type\", result)\n"+
+		"\t}\n"+
+		"\treturn\n")
+	assert.NotContains(t, text, "unrecognized value for discriminator property")
+	assert.Contains(t, text, "required discriminator property 'manager_type' not found")
+	assert.Contains(t, text, "// UnmarshalInstanceGroupManager unmarshals an instance of InstanceGroupManager")
+
+	// The fallback to the base model is recorded, and only that of the final else branch.
+	assert.Contains(t, text, "\t} else {\n"+
+		"\t\tmarkUnknownVariant[SecurityGroupRule](\"protocol\")\n"+
+		"\t\t// Fallback to base SecurityGroupRule for unknown protocols\n"+
+		"\t\terr = core.UnmarshalModel(m, \"\", result, UnmarshalSecurityGroupRuleGeneric)\n")
+	assert.Equal(t, 1, strings.Count(text, "markUnknownVariant["))
+
+	// The rewritten functions are left unchanged.
+	again, functions, err := rewriteUnions(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, functions)
+	assert.Equal(t, rewritten, again)
+}
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+)
+
+// unmarshalMethod is the method of the service type that unmarshals the result of a method, as
+// core.UnmarshalModel does, and applies the settings of the client for the values unknown to the
+// SDK.
+const unmarshalMethod = "unmarshalModel"
+
+// rewriteUnmarshal rewrites the methods in the Go file "path" with the content "source", so that
+// they unmarshal their response with the unmarshalModel method of their receiver, as in
+// vpc.unmarshalModel(rawResponse, "", &result, UnmarshalInstance), rather than with
+// core.UnmarshalModel. It returns the rewritten source and the names of the rewritten methods.
+// Rewritten methods are left unchanged.
+func rewriteUnmarshal(path string, source []byte) ([]byte, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, source, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replacements := make(map[int]replacement)
+	var methods []string
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Body == nil || len(decl.Recv.List[0].Names) != 1 {
+			continue
+		}
+		receiver := decl.Recv.List[0].Names[0].Name
+		rewritten := false
+		ast.Inspect(decl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || exprString(call.Fun) != "core.UnmarshalModel" || len(call.Args) != 4 || exprString(call.Args[0]) != "rawResponse" {
+				return true
+			}
+			replacements[fset.Position(call.Fun.Pos()).Offset] = replacement{
+				end:  fset.Position(call.Fun.End()).Offset,
+				text: receiver + "." + unmarshalMethod,
+			}
+			rewritten = true
+			return true
+		})
+		if rewritten {
+			methods = append(methods, receiverType(decl.Recv)+"."+decl.Name.Name)
+		}
+	}
+	if len(methods) == 0 {
+		return source, nil, nil
+	}
+
+	formatted, err := replaceText(source, replacements)
+	if err != nil {
+		return nil, nil, err
+	}
+	slices.Sort(methods)
+	return formatted, methods, nil
+}
@@ -0,0 +1,47 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteUnmarshal(t *testing.T) {
+	path := "testdata/methods/service.go"
+	source, err := os.ReadFile(path)
+	require.Nil(t, err)
+
+	rewritten, methods, err := rewriteUnmarshal(path, source)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"VpcV1.GetInstanceWithContext"}, methods)
+	text := string(rewritten)
+	assert.Contains(t, text, "\tif rawResponse != nil {\n"+
+		"\t\terr = vpc.unmarshalModel(rawResponse, \"\", &result, UnmarshalInstance)\n")
+	assert.Equal(t, 1, strings.Count(text, "unmarshalModel("))
+	assert.NotContains(t, text, "core.UnmarshalModel(")
+
+	// The rewritten methods are left unchanged.
+	again, methods, err := rewriteUnmarshal(path, rewritten)
+	require.Nil(t, err)
+	assert.Empty(t, methods)
+	assert.Equal(t, rewritten, again)
+}
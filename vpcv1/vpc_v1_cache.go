@@ -0,0 +1,201 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultCacheTTL is the time a ResponseCache keeps responses when no TTL is set.
+const DefaultCacheTTL = 1 * time.Hour
+
+// DefaultCachedOperations are the read-only operations whose responses a ResponseCache keeps by
+// default. They return catalog data that rarely changes.
+var DefaultCachedOperations = []string{
+	"ListBareMetalServerProfiles",
+	"GetBareMetalServerProfile",
+	"ListInstanceProfiles",
+	"GetInstanceProfile",
+	"ListLoadBalancerProfiles",
+	"GetLoadBalancerProfile",
+	"ListOperatingSystems",
+	"GetOperatingSystem",
+	"ListRegions",
+	"GetRegion",
+	"ListRegionZones",
+	"GetRegionZone",
+	"ListVolumeProfiles",
+	"GetVolumeProfile",
+}
+
+// CacheStore : The storage of a ResponseCache
+// Values are stored by operation ID and by key within an operation. The methods are called
+// concurrently by the requests in flight. NewMemoryCacheStore and NewFileCacheStore return the
+// built-in stores.
+type CacheStore interface {
+	// Get returns the value stored for "key" by operation "operationID", unless it has expired.
+	Get(operationID string, key string) (value []byte, found bool)
+
+	// Set stores "value" for "key" by operation "operationID" until "expiration".
+	Set(operationID string, key string, value []byte, expiration time.Time)
+
+	// Invalidate deletes the values stored by operation "operationID", or all values if
+	// "operationID" is "".
+	Invalidate(operationID string)
+}
+
+// ResponseCache : A read-through cache of the responses of catalog operations
+// The successful responses of the cached operations are kept for the TTL, by method and URL, which
+// includes the region and the query parameters. Responses served from the cache do not reach the
+// other client-side request policies: they are not traced, logged or counted.
+//
+// The cache does not tell accounts apart, so only share it between clients of the same account.
+type ResponseCache struct {
+	// The time responses are kept.
+	TTL time.Duration
+
+	// The IDs of the cached operations.
+	Operations map[string]bool
+
+	store CacheStore
+}
+
+// NewResponseCache : Instantiate ResponseCache storing responses in "store" (an in-memory store if
+// nil), with the default TTL and operations.
+func NewResponseCache(store CacheStore) *ResponseCache {
+	if store == nil {
+		store = NewMemoryCacheStore()
+	}
+	cache := &ResponseCache{
+		TTL:        DefaultCacheTTL,
+		Operations: make(map[string]bool),
+		store:      store,
+	}
+	return cache.CacheOperations(DefaultCachedOperations...)
+}
+
+// SetTTL : Allow user to set TTL
+func (cache *ResponseCache) SetTTL(ttl time.Duration) *ResponseCache {
+	cache.TTL = ttl
+	return cache
+}
+
+// CacheOperations : Cache the responses of the specified read-only operations, in addition to the
+// current ones
+func (cache *ResponseCache) CacheOperations(operationIDs ...string) *ResponseCache {
+	if cache.Operations == nil {
+		cache.Operations = make(map[string]bool)
+	}
+	for _, operationID := range operationIDs {
+		cache.Operations[operationID] = true
+	}
+	return cache
+}
+
+// Invalidate : Discard the cached responses of the specified operations, or of all operations if
+// none is specified
+func (cache *ResponseCache) Invalidate(operationIDs ...string) {
+	if len(operationIDs) == 0 {
+		cache.store.Invalidate("")
+	}
+	for _, operationID := range operationIDs {
+		cache.store.Invalidate(operationID)
+	}
+}
+
+// SetResponseCache : Serve the responses of catalog operations from "cache"
+// A cache may be shared by several clients. A nil cache disables caching.
+func (vpc *VpcV1) SetResponseCache(cache *ResponseCache) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.responseCache = cache
+	})
+}
+
+// GetResponseCache : Return the cache set with SetResponseCache, or nil
+func (vpc *VpcV1) GetResponseCache() *ResponseCache {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.responseCache
+	}
+	return nil
+}
+
+// cachedResponse is the stored representation of a response.
+type cachedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       []byte      `json:"body"`
+}
+
+// uncachedHeaders are the response headers that only apply to the request that got the response.
+var uncachedHeaders = []string{"Date", "Set-Cookie", "X-Request-Id", "X-Correlation-Id", "Transaction-Id"}
+
+// roundTrip serves "req", sent on behalf of operation "operationID", from the cache if possible,
+// and otherwise sends it with "send" and caches the response.
+func (cache *ResponseCache) roundTrip(operationID string, req *http.Request,
+	send func(operationID string, req *http.Request) (*http.Response, error)) (*http.Response, error) {
+	if req.Method != http.MethodGet || !cache.Operations[operationID] {
+		return send(operationID, req)
+	}
+
+	key := req.Method + " " + req.URL.String()
+	if value, found := cache.store.Get(operationID, key); found {
+		var cached cachedResponse
+		if json.Unmarshal(value, &cached) == nil {
+			return &http.Response{
+				Status:        strconv.Itoa(cached.StatusCode) + " " + http.StatusText(cached.StatusCode),
+				StatusCode:    cached.StatusCode,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        maps.Clone(cached.Header),
+				Body:          io.NopCloser(bytes.NewReader(cached.Body)),
+				ContentLength: int64(len(cached.Body)),
+				Request:       req,
+			}, nil
+		}
+	}
+
+	resp, err := send(operationID, req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	cached := cachedResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}
+	for _, name := range uncachedHeaders {
+		cached.Header.Del(name)
+	}
+	if value, err := json.Marshal(cached); err == nil {
+		cache.store.Set(operationID, key, value, time.Now().Add(cache.TTL))
+	}
+	return resp, nil
+}
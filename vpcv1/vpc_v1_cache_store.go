@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// memoryCacheEntry is a value stored by a MemoryCacheStore.
+type memoryCacheEntry struct {
+	value      []byte
+	expiration time.Time
+}
+
+// MemoryCacheStore : A CacheStore that keeps values in memory
+type MemoryCacheStore struct {
+	mutex   sync.Mutex
+	entries map[string]map[string]memoryCacheEntry
+}
+
+var _ CacheStore = (*MemoryCacheStore)(nil)
+
+// NewMemoryCacheStore : Instantiate MemoryCacheStore
+func NewMemoryCacheStore() *MemoryCacheStore {
+	return &MemoryCacheStore{
+		entries: make(map[string]map[string]memoryCacheEntry),
+	}
+}
+
+// Get implements CacheStore.
+func (store *MemoryCacheStore) Get(operationID string, key string) ([]byte, bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	entry, found := store.entries[operationID][key]
+	if !found {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiration) {
+		delete(store.entries[operationID], key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set implements CacheStore.
+func (store *MemoryCacheStore) Set(operationID string, key string, value []byte, expiration time.Time) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if store.entries[operationID] == nil {
+		store.entries[operationID] = make(map[string]memoryCacheEntry)
+	}
+	store.entries[operationID][key] = memoryCacheEntry{value: value, expiration: expiration}
+}
+
+// Invalidate implements CacheStore.
+func (store *MemoryCacheStore) Invalidate(operationID string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if operationID == "" {
+		clear(store.entries)
+		return
+	}
+	delete(store.entries, operationID)
+}
+
+// fileCacheEntry is the content of a file written by a FileCacheStore.
+type fileCacheEntry struct {
+	Key        string    `json:"key"`
+	Expiration time.Time `json:"expiration"`
+	Value      []byte    `json:"value"`
+}
+
+// FileCacheStore : A CacheStore that keeps values in files, so that they outlive the process
+// Each value is a file in a subdirectory of the store's directory named after its operation. Several
+// processes may share a directory. Errors reading or writing the files are treated as cache misses.
+type FileCacheStore struct {
+	directory string
+}
+
+var _ CacheStore = (*FileCacheStore)(nil)
+
+// NewFileCacheStore : Instantiate FileCacheStore keeping its files in "directory", which is
+// created if needed
+func NewFileCacheStore(directory string) (*FileCacheStore, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileCacheStore{directory: directory}, nil
+}
+
+// path returns the path of the file of "key" by operation "operationID".
+func (store *FileCacheStore) path(operationID string, key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(store.directory, filepath.Base(operationID), hex.EncodeToString(hash[:])+".json")
+}
+
+// Get implements CacheStore.
+func (store *FileCacheStore) Get(operationID string, key string) ([]byte, bool) {
+	path := store.path(operationID, key)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var entry fileCacheEntry
+	if json.Unmarshal(data, &entry) != nil || entry.Key != key {
+		return nil, false
+	}
+	if !time.Now().Before(entry.Expiration) {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Set implements CacheStore.
+func (store *FileCacheStore) Set(operationID string, key string, value []byte, expiration time.Time) {
+	data, err := json.Marshal(fileCacheEntry{Key: key, Expiration: expiration, Value: value})
+	if err != nil {
+		return
+	}
+	path := store.path(operationID, key)
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+
+	// Write to a temporary file first, so that readers never see a partial file.
+	file, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+	}
+}
+
+// Invalidate implements CacheStore.
+func (store *FileCacheStore) Invalidate(operationID string) {
+	if operationID == "" {
+		entries, err := os.ReadDir(store.directory)
+		if err != nil {
+			return
+		}
+		for _, entry := range entries {
+			_ = os.RemoveAll(filepath.Join(store.directory, entry.Name()))
+		}
+		return
+	}
+	_ = os.RemoveAll(filepath.Join(store.directory, filepath.Base(operationID)))
+}
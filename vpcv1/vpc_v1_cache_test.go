@@ -0,0 +1,121 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const regionsBody = `{"regions": [{"name": "us-south"}, {"name": "eu-de"}]}`
+
+var _ = Describe(`ResponseCache`, func() {
+	It(`Should serve catalog operations from the cache`, func() {
+		server, count := newSequenceServer(regionsBody)
+		defer server.Close()
+
+		cache := vpcv1.NewResponseCache(nil)
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetResponseCache(cache)
+		otherService := newTestVpcService(server.URL)
+		otherService.SetResponseCache(cache)
+		Expect(vpcService.GetResponseCache()).To(Equal(cache))
+
+		for _, service := range []*vpcv1.VpcV1{vpcService, vpcService, otherService} {
+			regions, response, err := service.ListRegions(service.NewListRegionsOptions())
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(200))
+			Expect(regions.Regions).To(HaveLen(2))
+		}
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		// Other operations are not cached.
+		for i := 0; i < 2; i++ {
+			_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+			Expect(err).To(BeNil())
+		}
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+
+		cache.Invalidate("ListRegions")
+		_, _, err := vpcService.ListRegions(vpcService.NewListRegionsOptions())
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(4)))
+
+		cache.Invalidate()
+		vpcService.SetResponseCache(nil)
+		_, _, err = vpcService.ListRegions(vpcService.NewListRegionsOptions())
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(5)))
+	})
+	It(`Should expire responses`, func() {
+		server, count := newSequenceServer(regionsBody)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetResponseCache(vpcv1.NewResponseCache(nil).SetTTL(20 * time.Millisecond))
+		_, _, err := vpcService.ListRegions(vpcService.NewListRegionsOptions())
+		Expect(err).To(BeNil())
+		time.Sleep(30 * time.Millisecond)
+		_, _, err = vpcService.ListRegions(vpcService.NewListRegionsOptions())
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+	})
+	It(`Should not cache errors`, func() {
+		server, count := newSequenceServer("", regionsBody)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetResponseCache(vpcv1.NewResponseCache(nil))
+		_, _, err := vpcService.ListRegions(vpcService.NewListRegionsOptions())
+		Expect(err).ToNot(BeNil())
+		for i := 0; i < 2; i++ {
+			_, _, err = vpcService.ListRegions(vpcService.NewListRegionsOptions())
+			Expect(err).To(BeNil())
+		}
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+	})
+	It(`Should keep responses on disk`, func() {
+		server, count := newSequenceServer(regionsBody)
+		defer server.Close()
+		directory, err := os.MkdirTemp("", "vpc-cache")
+		Expect(err).To(BeNil())
+		defer os.RemoveAll(directory)
+
+		for i := 0; i < 2; i++ {
+			store, err := vpcv1.NewFileCacheStore(directory)
+			Expect(err).To(BeNil())
+			vpcService := newTestVpcService(server.URL)
+			vpcService.SetResponseCache(vpcv1.NewResponseCache(store))
+			regions, _, err := vpcService.ListRegions(vpcService.NewListRegionsOptions())
+			Expect(err).To(BeNil())
+			Expect(*regions.Regions[1].Name).To(Equal("eu-de"))
+		}
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		store, err := vpcv1.NewFileCacheStore(directory)
+		Expect(err).To(BeNil())
+		store.Invalidate("")
+		entries, err := os.ReadDir(directory)
+		Expect(err).To(BeNil())
+		Expect(entries).To(BeEmpty())
+	})
+})
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// The Resume constructor of each pager of VpcV1 (for example VpcV1.ResumeInstancesPager) is
+// generated into vpc_v1_checkpoints_gen.go by apigen (see vpc_v1_generate.go).
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// PagerCheckpoint : The progress of a pager, from which the pagination can be resumed
+// A checkpoint holds the options the pager was created with and the start token of the next page.
+// It can be serialized to JSON, so that a long pagination, persisting a checkpoint after each page,
+// can continue where it stopped after a crash or a restart.
+//
+// The start tokens of the service expire, so checkpoints are meant to be resumed within hours.
+type PagerCheckpoint struct {
+	// The type of the pager, for example "InstancesPager", or "" for a Pager.
+	Pager string `json:"pager,omitempty"`
+
+	// The options the pager was created with, as JSON.
+	Options json.RawMessage `json:"options,omitempty"`
+
+	// The start token of the next page, or nil for the first page.
+	Start *string `json:"start,omitempty"`
+
+	// The number of items returned by the pager so far, if known.
+	Count int64 `json:"count,omitempty"`
+
+	// Whether all pages were retrieved.
+	Done bool `json:"done,omitempty"`
+}
+
+// checkPager returns an error if the checkpoint is not that of a pager of type "pager".
+func (checkpoint *PagerCheckpoint) checkPager(pager string) error {
+	if checkpoint == nil {
+		return core.SDKErrorf(nil, "checkpoint cannot be nil", "no-checkpoint", common.GetComponentInfo())
+	}
+	if checkpoint.Pager != pager {
+		err := fmt.Errorf("the checkpoint of a %s cannot resume a %s", pagerTypeName(checkpoint.Pager), pagerTypeName(pager))
+		return core.SDKErrorf(err, "", "checkpoint-pager-mismatch", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// pagerTypeName returns the name of the pager type "pager" for the error messages.
+func pagerTypeName(pager string) string {
+	if pager == "" {
+		return "Pager"
+	}
+	return pager
+}
+
+// decode unmarshals the options of the checkpoint, which must be that of a pager of type "pager",
+// into "options".
+func (checkpoint *PagerCheckpoint) decode(pager string, options interface{}) error {
+	if err := checkpoint.checkPager(pager); err != nil {
+		return err
+	}
+	if len(checkpoint.Options) > 0 {
+		if err := json.Unmarshal(checkpoint.Options, options); err != nil {
+			return core.SDKErrorf(err, "", "checkpoint-options-error", common.GetComponentInfo())
+		}
+	}
+	return nil
+}
+
+// Checkpoint : Return the progress of the pager, from which SetCheckpoint resumes
+// The checkpoint reflects the pages returned so far, not a page retrieved in the background. The
+// checkpoint of a pager of VpcV1, such as InstancesPager, holds its type and options, from which
+// its Resume constructor (for example ResumeInstancesPager) creates the pager again.
+func (pager *Pager[T]) Checkpoint() (checkpoint *PagerCheckpoint, err error) {
+	checkpoint = &PagerCheckpoint{
+		Pager: pager.name,
+		Start: pager.start,
+		Count: pager.count,
+		Done:  !pager.hasNext,
+	}
+	if checkpoint.Options, err = pager.encodeOptions(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// encodeOptions returns the options of the list operation of the pager as JSON, or nil if it has
+// none.
+func (pager *Pager[T]) encodeOptions() (json.RawMessage, error) {
+	if pager.options == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(pager.options)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "checkpoint-options-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// SetCheckpoint : Resume the pagination from "checkpoint", returned by the Checkpoint method of a
+// pager over the same list operation with the same parameters
+// An error is returned, and the pager is left unchanged, if the checkpoint is that of another type
+// of pager, or of a pager created with other options.
+func (pager *Pager[T]) SetCheckpoint(checkpoint *PagerCheckpoint) error {
+	if err := checkpoint.checkPager(pager.name); err != nil {
+		return err
+	}
+	options, err := pager.encodeOptions()
+	if err != nil {
+		return err
+	}
+	if !jsonEqual(options, checkpoint.Options) {
+		err := fmt.Errorf("the options of the checkpoint (%s) are not those of the %s (%s)", checkpoint.Options, pagerTypeName(pager.name), options)
+		return core.SDKErrorf(err, "", "checkpoint-options-mismatch", common.GetComponentInfo())
+	}
+	pager.start = checkpoint.Start
+	pager.count = checkpoint.Count
+	pager.hasNext = !checkpoint.Done
+	pager.prefetch = nil
+	return nil
+}
@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`PagerCheckpoint`, func() {
+	It(`Should resume a Pager from a serialized checkpoint`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+		defer server.Close()
+		vpcService := newTestVpcService(server.URL)
+
+		pager := newInstancesPager(vpcService).SetPageSize(2).SetMaxItems(4)
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-1", "i-2"}))
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+		data, err := json.Marshal(checkpoint)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"pager":"InstancesPager","options":{"Headers":null},"start":"2","count":2}`))
+
+		var decoded vpcv1.PagerCheckpoint
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		resumed := newInstancesPager(vpcService).SetPageSize(2).SetMaxItems(4)
+		Expect(resumed.SetCheckpoint(&decoded)).To(Succeed())
+		all, err := resumed.GetAll()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(all)).To(Equal([]string{"i-3", "i-4"}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+		checkpoint, err = resumed.Checkpoint()
+		Expect(err).To(BeNil())
+		Expect(checkpoint).To(Equal(&vpcv1.PagerCheckpoint{Pager: "InstancesPager", Options: json.RawMessage(`{"Headers":null}`), Count: 4, Done: true}))
+	})
+	It(`Should resume a pager of VpcV1 with its options`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+		defer server.Close()
+		vpcService := newTestVpcService(server.URL)
+
+		pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{Limit: core.Int64Ptr(3)})
+		Expect(err).To(BeNil())
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-1", "i-2", "i-3"}))
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+		Expect(string(checkpoint.Options)).To(Equal(`{"limit":3,"Headers":null}`))
+
+		resumed, err := vpcService.ResumeInstancesPager(checkpoint)
+		Expect(err).To(BeNil())
+		Expect(resumed.PageSize).To(Equal(int64(3)))
+		all, err := resumed.GetAll()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(all)).To(Equal([]string{"i-4", "i-5"}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+
+		_, err = vpcService.ResumeVolumesPager(checkpoint)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Should not retrieve pages from a checkpoint of a finished pagination`, func() {
+		server, count := newInstancePagesServer("i-1")
+		defer server.Close()
+
+		pager := newInstancesPager(newTestVpcService(server.URL))
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+		checkpoint.Done = true
+		Expect(pager.SetCheckpoint(checkpoint)).To(Succeed())
+		Expect(pager.HasNext()).To(BeFalse())
+		all, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(all).To(BeEmpty())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(0)))
+	})
+	It(`Should reject the checkpoint of another pager`, func() {
+		server, _ := newInstancePagesServer("i-1", "i-2", "i-3")
+		defer server.Close()
+		vpcService := newTestVpcService(server.URL)
+
+		pager := newInstancesPager(vpcService)
+		_, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		checkpoint, err := pager.Checkpoint()
+		Expect(err).To(BeNil())
+
+		// A pager created with other options.
+		other, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{Name: core.StringPtr("web")})
+		Expect(err).To(BeNil())
+		err = other.SetCheckpoint(checkpoint)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`are not those of the InstancesPager`))
+		Expect(other.Checkpoint()).To(HaveField("Start", BeNil()))
+
+		// A pager of another type.
+		volumes, err := vpcService.NewVolumesPager(&vpcv1.ListVolumesOptions{})
+		Expect(err).To(BeNil())
+		err = volumes.SetCheckpoint(checkpoint)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`the checkpoint of a InstancesPager cannot resume a VolumesPager`))
+
+		// A Pager created with NewPager.
+		items := vpcv1.NewPager(func(ctx context.Context, start *string, limit *int64) ([]vpcv1.Instance, *string, error) {
+			return nil, nil, nil
+		})
+		Expect(items.SetCheckpoint(checkpoint)).ToNot(Succeed())
+		Expect(pager.SetCheckpoint(&vpcv1.PagerCheckpoint{Done: true})).ToNot(Succeed())
+		Expect(pager.SetCheckpoint(nil)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,1283 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package vpcv1
+
+// ResumeBackupPoliciesPager : Instantiate BackupPoliciesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBackupPoliciesPager(checkpoint *PagerCheckpoint) (pager *BackupPoliciesPager, err error) {
+	options := &ListBackupPoliciesOptions{}
+	if err = checkpoint.decode("BackupPoliciesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBackupPoliciesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeBackupPolicyJobsPager : Instantiate BackupPolicyJobsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBackupPolicyJobsPager(checkpoint *PagerCheckpoint) (pager *BackupPolicyJobsPager, err error) {
+	options := &ListBackupPolicyJobsOptions{}
+	if err = checkpoint.decode("BackupPolicyJobsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBackupPolicyJobsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeBareMetalServerNetworkAttachmentsPager : Instantiate BareMetalServerNetworkAttachmentsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBareMetalServerNetworkAttachmentsPager(checkpoint *PagerCheckpoint) (pager *BareMetalServerNetworkAttachmentsPager, err error) {
+	options := &ListBareMetalServerNetworkAttachmentsOptions{}
+	if err = checkpoint.decode("BareMetalServerNetworkAttachmentsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBareMetalServerNetworkAttachmentsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeBareMetalServerNetworkInterfacesPager : Instantiate BareMetalServerNetworkInterfacesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBareMetalServerNetworkInterfacesPager(checkpoint *PagerCheckpoint) (pager *BareMetalServerNetworkInterfacesPager, err error) {
+	options := &ListBareMetalServerNetworkInterfacesOptions{}
+	if err = checkpoint.decode("BareMetalServerNetworkInterfacesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBareMetalServerNetworkInterfacesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeBareMetalServerProfilesPager : Instantiate BareMetalServerProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBareMetalServerProfilesPager(checkpoint *PagerCheckpoint) (pager *BareMetalServerProfilesPager, err error) {
+	options := &ListBareMetalServerProfilesOptions{}
+	if err = checkpoint.decode("BareMetalServerProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBareMetalServerProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeBareMetalServersPager : Instantiate BareMetalServersPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeBareMetalServersPager(checkpoint *PagerCheckpoint) (pager *BareMetalServersPager, err error) {
+	options := &ListBareMetalServersOptions{}
+	if err = checkpoint.decode("BareMetalServersPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewBareMetalServersPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeClusterNetworkInterfacesPager : Instantiate ClusterNetworkInterfacesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeClusterNetworkInterfacesPager(checkpoint *PagerCheckpoint) (pager *ClusterNetworkInterfacesPager, err error) {
+	options := &ListClusterNetworkInterfacesOptions{}
+	if err = checkpoint.decode("ClusterNetworkInterfacesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewClusterNetworkInterfacesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeClusterNetworkProfilesPager : Instantiate ClusterNetworkProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeClusterNetworkProfilesPager(checkpoint *PagerCheckpoint) (pager *ClusterNetworkProfilesPager, err error) {
+	options := &ListClusterNetworkProfilesOptions{}
+	if err = checkpoint.decode("ClusterNetworkProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewClusterNetworkProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeClusterNetworkSubnetReservedIpsPager : Instantiate ClusterNetworkSubnetReservedIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeClusterNetworkSubnetReservedIpsPager(checkpoint *PagerCheckpoint) (pager *ClusterNetworkSubnetReservedIpsPager, err error) {
+	options := &ListClusterNetworkSubnetReservedIpsOptions{}
+	if err = checkpoint.decode("ClusterNetworkSubnetReservedIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewClusterNetworkSubnetReservedIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeClusterNetworkSubnetsPager : Instantiate ClusterNetworkSubnetsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeClusterNetworkSubnetsPager(checkpoint *PagerCheckpoint) (pager *ClusterNetworkSubnetsPager, err error) {
+	options := &ListClusterNetworkSubnetsOptions{}
+	if err = checkpoint.decode("ClusterNetworkSubnetsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewClusterNetworkSubnetsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeClusterNetworksPager : Instantiate ClusterNetworksPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeClusterNetworksPager(checkpoint *PagerCheckpoint) (pager *ClusterNetworksPager, err error) {
+	options := &ListClusterNetworksOptions{}
+	if err = checkpoint.decode("ClusterNetworksPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewClusterNetworksPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeDedicatedHostGroupsPager : Instantiate DedicatedHostGroupsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeDedicatedHostGroupsPager(checkpoint *PagerCheckpoint) (pager *DedicatedHostGroupsPager, err error) {
+	options := &ListDedicatedHostGroupsOptions{}
+	if err = checkpoint.decode("DedicatedHostGroupsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewDedicatedHostGroupsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeDedicatedHostProfilesPager : Instantiate DedicatedHostProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeDedicatedHostProfilesPager(checkpoint *PagerCheckpoint) (pager *DedicatedHostProfilesPager, err error) {
+	options := &ListDedicatedHostProfilesOptions{}
+	if err = checkpoint.decode("DedicatedHostProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewDedicatedHostProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeDedicatedHostsPager : Instantiate DedicatedHostsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeDedicatedHostsPager(checkpoint *PagerCheckpoint) (pager *DedicatedHostsPager, err error) {
+	options := &ListDedicatedHostsOptions{}
+	if err = checkpoint.decode("DedicatedHostsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewDedicatedHostsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeEndpointGatewayIpsPager : Instantiate EndpointGatewayIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeEndpointGatewayIpsPager(checkpoint *PagerCheckpoint) (pager *EndpointGatewayIpsPager, err error) {
+	options := &ListEndpointGatewayIpsOptions{}
+	if err = checkpoint.decode("EndpointGatewayIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewEndpointGatewayIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeEndpointGatewayResourceBindingsPager : Instantiate EndpointGatewayResourceBindingsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeEndpointGatewayResourceBindingsPager(checkpoint *PagerCheckpoint) (pager *EndpointGatewayResourceBindingsPager, err error) {
+	options := &ListEndpointGatewayResourceBindingsOptions{}
+	if err = checkpoint.decode("EndpointGatewayResourceBindingsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewEndpointGatewayResourceBindingsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeEndpointGatewaysPager : Instantiate EndpointGatewaysPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeEndpointGatewaysPager(checkpoint *PagerCheckpoint) (pager *EndpointGatewaysPager, err error) {
+	options := &ListEndpointGatewaysOptions{}
+	if err = checkpoint.decode("EndpointGatewaysPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewEndpointGatewaysPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeFloatingIpsPager : Instantiate FloatingIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeFloatingIpsPager(checkpoint *PagerCheckpoint) (pager *FloatingIpsPager, err error) {
+	options := &ListFloatingIpsOptions{}
+	if err = checkpoint.decode("FloatingIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewFloatingIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeFlowLogCollectorsPager : Instantiate FlowLogCollectorsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeFlowLogCollectorsPager(checkpoint *PagerCheckpoint) (pager *FlowLogCollectorsPager, err error) {
+	options := &ListFlowLogCollectorsOptions{}
+	if err = checkpoint.decode("FlowLogCollectorsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewFlowLogCollectorsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeIkePoliciesPager : Instantiate IkePoliciesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeIkePoliciesPager(checkpoint *PagerCheckpoint) (pager *IkePoliciesPager, err error) {
+	options := &ListIkePoliciesOptions{}
+	if err = checkpoint.decode("IkePoliciesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewIkePoliciesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeIkePolicyConnectionsPager : Instantiate IkePolicyConnectionsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeIkePolicyConnectionsPager(checkpoint *PagerCheckpoint) (pager *IkePolicyConnectionsPager, err error) {
+	options := &ListIkePolicyConnectionsOptions{}
+	if err = checkpoint.decode("IkePolicyConnectionsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewIkePolicyConnectionsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeImageBareMetalServerProfilesPager : Instantiate ImageBareMetalServerProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeImageBareMetalServerProfilesPager(checkpoint *PagerCheckpoint) (pager *ImageBareMetalServerProfilesPager, err error) {
+	options := &ListImageBareMetalServerProfilesOptions{}
+	if err = checkpoint.decode("ImageBareMetalServerProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewImageBareMetalServerProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeImageInstanceProfilesPager : Instantiate ImageInstanceProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeImageInstanceProfilesPager(checkpoint *PagerCheckpoint) (pager *ImageInstanceProfilesPager, err error) {
+	options := &ListImageInstanceProfilesOptions{}
+	if err = checkpoint.decode("ImageInstanceProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewImageInstanceProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeImagesPager : Instantiate ImagesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeImagesPager(checkpoint *PagerCheckpoint) (pager *ImagesPager, err error) {
+	options := &ListImagesOptions{}
+	if err = checkpoint.decode("ImagesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewImagesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceClusterNetworkAttachmentsPager : Instantiate InstanceClusterNetworkAttachmentsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceClusterNetworkAttachmentsPager(checkpoint *PagerCheckpoint) (pager *InstanceClusterNetworkAttachmentsPager, err error) {
+	options := &ListInstanceClusterNetworkAttachmentsOptions{}
+	if err = checkpoint.decode("InstanceClusterNetworkAttachmentsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceClusterNetworkAttachmentsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceGroupManagerActionsPager : Instantiate InstanceGroupManagerActionsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceGroupManagerActionsPager(checkpoint *PagerCheckpoint) (pager *InstanceGroupManagerActionsPager, err error) {
+	options := &ListInstanceGroupManagerActionsOptions{}
+	if err = checkpoint.decode("InstanceGroupManagerActionsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceGroupManagerActionsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceGroupManagerPoliciesPager : Instantiate InstanceGroupManagerPoliciesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceGroupManagerPoliciesPager(checkpoint *PagerCheckpoint) (pager *InstanceGroupManagerPoliciesPager, err error) {
+	options := &ListInstanceGroupManagerPoliciesOptions{}
+	if err = checkpoint.decode("InstanceGroupManagerPoliciesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceGroupManagerPoliciesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceGroupManagersPager : Instantiate InstanceGroupManagersPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceGroupManagersPager(checkpoint *PagerCheckpoint) (pager *InstanceGroupManagersPager, err error) {
+	options := &ListInstanceGroupManagersOptions{}
+	if err = checkpoint.decode("InstanceGroupManagersPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceGroupManagersPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceGroupMembershipsPager : Instantiate InstanceGroupMembershipsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceGroupMembershipsPager(checkpoint *PagerCheckpoint) (pager *InstanceGroupMembershipsPager, err error) {
+	options := &ListInstanceGroupMembershipsOptions{}
+	if err = checkpoint.decode("InstanceGroupMembershipsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceGroupMembershipsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceGroupsPager : Instantiate InstanceGroupsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceGroupsPager(checkpoint *PagerCheckpoint) (pager *InstanceGroupsPager, err error) {
+	options := &ListInstanceGroupsOptions{}
+	if err = checkpoint.decode("InstanceGroupsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceGroupsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceNetworkInterfaceIpsPager : Instantiate InstanceNetworkInterfaceIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceNetworkInterfaceIpsPager(checkpoint *PagerCheckpoint) (pager *InstanceNetworkInterfaceIpsPager, err error) {
+	options := &ListInstanceNetworkInterfaceIpsOptions{}
+	if err = checkpoint.decode("InstanceNetworkInterfaceIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceNetworkInterfaceIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstanceProfilesPager : Instantiate InstanceProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstanceProfilesPager(checkpoint *PagerCheckpoint) (pager *InstanceProfilesPager, err error) {
+	options := &ListInstanceProfilesOptions{}
+	if err = checkpoint.decode("InstanceProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstanceProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeInstancesPager : Instantiate InstancesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeInstancesPager(checkpoint *PagerCheckpoint) (pager *InstancesPager, err error) {
+	options := &ListInstancesOptions{}
+	if err = checkpoint.decode("InstancesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewInstancesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeIpsecPoliciesPager : Instantiate IpsecPoliciesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeIpsecPoliciesPager(checkpoint *PagerCheckpoint) (pager *IpsecPoliciesPager, err error) {
+	options := &ListIpsecPoliciesOptions{}
+	if err = checkpoint.decode("IpsecPoliciesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewIpsecPoliciesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeIpsecPolicyConnectionsPager : Instantiate IpsecPolicyConnectionsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeIpsecPolicyConnectionsPager(checkpoint *PagerCheckpoint) (pager *IpsecPolicyConnectionsPager, err error) {
+	options := &ListIpsecPolicyConnectionsOptions{}
+	if err = checkpoint.decode("IpsecPolicyConnectionsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewIpsecPolicyConnectionsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeKeysPager : Instantiate KeysPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeKeysPager(checkpoint *PagerCheckpoint) (pager *KeysPager, err error) {
+	options := &ListKeysOptions{}
+	if err = checkpoint.decode("KeysPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewKeysPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeLoadBalancerProfilesPager : Instantiate LoadBalancerProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeLoadBalancerProfilesPager(checkpoint *PagerCheckpoint) (pager *LoadBalancerProfilesPager, err error) {
+	options := &ListLoadBalancerProfilesOptions{}
+	if err = checkpoint.decode("LoadBalancerProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewLoadBalancerProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeLoadBalancersPager : Instantiate LoadBalancersPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeLoadBalancersPager(checkpoint *PagerCheckpoint) (pager *LoadBalancersPager, err error) {
+	options := &ListLoadBalancersOptions{}
+	if err = checkpoint.decode("LoadBalancersPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewLoadBalancersPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeNetworkACLRulesPager : Instantiate NetworkACLRulesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeNetworkACLRulesPager(checkpoint *PagerCheckpoint) (pager *NetworkACLRulesPager, err error) {
+	options := &ListNetworkACLRulesOptions{}
+	if err = checkpoint.decode("NetworkACLRulesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewNetworkACLRulesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeNetworkAclsPager : Instantiate NetworkAclsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeNetworkAclsPager(checkpoint *PagerCheckpoint) (pager *NetworkAclsPager, err error) {
+	options := &ListNetworkAclsOptions{}
+	if err = checkpoint.decode("NetworkAclsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewNetworkAclsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeNetworkInterfaceFloatingIpsPager : Instantiate NetworkInterfaceFloatingIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeNetworkInterfaceFloatingIpsPager(checkpoint *PagerCheckpoint) (pager *NetworkInterfaceFloatingIpsPager, err error) {
+	options := &ListNetworkInterfaceFloatingIpsOptions{}
+	if err = checkpoint.decode("NetworkInterfaceFloatingIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewNetworkInterfaceFloatingIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeOperatingSystemsPager : Instantiate OperatingSystemsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeOperatingSystemsPager(checkpoint *PagerCheckpoint) (pager *OperatingSystemsPager, err error) {
+	options := &ListOperatingSystemsOptions{}
+	if err = checkpoint.decode("OperatingSystemsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewOperatingSystemsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePlacementGroupsPager : Instantiate PlacementGroupsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePlacementGroupsPager(checkpoint *PagerCheckpoint) (pager *PlacementGroupsPager, err error) {
+	options := &ListPlacementGroupsOptions{}
+	if err = checkpoint.decode("PlacementGroupsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPlacementGroupsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePrivatePathServiceGatewayAccountPoliciesPager : Instantiate PrivatePathServiceGatewayAccountPoliciesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePrivatePathServiceGatewayAccountPoliciesPager(checkpoint *PagerCheckpoint) (pager *PrivatePathServiceGatewayAccountPoliciesPager, err error) {
+	options := &ListPrivatePathServiceGatewayAccountPoliciesOptions{}
+	if err = checkpoint.decode("PrivatePathServiceGatewayAccountPoliciesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPrivatePathServiceGatewayAccountPoliciesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePrivatePathServiceGatewayEndpointGatewayBindingsPager : Instantiate PrivatePathServiceGatewayEndpointGatewayBindingsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePrivatePathServiceGatewayEndpointGatewayBindingsPager(checkpoint *PagerCheckpoint) (pager *PrivatePathServiceGatewayEndpointGatewayBindingsPager, err error) {
+	options := &ListPrivatePathServiceGatewayEndpointGatewayBindingsOptions{}
+	if err = checkpoint.decode("PrivatePathServiceGatewayEndpointGatewayBindingsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPrivatePathServiceGatewayEndpointGatewayBindingsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePrivatePathServiceGatewaysPager : Instantiate PrivatePathServiceGatewaysPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePrivatePathServiceGatewaysPager(checkpoint *PagerCheckpoint) (pager *PrivatePathServiceGatewaysPager, err error) {
+	options := &ListPrivatePathServiceGatewaysOptions{}
+	if err = checkpoint.decode("PrivatePathServiceGatewaysPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPrivatePathServiceGatewaysPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePublicAddressRangesPager : Instantiate PublicAddressRangesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePublicAddressRangesPager(checkpoint *PagerCheckpoint) (pager *PublicAddressRangesPager, err error) {
+	options := &ListPublicAddressRangesOptions{}
+	if err = checkpoint.decode("PublicAddressRangesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPublicAddressRangesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumePublicGatewaysPager : Instantiate PublicGatewaysPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumePublicGatewaysPager(checkpoint *PagerCheckpoint) (pager *PublicGatewaysPager, err error) {
+	options := &ListPublicGatewaysOptions{}
+	if err = checkpoint.decode("PublicGatewaysPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewPublicGatewaysPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeReservationsPager : Instantiate ReservationsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeReservationsPager(checkpoint *PagerCheckpoint) (pager *ReservationsPager, err error) {
+	options := &ListReservationsOptions{}
+	if err = checkpoint.decode("ReservationsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewReservationsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSecurityGroupTargetsPager : Instantiate SecurityGroupTargetsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSecurityGroupTargetsPager(checkpoint *PagerCheckpoint) (pager *SecurityGroupTargetsPager, err error) {
+	options := &ListSecurityGroupTargetsOptions{}
+	if err = checkpoint.decode("SecurityGroupTargetsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSecurityGroupTargetsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSecurityGroupsPager : Instantiate SecurityGroupsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSecurityGroupsPager(checkpoint *PagerCheckpoint) (pager *SecurityGroupsPager, err error) {
+	options := &ListSecurityGroupsOptions{}
+	if err = checkpoint.decode("SecurityGroupsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSecurityGroupsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeShareAccessorBindingsPager : Instantiate ShareAccessorBindingsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeShareAccessorBindingsPager(checkpoint *PagerCheckpoint) (pager *ShareAccessorBindingsPager, err error) {
+	options := &ListShareAccessorBindingsOptions{}
+	if err = checkpoint.decode("ShareAccessorBindingsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewShareAccessorBindingsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeShareMountTargetsPager : Instantiate ShareMountTargetsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeShareMountTargetsPager(checkpoint *PagerCheckpoint) (pager *ShareMountTargetsPager, err error) {
+	options := &ListShareMountTargetsOptions{}
+	if err = checkpoint.decode("ShareMountTargetsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewShareMountTargetsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeShareProfilesPager : Instantiate ShareProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeShareProfilesPager(checkpoint *PagerCheckpoint) (pager *ShareProfilesPager, err error) {
+	options := &ListShareProfilesOptions{}
+	if err = checkpoint.decode("ShareProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewShareProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeShareSnapshotsPager : Instantiate ShareSnapshotsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeShareSnapshotsPager(checkpoint *PagerCheckpoint) (pager *ShareSnapshotsPager, err error) {
+	options := &ListShareSnapshotsOptions{}
+	if err = checkpoint.decode("ShareSnapshotsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewShareSnapshotsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSharesPager : Instantiate SharesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSharesPager(checkpoint *PagerCheckpoint) (pager *SharesPager, err error) {
+	options := &ListSharesOptions{}
+	if err = checkpoint.decode("SharesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSharesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSnapshotConsistencyGroupsPager : Instantiate SnapshotConsistencyGroupsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSnapshotConsistencyGroupsPager(checkpoint *PagerCheckpoint) (pager *SnapshotConsistencyGroupsPager, err error) {
+	options := &ListSnapshotConsistencyGroupsOptions{}
+	if err = checkpoint.decode("SnapshotConsistencyGroupsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSnapshotConsistencyGroupsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSnapshotInstanceProfilesPager : Instantiate SnapshotInstanceProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSnapshotInstanceProfilesPager(checkpoint *PagerCheckpoint) (pager *SnapshotInstanceProfilesPager, err error) {
+	options := &ListSnapshotInstanceProfilesOptions{}
+	if err = checkpoint.decode("SnapshotInstanceProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSnapshotInstanceProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSnapshotsPager : Instantiate SnapshotsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSnapshotsPager(checkpoint *PagerCheckpoint) (pager *SnapshotsPager, err error) {
+	options := &ListSnapshotsOptions{}
+	if err = checkpoint.decode("SnapshotsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSnapshotsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSubnetReservedIpsPager : Instantiate SubnetReservedIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSubnetReservedIpsPager(checkpoint *PagerCheckpoint) (pager *SubnetReservedIpsPager, err error) {
+	options := &ListSubnetReservedIpsOptions{}
+	if err = checkpoint.decode("SubnetReservedIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSubnetReservedIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeSubnetsPager : Instantiate SubnetsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeSubnetsPager(checkpoint *PagerCheckpoint) (pager *SubnetsPager, err error) {
+	options := &ListSubnetsOptions{}
+	if err = checkpoint.decode("SubnetsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewSubnetsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPCAddressPrefixesPager : Instantiate VPCAddressPrefixesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPCAddressPrefixesPager(checkpoint *PagerCheckpoint) (pager *VPCAddressPrefixesPager, err error) {
+	options := &ListVPCAddressPrefixesOptions{}
+	if err = checkpoint.decode("VPCAddressPrefixesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPCAddressPrefixesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPCDnsResolutionBindingsPager : Instantiate VPCDnsResolutionBindingsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPCDnsResolutionBindingsPager(checkpoint *PagerCheckpoint) (pager *VPCDnsResolutionBindingsPager, err error) {
+	options := &ListVPCDnsResolutionBindingsOptions{}
+	if err = checkpoint.decode("VPCDnsResolutionBindingsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPCDnsResolutionBindingsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPCRoutesPager : Instantiate VPCRoutesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPCRoutesPager(checkpoint *PagerCheckpoint) (pager *VPCRoutesPager, err error) {
+	options := &ListVPCRoutesOptions{}
+	if err = checkpoint.decode("VPCRoutesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPCRoutesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPCRoutingTableRoutesPager : Instantiate VPCRoutingTableRoutesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPCRoutingTableRoutesPager(checkpoint *PagerCheckpoint) (pager *VPCRoutingTableRoutesPager, err error) {
+	options := &ListVPCRoutingTableRoutesOptions{}
+	if err = checkpoint.decode("VPCRoutingTableRoutesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPCRoutingTableRoutesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPCRoutingTablesPager : Instantiate VPCRoutingTablesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPCRoutingTablesPager(checkpoint *PagerCheckpoint) (pager *VPCRoutingTablesPager, err error) {
+	options := &ListVPCRoutingTablesOptions{}
+	if err = checkpoint.decode("VPCRoutingTablesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPCRoutingTablesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNGatewayConnectionsPager : Instantiate VPNGatewayConnectionsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNGatewayConnectionsPager(checkpoint *PagerCheckpoint) (pager *VPNGatewayConnectionsPager, err error) {
+	options := &ListVPNGatewayConnectionsOptions{}
+	if err = checkpoint.decode("VPNGatewayConnectionsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNGatewayConnectionsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNGatewayServiceConnectionsPager : Instantiate VPNGatewayServiceConnectionsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNGatewayServiceConnectionsPager(checkpoint *PagerCheckpoint) (pager *VPNGatewayServiceConnectionsPager, err error) {
+	options := &ListVPNGatewayServiceConnectionsOptions{}
+	if err = checkpoint.decode("VPNGatewayServiceConnectionsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNGatewayServiceConnectionsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNGatewaysPager : Instantiate VPNGatewaysPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNGatewaysPager(checkpoint *PagerCheckpoint) (pager *VPNGatewaysPager, err error) {
+	options := &ListVPNGatewaysOptions{}
+	if err = checkpoint.decode("VPNGatewaysPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNGatewaysPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNServerClientsPager : Instantiate VPNServerClientsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNServerClientsPager(checkpoint *PagerCheckpoint) (pager *VPNServerClientsPager, err error) {
+	options := &ListVPNServerClientsOptions{}
+	if err = checkpoint.decode("VPNServerClientsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNServerClientsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNServerRoutesPager : Instantiate VPNServerRoutesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNServerRoutesPager(checkpoint *PagerCheckpoint) (pager *VPNServerRoutesPager, err error) {
+	options := &ListVPNServerRoutesOptions{}
+	if err = checkpoint.decode("VPNServerRoutesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNServerRoutesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVPNServersPager : Instantiate VPNServersPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVPNServersPager(checkpoint *PagerCheckpoint) (pager *VPNServersPager, err error) {
+	options := &ListVPNServersOptions{}
+	if err = checkpoint.decode("VPNServersPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVPNServersPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVirtualNetworkInterfaceIpsPager : Instantiate VirtualNetworkInterfaceIpsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVirtualNetworkInterfaceIpsPager(checkpoint *PagerCheckpoint) (pager *VirtualNetworkInterfaceIpsPager, err error) {
+	options := &ListVirtualNetworkInterfaceIpsOptions{}
+	if err = checkpoint.decode("VirtualNetworkInterfaceIpsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVirtualNetworkInterfaceIpsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVirtualNetworkInterfacesPager : Instantiate VirtualNetworkInterfacesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVirtualNetworkInterfacesPager(checkpoint *PagerCheckpoint) (pager *VirtualNetworkInterfacesPager, err error) {
+	options := &ListVirtualNetworkInterfacesOptions{}
+	if err = checkpoint.decode("VirtualNetworkInterfacesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVirtualNetworkInterfacesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVolumeInstanceProfilesPager : Instantiate VolumeInstanceProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVolumeInstanceProfilesPager(checkpoint *PagerCheckpoint) (pager *VolumeInstanceProfilesPager, err error) {
+	options := &ListVolumeInstanceProfilesOptions{}
+	if err = checkpoint.decode("VolumeInstanceProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVolumeInstanceProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVolumeJobsPager : Instantiate VolumeJobsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVolumeJobsPager(checkpoint *PagerCheckpoint) (pager *VolumeJobsPager, err error) {
+	options := &ListVolumeJobsOptions{}
+	if err = checkpoint.decode("VolumeJobsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVolumeJobsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVolumeProfilesPager : Instantiate VolumeProfilesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVolumeProfilesPager(checkpoint *PagerCheckpoint) (pager *VolumeProfilesPager, err error) {
+	options := &ListVolumeProfilesOptions{}
+	if err = checkpoint.decode("VolumeProfilesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVolumeProfilesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVolumesPager : Instantiate VolumesPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVolumesPager(checkpoint *PagerCheckpoint) (pager *VolumesPager, err error) {
+	options := &ListVolumesOptions{}
+	if err = checkpoint.decode("VolumesPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVolumesPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ResumeVpcsPager : Instantiate VpcsPager continuing from "checkpoint", returned by its Checkpoint method
+func (vpc *VpcV1) ResumeVpcsPager(checkpoint *PagerCheckpoint) (pager *VpcsPager, err error) {
+	options := &ListVpcsOptions{}
+	if err = checkpoint.decode("VpcsPager", options); err != nil {
+		return
+	}
+	pager, err = vpc.NewVpcsPager(options)
+	if err != nil {
+		return
+	}
+	if err = pager.SetCheckpoint(checkpoint); err != nil {
+		return nil, err
+	}
+	return
+}
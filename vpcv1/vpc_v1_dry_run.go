@@ -0,0 +1,189 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// ErrDryRun is matched with errors.Is by the errors returned by the mutating calls of a dry-run
+// client (see DryRun).
+var ErrDryRun = errors.New("vpcv1: request not sent in dry-run mode")
+
+// PlannedRequest : A request that a dry-run client built and validated, but did not send
+type PlannedRequest struct {
+	// The operation ID of the service method, for example "CreateInstance".
+	OperationID string
+
+	// The HTTP method.
+	Method string
+
+	// The full URL, including the version and generation query parameters.
+	URL string
+
+	// The headers, with the Authorization header redacted.
+	Headers http.Header
+
+	// The body, uncompressed, or "" if there is none.
+	Body string
+}
+
+// String returns the request in HTTP/1.1 wire format.
+func (request *PlannedRequest) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "%s %s\n", request.Method, request.URL)
+	for _, name := range slices.Sorted(maps.Keys(request.Headers)) {
+		for _, value := range request.Headers[name] {
+			fmt.Fprintf(&builder, "%s: %s\n", name, value)
+		}
+	}
+	if request.Body != "" {
+		fmt.Fprintf(&builder, "\n%s\n", request.Body)
+	}
+	return builder.String()
+}
+
+// DryRunError : The error returned by the mutating calls of a dry-run client
+// It matches ErrDryRun with errors.Is, and can be extracted from the error returned by the service
+// method with errors.As or with GetPlannedRequest.
+type DryRunError struct {
+	// The request that would have been sent.
+	Request *PlannedRequest
+}
+
+// Error implements the error interface.
+func (e *DryRunError) Error() string {
+	return fmt.Sprintf("%s: %s %s", ErrDryRun.Error(), e.Request.Method, e.Request.URL)
+}
+
+// Is allows a DryRunError to be compared against ErrDryRun with errors.Is.
+func (e *DryRunError) Is(target error) bool {
+	return target == ErrDryRun
+}
+
+// GetPlannedRequest : Return the request that a dry-run client did not send, from the error
+// returned by the service method
+func GetPlannedRequest(err error) (*PlannedRequest, bool) {
+	var dryRunErr *DryRunError
+	if !errors.As(err, &dryRunErr) {
+		return nil, false
+	}
+	return dryRunErr.Request, true
+}
+
+// dryRun holds the requests planned by a dry-run client.
+type dryRun struct {
+	mutex    sync.Mutex
+	requests []*PlannedRequest
+}
+
+// DryRun : Return a dry-run client derived from the service
+// The dry-run client builds and validates each request like the service, but neither authenticates
+// nor sends those of mutating calls (POST, PUT, PATCH and DELETE): their service methods return an
+// error matching ErrDryRun, from which the request is available with GetPlannedRequest, without
+// retrying it. Read calls are the exception: they are authenticated and sent, so that the code under
+// review can look up the resources it operates on.
+//
+// The requests are also available, in order, from GetPlannedRequests. Clones made from the dry-run
+// client share its planned requests.
+func (vpc *VpcV1) DryRun() *VpcV1 {
+	clone := vpc.Clone()
+	clone.configureTransport(func(transport *clientTransport) {
+		transport.dryRun = &dryRun{}
+	})
+	return clone
+}
+
+// IsDryRun : Return true if the service is a dry-run client made with DryRun
+func (vpc *VpcV1) IsDryRun() bool {
+	transport := vpc.getTransport()
+	return transport != nil && transport.dryRun != nil
+}
+
+// GetPlannedRequests : Return the requests that a dry-run client did not send, in order
+func (vpc *VpcV1) GetPlannedRequests() []*PlannedRequest {
+	transport := vpc.getTransport()
+	if transport == nil || transport.dryRun == nil {
+		return nil
+	}
+	transport.dryRun.mutex.Lock()
+	defer transport.dryRun.mutex.Unlock()
+	return slices.Clone(transport.dryRun.requests)
+}
+
+// isMutating returns true if requests with "method" modify resources.
+func isMutating(method string) bool {
+	return method != http.MethodGet && method != http.MethodHead && method != http.MethodOptions
+}
+
+// planRequest records "req", sent by a service method of "vpc" through "transport", with the headers
+// that the base service and "transport" would have sent, and returns the error of the call.
+func (vpc *VpcV1) planRequest(transport *clientTransport, req *http.Request) error {
+	for name, values := range vpc.Service.DefaultHeaders {
+		req.Header.Set(name, strings.Join(values, ""))
+	}
+	if req.Header.Get(common.HEADER_NAME_USER_AGENT) == "" {
+		req.Header.Set(common.HEADER_NAME_USER_AGENT, vpc.Service.UserAgent)
+	}
+	return transport.dryRun.plan(operationIDFromContext(req.Context()), transport.prepare(req))
+}
+
+// plan records "req", sent on behalf of operation "operationID", and returns the error of the call.
+func (dryRun *dryRun) plan(operationID string, req *http.Request) error {
+	request := &PlannedRequest{
+		OperationID: operationID,
+		Method:      req.Method,
+		URL:         req.URL.String(),
+		Headers:     req.Header.Clone(),
+	}
+	if request.Headers.Get("Authorization") != "" {
+		request.Headers.Set("Authorization", RedactedValue)
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return err
+		}
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			if reader, err := gzip.NewReader(bytes.NewReader(body)); err == nil {
+				if uncompressed, err := io.ReadAll(reader); err == nil {
+					body = uncompressed
+					request.Headers.Del("Content-Encoding")
+				}
+			}
+		}
+		request.Body = string(body)
+	}
+
+	dryRun.mutex.Lock()
+	dryRun.requests = append(dryRun.requests, request)
+	dryRun.mutex.Unlock()
+	return &DryRunError{Request: request}
+}
@@ -0,0 +1,132 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`DryRun`, func() {
+	It(`Should plan mutating calls without sending them`, func() {
+		server, count := newSequenceServer(`{"id": "v-1", "name": "my-volume"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL + "/v1")
+		vpcService.SetRetryPolicy(fastRetries)
+		dryRun := vpcService.DryRun()
+		Expect(dryRun.IsDryRun()).To(BeTrue())
+		Expect(vpcService.IsDryRun()).To(BeFalse())
+
+		// Reads are sent.
+		volume, _, err := dryRun.GetVolume(dryRun.NewGetVolumeOptions("v-1"))
+		Expect(err).To(BeNil())
+		Expect(*volume.Name).To(Equal("my-volume"))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		createOptions := dryRun.NewCreateVolumeOptions(testVolumePrototype)
+		_, _, err = dryRun.CreateVolume(createOptions)
+		Expect(errors.Is(err, vpcv1.ErrDryRun)).To(BeTrue())
+		planned, ok := vpcv1.GetPlannedRequest(err)
+		Expect(ok).To(BeTrue())
+		Expect(planned.OperationID).To(Equal("CreateVolume"))
+		Expect(planned.Method).To(Equal("POST"))
+		Expect(planned.URL).To(HavePrefix(server.URL + "/v1/volumes?"))
+		Expect(planned.URL).To(ContainSubstring("version="))
+		Expect(planned.URL).To(ContainSubstring("generation=2"))
+		Expect(planned.Headers.Get("Content-Type")).To(Equal("application/json"))
+		Expect(planned.Headers.Get("X-Sdk-Operation-Id")).To(BeEmpty())
+		Expect(planned.Body).To(MatchJSON(`{"capacity": 100, "profile": {"name": "general-purpose"}, "zone": {"name": "us-south-1"}}`))
+		Expect(planned.String()).To(HavePrefix("POST " + planned.URL + "\n"))
+
+		_, _, err = dryRun.UpdateVolume(dryRun.NewUpdateVolumeOptions("v-1", map[string]interface{}{"name": "renamed"}))
+		Expect(errors.Is(err, vpcv1.ErrDryRun)).To(BeTrue())
+		_, err = dryRun.DeleteVolume(dryRun.NewDeleteVolumeOptions("v-1"))
+		Expect(errors.Is(err, vpcv1.ErrDryRun)).To(BeTrue())
+
+		// Nothing else was sent, and the retry policy did not retry the planned requests.
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+		plans := dryRun.GetPlannedRequests()
+		Expect(plans).To(HaveLen(3))
+		Expect(plans[1].Method).To(Equal("PATCH"))
+		Expect(plans[1].Body).To(MatchJSON(`{"name": "renamed"}`))
+		Expect(plans[2].Method).To(Equal("DELETE"))
+		Expect(plans[2].URL).To(HavePrefix(server.URL + "/v1/volumes/v-1?"))
+		Expect(vpcService.GetPlannedRequests()).To(BeNil())
+	})
+	It(`Should validate requests`, func() {
+		dryRun := newTestVpcService("http://127.0.0.1:1").DryRun()
+		_, _, err := dryRun.CreateVolume(&vpcv1.CreateVolumeOptions{})
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, vpcv1.ErrDryRun)).To(BeFalse())
+		Expect(dryRun.GetPlannedRequests()).To(BeEmpty())
+	})
+	It(`Should neither authenticate nor retry the planned requests`, func() {
+		authenticator := &countingAuthenticator{}
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:           "http://127.0.0.1:1",
+			Authenticator: authenticator,
+		})
+		Expect(err).To(BeNil())
+		vpcService.EnableRetries(3, time.Second)
+		dryRun := vpcService.DryRun()
+
+		start := time.Now()
+		_, err = dryRun.DeleteVolume(dryRun.NewDeleteVolumeOptions("v-1"))
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		planned, ok := vpcv1.GetPlannedRequest(err)
+		Expect(ok).To(BeTrue())
+		Expect(planned.Headers.Get("Authorization")).To(BeEmpty())
+		Expect(planned.Headers.Get("User-Agent")).To(HavePrefix("vpc-go-sdk"))
+		Expect(dryRun.GetPlannedRequests()).To(HaveLen(1))
+		Expect(atomic.LoadInt32(&authenticator.count)).To(BeZero())
+	})
+	It(`Should redact the credentials set on the request`, func() {
+		vpcService := newTestVpcService("http://127.0.0.1:1")
+		options := vpcService.NewDeleteVolumeOptions("v-1").SetHeaders(map[string]string{"Authorization": "Bearer secret-token"})
+		_, err := vpcService.DryRun().DeleteVolume(options)
+		planned, ok := vpcv1.GetPlannedRequest(err)
+		Expect(ok).To(BeTrue())
+		Expect(planned.Headers.Get("Authorization")).To(Equal(vpcv1.RedactedValue))
+	})
+})
+
+// countingAuthenticator is a core.Authenticator counting the requests it authenticates.
+type countingAuthenticator struct {
+	count int32
+}
+
+func (*countingAuthenticator) AuthenticationType() string {
+	return core.AUTHTYPE_BEARER_TOKEN
+}
+
+func (authenticator *countingAuthenticator) Authenticate(req *http.Request) error {
+	atomic.AddInt32(&authenticator.count, 1)
+	req.Header.Set("Authorization", "Bearer secret-token")
+	return nil
+}
+
+func (*countingAuthenticator) Validate() error {
+	return nil
+}
@@ -0,0 +1,341 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// EndpointMode : The endpoints that an EndpointResolver sends requests to
+type EndpointMode string
+
+// The endpoint modes.
+const (
+	// Send requests to the public endpoint of the region, for example us-south.iaas.cloud.ibm.com.
+	EndpointModePublic EndpointMode = "public"
+
+	// Send requests to the private endpoint of the region, for example
+	// us-south.private.iaas.cloud.ibm.com, which is only reachable from IBM Cloud.
+	EndpointModePrivate EndpointMode = "private"
+
+	// Send requests to the private endpoint of the region if it is reachable, and to the public one
+	// otherwise, failing over from one to the other on connection errors.
+	EndpointModeAuto EndpointMode = "auto"
+)
+
+// DefaultEndpointProbeTimeout is the time an EndpointResolver waits for a connection to the private
+// endpoint when it probes its reachability, when no timeout is set.
+const DefaultEndpointProbeTimeout = 2 * time.Second
+
+// EndpointResolver : Selects the endpoint that the requests of a VpcV1 instance are sent to
+// The service URL configured on the instance selects the region; its host may be the public or
+// the private endpoint of the region. The resolver sends each request to the endpoint of the
+// region selected by its mode instead.
+//
+// In EndpointModeAuto, the first request to a region probes whether a TCP connection to the private
+// endpoint can be established. Then, when a request fails to connect to the selected endpoint, it is
+// sent again to the other endpoint, which is selected for the following requests. Requests are only
+// sent again when they failed to connect, so they never reach the service twice.
+//
+// Service URLs with other hosts, such as those of test servers, are used as configured, unless the
+// endpoints are set with SetEndpoints. A resolver is set on a client with the EndpointResolver
+// field of VpcV1Options or with SetEndpointResolver, and may be shared by several clients.
+type EndpointResolver struct {
+	mutex        sync.Mutex
+	mode         EndpointMode
+	probeTimeout time.Duration
+	publicURL    *url.URL
+	privateURL   *url.URL
+
+	// Whether the private endpoint is selected in EndpointModeAuto, by public host.
+	selected map[string]bool
+}
+
+// NewEndpointResolver : Instantiate EndpointResolver with the specified mode
+func NewEndpointResolver(mode EndpointMode) *EndpointResolver {
+	return &EndpointResolver{
+		mode:         mode,
+		probeTimeout: DefaultEndpointProbeTimeout,
+		selected:     make(map[string]bool),
+	}
+}
+
+// GetMode : Return the mode of the resolver
+func (resolver *EndpointResolver) GetMode() EndpointMode {
+	return resolver.mode
+}
+
+// SetProbeTimeout : Allow user to set the time to wait for a connection to the private endpoint
+func (resolver *EndpointResolver) SetProbeTimeout(probeTimeout time.Duration) *EndpointResolver {
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	resolver.probeTimeout = probeTimeout
+	return resolver
+}
+
+// SetEndpoints : Use the specified public and private endpoints, for example those of a proxy,
+// instead of those derived from the service URL. Only the scheme and host of the URLs are used.
+func (resolver *EndpointResolver) SetEndpoints(publicURL string, privateURL string) (*EndpointResolver, error) {
+	public, err := url.Parse(publicURL)
+	if err != nil {
+		return resolver, core.SDKErrorf(err, "", "public-url-error", common.GetComponentInfo())
+	}
+	private, err := url.Parse(privateURL)
+	if err != nil {
+		return resolver, core.SDKErrorf(err, "", "private-url-error", common.GetComponentInfo())
+	}
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	resolver.publicURL = &url.URL{Scheme: public.Scheme, Host: public.Host}
+	resolver.privateURL = &url.URL{Scheme: private.Scheme, Host: private.Host}
+	return resolver, nil
+}
+
+// SetEndpointResolver : Send the requests of the service to the endpoints selected by "resolver"
+// A nil resolver sends the requests to the service URL.
+func (vpc *VpcV1) SetEndpointResolver(resolver *EndpointResolver) error {
+	if resolver != nil {
+		switch resolver.mode {
+		case EndpointModePublic, EndpointModePrivate, EndpointModeAuto:
+		default:
+			err := fmt.Errorf("unknown endpoint mode '%s'", resolver.mode)
+			return core.SDKErrorf(err, "", "invalid-endpoint-mode", common.GetComponentInfo())
+		}
+	}
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.endpointResolver = resolver
+	})
+	return nil
+}
+
+// GetEndpointResolver : Return the resolver set with SetEndpointResolver, or nil
+func (vpc *VpcV1) GetEndpointResolver() *EndpointResolver {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.endpointResolver
+	}
+	return nil
+}
+
+// GetEndpoint : Return the URL that the requests of the service are currently sent to
+// It is the service URL, with the host of the endpoint selected by the endpoint resolver, if any.
+// In EndpointModeAuto, the endpoint is selected by the first request; until then, the service URL
+// is returned.
+func (vpc *VpcV1) GetEndpoint() string {
+	serviceURL := vpc.GetServiceURL()
+	resolver := vpc.GetEndpointResolver()
+	if resolver == nil {
+		return serviceURL
+	}
+	parsed, err := url.Parse(serviceURL)
+	if err != nil {
+		return serviceURL
+	}
+	public, private, ok := resolver.endpoints(parsed)
+	if !ok {
+		return serviceURL
+	}
+	usePrivate, selected := resolver.selection(public.Host)
+	if !selected {
+		return serviceURL
+	}
+	endpoint := public
+	if usePrivate {
+		endpoint = private
+	}
+	parsed.Scheme, parsed.Host = endpoint.Scheme, endpoint.Host
+	return parsed.String()
+}
+
+// endpointRegion returns the region of the public or private endpoint "host", for example
+// "us-south" for "us-south.private.iaas.cloud.ibm.com" or "private.us-south.iaas.cloud.ibm.com",
+// or "".
+func endpointRegion(host string) string {
+	name, ok := strings.CutSuffix(host, ".iaas.cloud.ibm.com")
+	if !ok {
+		return ""
+	}
+	if region, ok := strings.CutSuffix(name, ".private"); ok {
+		name = region
+	} else if region, ok := strings.CutPrefix(name, "private."); ok {
+		name = region
+	}
+	if name == "" || strings.Contains(name, ".") {
+		return ""
+	}
+	return name
+}
+
+// endpoints returns the public and private endpoints of the region of "target", if it is one of
+// them.
+func (resolver *EndpointResolver) endpoints(target *url.URL) (public *url.URL, private *url.URL, ok bool) {
+	resolver.mutex.Lock()
+	public, private = resolver.publicURL, resolver.privateURL
+	resolver.mutex.Unlock()
+	if public != nil {
+		ok = target.Host == public.Host || target.Host == private.Host
+		return
+	}
+
+	region := endpointRegion(target.Hostname())
+	if region == "" {
+		return nil, nil, false
+	}
+	public = &url.URL{Scheme: target.Scheme, Host: region + ".iaas.cloud.ibm.com"}
+	private = &url.URL{Scheme: target.Scheme, Host: region + ".private.iaas.cloud.ibm.com"}
+	if port := target.Port(); port != "" {
+		public.Host = net.JoinHostPort(public.Host, port)
+		private.Host = net.JoinHostPort(private.Host, port)
+	}
+	return public, private, true
+}
+
+// selection returns whether the private endpoint is selected for the region of the public endpoint
+// "publicHost", and whether an endpoint is selected at all.
+func (resolver *EndpointResolver) selection(publicHost string) (usePrivate bool, selected bool) {
+	switch resolver.mode {
+	case EndpointModePublic:
+		return false, true
+	case EndpointModePrivate:
+		return true, true
+	}
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	usePrivate, selected = resolver.selected[publicHost]
+	return
+}
+
+// selectEndpoint returns whether requests to the region of the public endpoint "public" are sent to
+// the private endpoint "private", probing it if no endpoint is selected yet.
+func (resolver *EndpointResolver) selectEndpoint(ctx context.Context, public *url.URL, private *url.URL) bool {
+	if usePrivate, selected := resolver.selection(public.Host); selected {
+		return usePrivate
+	}
+
+	resolver.mutex.Lock()
+	timeout := resolver.probeTimeout
+	resolver.mutex.Unlock()
+	dialer := &net.Dialer{Timeout: timeout}
+	reachable := false
+	if conn, err := dialer.DialContext(ctx, "tcp", hostPort(private)); err == nil {
+		conn.Close()
+		reachable = true
+	} else if ctx.Err() != nil {
+		// The probe was interrupted, so it says nothing about the endpoint.
+		return false
+	}
+
+	resolver.mutex.Lock()
+	defer resolver.mutex.Unlock()
+	if usePrivate, selected := resolver.selected[public.Host]; selected {
+		return usePrivate
+	}
+	resolver.selected[public.Host] = reachable
+	return reachable
+}
+
+// hostPort returns the host and port to connect to for "endpoint".
+func hostPort(endpoint *url.URL) string {
+	if endpoint.Port() != "" {
+		return endpoint.Host
+	}
+	if endpoint.Scheme == "http" {
+		return net.JoinHostPort(endpoint.Hostname(), "80")
+	}
+	return net.JoinHostPort(endpoint.Hostname(), "443")
+}
+
+// rewrite returns "req" sent to the endpoint selected for its region.
+func (resolver *EndpointResolver) rewrite(req *http.Request) *http.Request {
+	public, private, ok := resolver.endpoints(req.URL)
+	if !ok {
+		return req
+	}
+	endpoint := public
+	if resolver.selectEndpoint(req.Context(), public, private) {
+		endpoint = private
+	}
+	return withEndpoint(req, endpoint)
+}
+
+// withEndpoint returns a copy of "req" sent to "endpoint", or "req" if it is already.
+func withEndpoint(req *http.Request, endpoint *url.URL) *http.Request {
+	if req.URL.Scheme == endpoint.Scheme && req.URL.Host == endpoint.Host {
+		return req
+	}
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.URL.Scheme = endpoint.Scheme
+	req.URL.Host = endpoint.Host
+	req.Host = ""
+	return req
+}
+
+// isConnectionError returns true if "err" means that a request could not reach the server at all.
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// failover returns a transport that sends each attempt with "next", and in EndpointModeAuto sends
+// it again to the other endpoint of its region if it failed to connect, selecting that endpoint for
+// the following requests.
+func (resolver *EndpointResolver) failover(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		resp, err := next.RoundTrip(req)
+		if err == nil || resolver.mode != EndpointModeAuto || !isConnectionError(err) {
+			return resp, err
+		}
+		public, private, ok := resolver.endpoints(req.URL)
+		if !ok || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, err
+		}
+
+		usePrivate := req.URL.Host != private.Host
+		resolver.mutex.Lock()
+		resolver.selected[public.Host] = usePrivate
+		resolver.mutex.Unlock()
+
+		endpoint := public
+		if usePrivate {
+			endpoint = private
+		}
+		retry := withEndpoint(req, endpoint)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			retry.Body = body
+		}
+		return next.RoundTrip(retry)
+	})
+}
@@ -0,0 +1,131 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EndpointResolver`, func() {
+	It(`Should select the endpoint of the mode`, func() {
+		vpcService := newTestVpcService("https://us-south.iaas.cloud.ibm.com/v1")
+		Expect(vpcService.GetEndpoint()).To(Equal("https://us-south.iaas.cloud.ibm.com/v1"))
+
+		Expect(vpcService.SetEndpointResolver(vpcv1.NewEndpointResolver(vpcv1.EndpointModePrivate))).To(Succeed())
+		Expect(vpcService.GetEndpoint()).To(Equal("https://us-south.private.iaas.cloud.ibm.com/v1"))
+
+		Expect(vpcService.SetServiceURL("https://private.eu-de.iaas.cloud.ibm.com/v1")).To(Succeed())
+		Expect(vpcService.GetEndpoint()).To(Equal("https://eu-de.private.iaas.cloud.ibm.com/v1"))
+
+		Expect(vpcService.SetEndpointResolver(vpcv1.NewEndpointResolver(vpcv1.EndpointModePublic))).To(Succeed())
+		Expect(vpcService.GetEndpoint()).To(Equal("https://eu-de.iaas.cloud.ibm.com/v1"))
+
+		Expect(vpcService.SetEndpointResolver(vpcv1.NewEndpointResolver("nearest"))).ToNot(Succeed())
+		Expect(vpcService.GetEndpointResolver().GetMode()).To(Equal(vpcv1.EndpointModePublic))
+	})
+	It(`Should apply the resolver of the options`, func() {
+		resolver := vpcv1.NewEndpointResolver(vpcv1.EndpointModePrivate)
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:              "https://us-south.iaas.cloud.ibm.com/v1",
+			Authenticator:    &core.NoAuthAuthenticator{},
+			EndpointResolver: resolver,
+		})
+		Expect(err).To(BeNil())
+		Expect(vpcService.GetEndpointResolver()).To(BeIdenticalTo(resolver))
+		Expect(vpcService.GetEndpoint()).To(Equal("https://us-south.private.iaas.cloud.ibm.com/v1"))
+
+		vpcService, err = vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			Authenticator:    &core.NoAuthAuthenticator{},
+			EndpointResolver: vpcv1.NewEndpointResolver("nearest"),
+		})
+		Expect(err).ToNot(BeNil())
+		Expect(vpcService).To(BeNil())
+	})
+	It(`Should disable the SSL verification of the external configuration with the resolver of the options`, func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "i-1"}`)
+		}))
+		defer server.Close()
+
+		os.Setenv("VPC_AUTH_TYPE", "noauth")
+		os.Setenv("VPC_DISABLE_SSL", "true")
+		defer os.Unsetenv("VPC_AUTH_TYPE")
+		defer os.Unsetenv("VPC_DISABLE_SSL")
+
+		resolver, err := vpcv1.NewEndpointResolver(vpcv1.EndpointModePublic).SetEndpoints(server.URL, server.URL)
+		Expect(err).To(BeNil())
+		vpcService, err := vpcv1.NewVpcV1UsingExternalConfig(&vpcv1.VpcV1Options{
+			URL:              server.URL,
+			EndpointResolver: resolver,
+		})
+		Expect(err).To(BeNil())
+		Expect(vpcService.IsSSLDisabled()).To(BeTrue())
+
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+	})
+	It(`Should use the public endpoint when the private one is unreachable`, func() {
+		public, publicCount := newSequenceServer(`{"id": "i-1"}`)
+		defer public.Close()
+		private, privateCount := newSequenceServer(`{"id": "i-1"}`)
+		private.Close()
+
+		vpcService := newTestVpcService(private.URL)
+		resolver, err := vpcv1.NewEndpointResolver(vpcv1.EndpointModeAuto).SetEndpoints(public.URL, private.URL)
+		Expect(err).To(BeNil())
+		Expect(vpcService.SetEndpointResolver(resolver)).To(Succeed())
+		Expect(vpcService.GetEndpoint()).To(Equal(private.URL))
+
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(publicCount)).To(Equal(int32(1)))
+		Expect(atomic.LoadInt32(privateCount)).To(Equal(int32(0)))
+		Expect(vpcService.GetEndpoint()).To(Equal(public.URL))
+	})
+	It(`Should fail over to the other endpoint on connection errors`, func() {
+		public, publicCount := newSequenceServer(`{"id": "i-1"}`)
+		defer public.Close()
+		private, privateCount := newSequenceServer(`{"id": "i-1"}`)
+		defer private.Close()
+
+		vpcService := newTestVpcService(public.URL)
+		resolver, err := vpcv1.NewEndpointResolver(vpcv1.EndpointModeAuto).SetEndpoints(public.URL, private.URL)
+		Expect(err).To(BeNil())
+		Expect(vpcService.SetEndpointResolver(resolver)).To(Succeed())
+
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(privateCount)).To(Equal(int32(1)))
+		Expect(vpcService.GetEndpoint()).To(Equal(private.URL))
+
+		private.Close()
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(publicCount)).To(Equal(int32(1)))
+		Expect(vpcService.GetEndpoint()).To(Equal(public.URL))
+	})
+})
@@ -0,0 +1,270 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// Sentinel errors that an *APIError matches with errors.Is, based on its HTTP status code and
+// error codes. The *core.SDKProblem values returned by the VpcV1 methods for error responses
+// unwrap to an *APIError, so they can be tested directly. For example:
+//
+//	if errors.Is(err, vpcv1.ErrNotFound) {
+//		// the resource is already gone
+//	}
+var (
+	ErrNotFound           = errors.New("vpcv1: resource not found")
+	ErrConflict           = errors.New("vpcv1: resource conflict")
+	ErrQuotaExceeded      = errors.New("vpcv1: quota exceeded")
+	ErrPreconditionFailed = errors.New("vpcv1: precondition failed")
+	ErrValidation         = errors.New("vpcv1: invalid request")
+	ErrTooManyRequests    = errors.New("vpcv1: too many requests")
+)
+
+// APIErrorTarget : The part of the request that an API error applies to.
+type APIErrorTarget struct {
+	// The name of the offending property or parameter.
+	Name string `json:"name,omitempty"`
+
+	// The kind of request part, such as `field`, `header`, or `parameter`.
+	Type string `json:"type,omitempty"`
+
+	// The offending value, if the service reported it.
+	Value interface{} `json:"value,omitempty"`
+}
+
+// APIErrorItem : One entry of the `errors` array in a VPC API error response.
+type APIErrorItem struct {
+	// A snake case string succinctly identifying the problem, for example `not_found`.
+	Code string `json:"code,omitempty"`
+
+	// An explanation of the problem.
+	Message string `json:"message,omitempty"`
+
+	// A link to documentation about the problem.
+	MoreInfo string `json:"more_info,omitempty"`
+
+	// The part of the request that the problem applies to.
+	Target *APIErrorTarget `json:"target,omitempty"`
+}
+
+// APIError : The problem reported by the VPC API for a failed request.
+// The first entry of the `errors` array is promoted to the Code, Message, MoreInfo and Target
+// fields. An APIError matches the ErrNotFound, ErrConflict, ErrQuotaExceeded, ErrPreconditionFailed,
+// ErrValidation and ErrTooManyRequests sentinels with errors.Is. The *core.SDKProblem returned by a
+// VpcV1 method for an error response unwraps to an APIError, which can be extracted with errors.As
+// or ParseAPIError.
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The operation that failed, for example `get_instance`.
+	OperationID string
+
+	// The code of the first reported problem.
+	Code string
+
+	// The message of the first reported problem.
+	Message string
+
+	// The documentation link of the first reported problem.
+	MoreInfo string
+
+	// The target of the first reported problem.
+	Target *APIErrorTarget
+
+	// The identifier the service assigned to the failed request, for use in support cases.
+	Trace string
+
+	// The X-Request-Id of the failed request, for example the one set with common.WithRequestID.
+	RequestID string
+
+	// All reported problems.
+	Errors []APIErrorItem
+
+	// The full error response.
+	Response *core.DetailedResponse
+
+	err error
+}
+
+// apiErrorBody is the JSON representation of a VPC API error response.
+type apiErrorBody struct {
+	Errors []APIErrorItem `json:"errors,omitempty"`
+	Trace  string         `json:"trace,omitempty"`
+}
+
+// ParseAPIError : Extract the VPC API problem from an error returned by a VpcV1 method
+// This is the same as errors.As with an *APIError, but also accepts the errors of the core SDK
+// for an error response. Returns false if "err" did not result from an error response, for
+// example because the request could not be sent.
+func ParseAPIError(err error) (apiErr *APIError, ok bool) {
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) || httpProblem.Response == nil {
+		return nil, false
+	}
+	apiErr = NewAPIError(httpProblem.Response, err)
+	apiErr.OperationID = httpProblem.OperationID
+	return apiErr, true
+}
+
+// apiProblem is the cause of the problem returned by a VpcV1 method for an error response: the
+// *core.HTTPProblem of the response, which also unwraps to the *APIError parsed from it.
+type apiProblem struct {
+	*core.HTTPProblem
+	apiErr *APIError
+}
+
+// Unwrap returns the *APIError of the problem.
+func (p *apiProblem) Unwrap() []error {
+	return []error{p.apiErr}
+}
+
+// withAPIError returns "err", the error of a request, as the cause of the problem returned by a
+// VpcV1 method: if it resulted from an error response, its *core.HTTPProblem is returned with the
+// *APIError parsed from the response, which the returned *core.SDKProblem then unwraps to. The
+// methods of VpcV1 call it on the errors of their requests (see vpc_v1_generate.go).
+func withAPIError(err error) error {
+	// The problems of the core SDK keep their *core.HTTPProblem out of their chain, until an
+	// SDK problem is created from them.
+	var httpProblem *core.HTTPProblem
+	if !errors.As(core.SDKErrorf(err, "", "", common.GetComponentInfo()), &httpProblem) || httpProblem.Response == nil {
+		return err
+	}
+	apiErr := NewAPIError(httpProblem.Response, httpProblem)
+	apiErr.OperationID = httpProblem.OperationID
+	return &apiProblem{HTTPProblem: httpProblem, apiErr: apiErr}
+}
+
+// NewAPIError : Build an APIError from an error response
+// "err" is the error that accompanied "response" and may be nil.
+func NewAPIError(response *core.DetailedResponse, err error) *APIError {
+	apiErr := &APIError{
+		StatusCode: response.GetStatusCode(),
+		RequestID:  common.GetRequestID(response),
+		Response:   response,
+		err:        err,
+	}
+
+	var body []byte
+	switch {
+	case response.Result != nil:
+		body, _ = json.Marshal(response.Result)
+	case response.RawResult != nil:
+		body = response.RawResult
+	}
+
+	var parsed apiErrorBody
+	if len(body) > 0 && json.Unmarshal(body, &parsed) == nil {
+		apiErr.Errors = parsed.Errors
+		apiErr.Trace = parsed.Trace
+	}
+	if len(apiErr.Errors) > 0 {
+		first := apiErr.Errors[0]
+		apiErr.Code = first.Code
+		apiErr.Message = first.Message
+		apiErr.MoreInfo = first.MoreInfo
+		apiErr.Target = first.Target
+	}
+	if apiErr.Trace == "" {
+		apiErr.Trace = apiErr.RequestID
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(apiErr.StatusCode)
+	}
+	return apiErr
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " %s", e.Code)
+	}
+	fmt.Fprintf(&b, ": %s", e.Message)
+	if e.Target != nil && e.Target.Name != "" {
+		fmt.Fprintf(&b, " (%s '%s')", e.Target.Type, e.Target.Name)
+	}
+	if e.Trace != "" {
+		fmt.Fprintf(&b, " [trace: %s]", e.Trace)
+	}
+	return b.String()
+}
+
+// Unwrap returns the error that accompanied the response, such as its *core.HTTPProblem.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// HasCode returns true if any of the reported problems has the specified code.
+func (e *APIError) HasCode(code string) bool {
+	for _, item := range e.Errors {
+		if item.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCodeMatching returns true if any of the reported problems has a code accepted by "match".
+func (e *APIError) hasCodeMatching(match func(code string) bool) bool {
+	for _, item := range e.Errors {
+		if match(item.Code) {
+			return true
+		}
+	}
+	return false
+}
+
+// Is allows an APIError to be compared against the sentinel errors with errors.Is.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || e.hasCodeMatching(func(code string) bool {
+			return code == "not_found" || strings.HasSuffix(code, "_not_found")
+		})
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict || e.hasCodeMatching(func(code string) bool {
+			return strings.Contains(code, "conflict")
+		})
+	case ErrQuotaExceeded:
+		return e.hasCodeMatching(func(code string) bool {
+			return strings.Contains(code, "quota")
+		})
+	case ErrPreconditionFailed:
+		return e.StatusCode == http.StatusPreconditionFailed
+	case ErrValidation:
+		return e.hasCodeMatching(func(code string) bool {
+			return strings.HasPrefix(code, "validation_")
+		})
+	case ErrTooManyRequests:
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newErrorServer returns a test server that answers every request with "statusCode" and "body".
+func newErrorServer(statusCode int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if body != "" {
+			res.Header().Set("Content-type", "application/json")
+		}
+		res.WriteHeader(statusCode)
+		fmt.Fprint(res, body)
+	}))
+}
+
+var _ = Describe(`APIError`, func() {
+	It(`Should parse the VPC problem body`, func() {
+		server := newErrorServer(404, `{
+			"errors": [{
+				"code": "not_found",
+				"message": "Instance not found",
+				"more_info": "https://cloud.ibm.com/docs/vpc?topic=vpc-rias-error-messages#not_found",
+				"target": {"name": "id", "type": "parameter", "value": "i-1"}
+			}],
+			"trace": "4a2b8c1d-0000-4000-8000-000000000000",
+			"status_code": 404
+		}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+
+		apiErr, ok := vpcv1.ParseAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(404))
+		Expect(apiErr.OperationID).To(Equal("get_instance"))
+		Expect(apiErr.Code).To(Equal("not_found"))
+		Expect(apiErr.Message).To(Equal("Instance not found"))
+		Expect(apiErr.MoreInfo).To(ContainSubstring("#not_found"))
+		Expect(apiErr.Target.Name).To(Equal("id"))
+		Expect(apiErr.Target.Type).To(Equal("parameter"))
+		Expect(apiErr.Target.Value).To(Equal("i-1"))
+		Expect(apiErr.Trace).To(Equal("4a2b8c1d-0000-4000-8000-000000000000"))
+		Expect(apiErr.HasCode("not_found")).To(BeTrue())
+		Expect(apiErr.Error()).To(Equal("404 not_found: Instance not found (parameter 'id') [trace: 4a2b8c1d-0000-4000-8000-000000000000]"))
+
+		Expect(errors.Is(apiErr, vpcv1.ErrNotFound)).To(BeTrue())
+		Expect(errors.Is(apiErr, vpcv1.ErrConflict)).To(BeFalse())
+
+		var httpProblem *core.HTTPProblem
+		Expect(errors.As(apiErr, &httpProblem)).To(BeTrue())
+		Expect(httpProblem.OperationID).To(Equal("get_instance"))
+	})
+	It(`Should match the sentinel errors`, func() {
+		cases := []struct {
+			statusCode int
+			code       string
+			sentinel   error
+		}{
+			{409, "resource_conflict", vpcv1.ErrConflict},
+			{400, "over_quota", vpcv1.ErrQuotaExceeded},
+			{412, "", vpcv1.ErrPreconditionFailed},
+			{400, "validation_invalid_argument", vpcv1.ErrValidation},
+			{429, "", vpcv1.ErrTooManyRequests},
+		}
+		for _, c := range cases {
+			response := &core.DetailedResponse{
+				StatusCode: c.statusCode,
+				Result: map[string]interface{}{
+					"errors": []interface{}{map[string]interface{}{"code": c.code, "message": "problem"}},
+				},
+			}
+			apiErr := vpcv1.NewAPIError(response, nil)
+			Expect(errors.Is(apiErr, c.sentinel)).To(BeTrue(), "status %d, code %q", c.statusCode, c.code)
+			Expect(errors.Is(apiErr, vpcv1.ErrNotFound)).To(BeFalse())
+		}
+	})
+	It(`Should cope with an empty error body`, func() {
+		server := newErrorServer(412, "")
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, _, err := vpcService.GetVolume(vpcService.NewGetVolumeOptions("v-1"))
+
+		apiErr, ok := vpcv1.ParseAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.Code).To(BeEmpty())
+		Expect(apiErr.Message).To(Equal("Precondition Failed"))
+		Expect(errors.Is(apiErr, vpcv1.ErrPreconditionFailed)).To(BeTrue())
+	})
+	It(`Should return an APIError from GetInstance`, func() {
+		server := newErrorServer(404, `{"errors": [{"code": "not_found", "message": "Instance not found"}], "trace": "t-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		for _, getInstance := range []func() error{
+			func() error {
+				_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+				return err
+			},
+			func() error {
+				_, _, err := vpcService.GetInstanceWithContext(context.Background(), vpcService.NewGetInstanceOptions("i-1"))
+				return err
+			},
+		} {
+			err := getInstance()
+			sdkProblem, ok := err.(*core.SDKProblem)
+			Expect(ok).To(BeTrue())
+			Expect(sdkProblem.Error()).To(Equal("Instance not found"))
+			Expect(errors.Is(err, vpcv1.ErrNotFound)).To(BeTrue())
+			Expect(errors.Is(err, vpcv1.ErrConflict)).To(BeFalse())
+
+			var apiErr *vpcv1.APIError
+			Expect(errors.As(err, &apiErr)).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(404))
+			Expect(apiErr.OperationID).To(Equal("get_instance"))
+			Expect(apiErr.Code).To(Equal("not_found"))
+			Expect(apiErr.Trace).To(Equal("t-1"))
+
+			var httpProblem *core.HTTPProblem
+			Expect(errors.As(err, &httpProblem)).To(BeTrue())
+
+			parsed, ok := vpcv1.ParseAPIError(err)
+			Expect(ok).To(BeTrue())
+			Expect(parsed).To(BeIdenticalTo(apiErr))
+		}
+	})
+	It(`Should return an APIError from DeleteVolume`, func() {
+		server := newErrorServer(409, `{"errors": [{"code": "volume_in_use_conflict", "message": "The volume is attached"}]}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, err := vpcService.DeleteVolume(vpcService.NewDeleteVolumeOptions("v-1"))
+		Expect(err).To(BeAssignableToTypeOf(&core.SDKProblem{}))
+		Expect(errors.Is(err, vpcv1.ErrConflict)).To(BeTrue())
+		Expect(errors.Is(err, vpcv1.ErrNotFound)).To(BeFalse())
+
+		var apiErr *vpcv1.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(409))
+		Expect(apiErr.OperationID).To(Equal("delete_volume"))
+		Expect(apiErr.HasCode("volume_in_use_conflict")).To(BeTrue())
+	})
+	It(`Should return an APIError from a pager`, func() {
+		server := newErrorServer(429, "")
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		pager, err := vpcService.NewVolumesPager(vpcService.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		_, err = pager.GetNext()
+		Expect(err).To(BeAssignableToTypeOf(&core.SDKProblem{}))
+		Expect(errors.Is(err, vpcv1.ErrTooManyRequests)).To(BeTrue())
+	})
+	It(`Should not wrap errors that are not error responses`, func() {
+		vpcService := newTestVpcService("http://127.0.0.1:1")
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+
+		var apiErr *vpcv1.APIError
+		Expect(errors.As(err, &apiErr)).To(BeFalse())
+		Expect(errors.Is(err, vpcv1.ErrNotFound)).To(BeFalse())
+	})
+	It(`Should not parse errors that are not error responses`, func() {
+		_, ok := vpcv1.ParseAPIError(errors.New("connection refused"))
+		Expect(ok).To(BeFalse())
+		_, ok = vpcv1.ParseAPIError(nil)
+		Expect(ok).To(BeFalse())
+	})
+})
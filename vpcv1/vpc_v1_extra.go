@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// Each model has an Extra field holding the properties it does not declare, set by its Unmarshal
+// function with unknownProperties, and a MarshalJSON method emitting them with marshalWithExtra.
+// apigen adds them after the service code is regenerated (see vpc_v1_generate.go), and rewrites the
+// methods of VpcV1 to unmarshal their results with unmarshalModel, which applies the settings of
+// the client for the values unknown to the SDK.
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// unknownPropertiesFound and unknownVariantsFound count the unknown properties and union variants
+// found by the Unmarshal functions, so that unmarshalModel inspects a result only when it holds
+// values that the settings of the client apply to.
+var (
+	unknownPropertiesFound atomic.Int64
+	unknownVariantsFound   atomic.Int64
+)
+
+// SetRetainUnknownProperties : Keep the properties of the models that the SDK does not declare
+// When enabled, the properties of the resources returned by the API that were added after this
+// version of the SDK are kept in the Extra field of their model, rather than dropped, and are
+// emitted again when the model is marshalled to JSON. A resource can then be exported and imported
+// through the SDK without losing data. It is disabled by default, since the properties are kept in
+// memory. The models unmarshalled directly with their Unmarshal function always keep them.
+func (vpc *VpcV1) SetRetainUnknownProperties(retain bool) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.retainUnknownProperties = retain
+	})
+}
+
+// GetRetainUnknownProperties : Return true if the properties of the models that the SDK does not
+// declare are kept (see SetRetainUnknownProperties)
+func (vpc *VpcV1) GetRetainUnknownProperties() bool {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.retainUnknownProperties
+	}
+	return false
+}
+
+// unmarshalModel unmarshals the result of a method, as core.UnmarshalModel does, and applies the
+// settings of the client for the values unknown to the SDK: the properties of the models that the
+// SDK does not declare are dropped unless SetRetainUnknownProperties is enabled, and the union
+// values whose discriminator is unknown are reported to the handler set with
+// SetUnknownVariantHandler.
+func (vpc *VpcV1) unmarshalModel(rawInput interface{}, propertyName string, result interface{}, unmarshaller core.ModelUnmarshaller) error {
+	settings := unknownSettings{strip: true}
+	if transport := vpc.getTransport(); transport != nil {
+		settings.strip = !transport.retainUnknownProperties
+		settings.handler = transport.unknownVariantHandler
+	}
+	properties, variants := unknownPropertiesFound.Load(), unknownVariantsFound.Load()
+	if err := core.UnmarshalModel(rawInput, propertyName, result, unmarshaller); err != nil {
+		return err
+	}
+	// The counters may have been increased by another unmarshalling, which only costs an inspection.
+	settings.strip = settings.strip && unknownPropertiesFound.Load() != properties
+	if unknownVariantsFound.Load() == variants {
+		settings.handler = nil
+	}
+	if !settings.strip && settings.handler == nil {
+		return nil
+	}
+	return settings.apply(reflect.ValueOf(result))
+}
+
+// unknownSettings are the settings of a client for the values unknown to the SDK that apply to a
+// result.
+type unknownSettings struct {
+	strip   bool
+	handler UnknownVariantHandler
+}
+
+// apply applies the settings to "value", an unmarshalled result, and to the values it holds.
+func (settings unknownSettings) apply(value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			return settings.apply(value.Elem())
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		if unknown := unknownVariantOf(value.Elem()); unknown != nil && settings.handler != nil {
+			if err := settings.handler(unknown); err != nil {
+				return core.SDKErrorf(err, "", "unknown-variant", common.GetComponentInfo())
+			}
+		}
+		return settings.apply(value.Elem())
+	case reflect.Struct:
+		fields := modelFieldsOf(value.Type())
+		if fields.extra >= 0 && settings.strip && value.Field(fields.extra).CanSet() {
+			value.Field(fields.extra).SetZero()
+		}
+		for _, i := range fields.nested {
+			if err := settings.apply(value.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := settings.apply(value.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for iter := value.MapRange(); iter.Next(); {
+			if err := settings.apply(iter.Value()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modelFields are the fields of a struct type that unknownSettings.apply inspects: the index of its
+// Extra field, or -1, and the indexes of the exported fields that may hold models.
+type modelFields struct {
+	extra  int
+	nested []int
+}
+
+// modelFieldsByType caches the modelFields of each struct type.
+var modelFieldsByType sync.Map
+
+// modelFieldsOf returns the modelFields of the struct type "structType".
+func modelFieldsOf(structType reflect.Type) *modelFields {
+	if fields, found := modelFieldsByType.Load(structType); found {
+		return fields.(*modelFields)
+	}
+	fields := &modelFields{extra: -1}
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		switch {
+		case !field.IsExported():
+		case field.Name == "Extra" && field.Type == rawMessagesType:
+			fields.extra = i
+		case mayHoldModels(field.Type):
+			fields.nested = append(fields.nested, i)
+		}
+	}
+	modelFieldsByType.Store(structType, fields)
+	return fields
+}
+
+// mayHoldModels returns true if the values of type "valueType" may hold models or union values,
+// rather than only primitive values such as the strings and numbers of the properties of models.
+func mayHoldModels(valueType reflect.Type) bool {
+	switch valueType.Kind() {
+	case reflect.Struct, reflect.Interface:
+		return true
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return mayHoldModels(valueType.Elem())
+	}
+	return false
+}
+
+// knownPropertiesByType caches the JSON properties declared by each model type.
+var knownPropertiesByType sync.Map
+
+// knownProperties returns the names of the JSON properties declared by the struct type "modelType".
+func knownProperties(modelType reflect.Type) map[string]bool {
+	if known, found := knownPropertiesByType.Load(modelType); found {
+		return known.(map[string]bool)
+	}
+	known := make(map[string]bool)
+	for i := 0; i < modelType.NumField(); i++ {
+		name, _, _ := strings.Cut(modelType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			known[name] = true
+		}
+	}
+	knownPropertiesByType.Store(modelType, known)
+	return known
+}
+
+// unknownProperties returns the properties in "m" that the model "model", a pointer to a struct,
+// does not declare, or nil if there are none.
+func unknownProperties(m map[string]json.RawMessage, model interface{}) map[string]json.RawMessage {
+	known := knownProperties(reflect.TypeOf(model).Elem())
+	var extra map[string]json.RawMessage
+	for name, value := range m {
+		if known[name] {
+			continue
+		}
+		if extra == nil {
+			extra = make(map[string]json.RawMessage)
+		}
+		extra[name] = value
+	}
+	if extra != nil {
+		unknownPropertiesFound.Add(1)
+	}
+	return extra
+}
+
+// marshalWithExtra returns the JSON encoding of "model", a struct without a MarshalJSON method,
+// with the properties in "extra" that it does not declare appended, in the order of their names.
+// The generated MarshalJSON methods call it only when "extra" is not empty.
+func marshalWithExtra(model interface{}, extra map[string]json.RawMessage) ([]byte, error) {
+	data, err := json.Marshal(model)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+	known := knownProperties(reflect.Indirect(reflect.ValueOf(model)).Type())
+	var buffer bytes.Buffer
+	buffer.Write(data[:len(data)-1])
+	empty := len(bytes.TrimSpace(data[1:len(data)-1])) == 0
+	for _, name := range slices.Sorted(maps.Keys(extra)) {
+		if known[name] {
+			continue
+		}
+		if !empty {
+			buffer.WriteByte(',')
+		}
+		empty = false
+		key, _ := json.Marshal(name)
+		buffer.Write(key)
+		buffer.WriteByte(':')
+		if err = json.Compact(&buffer, extra[name]); err != nil {
+			return nil, fmt.Errorf("invalid JSON value of the unknown property %q: %w", name, err)
+		}
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,211 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testExtraModel is a model as rewritten by apigen -extra.
+type testExtraModel struct {
+	Name     *string         `json:"name"`
+	Capacity *int64          `json:"capacity,omitempty"`
+	Profile  *testExtraModel `json:"profile,omitempty"`
+
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+func (model testExtraModel) MarshalJSON() ([]byte, error) {
+	type plain testExtraModel
+	if len(model.Extra) == 0 {
+		return json.Marshal(plain(model))
+	}
+	return marshalWithExtra(plain(model), model.Extra)
+}
+
+func unmarshalTestExtraModel(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(testExtraModel)
+	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "capacity", &obj.Capacity)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "profile", &obj.Profile, unmarshalTestExtraModel)
+	if err != nil {
+		return
+	}
+	obj.Extra = unknownProperties(m, obj)
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// newUnknownTestClient returns a client for the tests of the values unknown to the SDK.
+func newUnknownTestClient(url string) *VpcV1 {
+	vpc, err := NewVpcV1(&VpcV1Options{URL: url, Authenticator: &core.NoAuthAuthenticator{}})
+	Expect(err).To(BeNil())
+	return vpc
+}
+
+var _ = Describe(`Unknown properties`, func() {
+	const body = `{"capacity":10,"encryption":{"crn":"crn:1"},"name":"v-1","profile":{"name":"general","tier":3}}`
+	var m map[string]json.RawMessage
+	var vpc *VpcV1
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(body), &m)).To(Succeed())
+		vpc = newUnknownTestClient("http://localhost")
+	})
+
+	It(`Should drop the unknown properties by default`, func() {
+		Expect(vpc.GetRetainUnknownProperties()).To(BeFalse())
+		var result *testExtraModel
+		Expect(vpc.unmarshalModel(m, "", &result, unmarshalTestExtraModel)).To(Succeed())
+		Expect(result.Extra).To(BeNil())
+		Expect(result.Profile.Extra).To(BeNil())
+
+		data, err := json.Marshal(result)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"name":"v-1","capacity":10,"profile":{"name":"general"}}`))
+	})
+	It(`Should keep the unknown properties and marshal them again`, func() {
+		vpc.SetRetainUnknownProperties(true)
+		Expect(vpc.GetRetainUnknownProperties()).To(BeTrue())
+		var result *testExtraModel
+		Expect(vpc.unmarshalModel(m, "", &result, unmarshalTestExtraModel)).To(Succeed())
+		Expect(result.Extra).To(HaveLen(1))
+		Expect(string(result.Extra["encryption"])).To(Equal(`{"crn":"crn:1"}`))
+		Expect(string(result.Profile.Extra["tier"])).To(Equal(`3`))
+
+		data, err := json.Marshal(result)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(body))
+
+		// Values are marshalled the same way as pointers.
+		data, err = json.Marshal(*result)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(body))
+
+		// The unknown properties follow the declared ones.
+		Expect(string(data)).To(Equal(`{"name":"v-1","capacity":10,"profile":{"name":"general","tier":3},"encryption":{"crn":"crn:1"}}`))
+	})
+	It(`Should apply the setting of each client to the results of its methods`, func() {
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "i-1", "name": "web", "quantum_mode": "sgx", "vcpu": {"architecture": "amd64", "turbo": {"limit": 200}}}`)
+		}))
+		defer server.Close()
+		vpc = newUnknownTestClient(server.URL)
+		retaining := vpc.Clone()
+		retaining.SetRetainUnknownProperties(true)
+
+		instance, _, err := retaining.GetInstance(&GetInstanceOptions{ID: core.StringPtr("i-1")})
+		Expect(err).To(BeNil())
+		Expect(instance.Extra).To(HaveKey("quantum_mode"))
+		Expect(instance.Vcpu.Extra).To(HaveKey("turbo"))
+
+		instance, _, err = vpc.GetInstance(&GetInstanceOptions{ID: core.StringPtr("i-1")})
+		Expect(err).To(BeNil())
+		Expect(*instance.Name).To(Equal("web"))
+		Expect(instance.Extra).To(BeNil())
+		Expect(instance.Vcpu.Extra).To(BeNil())
+		Expect(vpc.GetRetainUnknownProperties()).To(BeFalse())
+	})
+	It(`Should not let the unknown properties override the declared ones`, func() {
+		model := testExtraModel{
+			Name: core.StringPtr("v-2"),
+			Extra: map[string]json.RawMessage{
+				"name": json.RawMessage(`"other"`),
+				"zone": json.RawMessage(`"us-south-1"`),
+			},
+		}
+		data, err := json.Marshal(model)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"name":"v-2","zone":"us-south-1"}`))
+
+		model.Extra["zone"] = json.RawMessage(`us-south-1`)
+		_, err = json.Marshal(model)
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Should keep the unknown properties of unknown union variants`, func() {
+		var result *testExtraModel
+		Expect(unmarshalUnknownVariant[testExtraModel](m, "name", &result)).To(Succeed())
+		Expect(*result.Name).To(Equal("v-1"))
+		Expect(result.Extra).To(HaveKey("encryption"))
+	})
+	It(`Should compare the unknown properties as JSON values`, func() {
+		a := &testExtraModel{Name: core.StringPtr("v-1"), Extra: map[string]json.RawMessage{
+			"tier": json.RawMessage(`{"a": 1, "b": [1, 2]}`),
+			"href": json.RawMessage(`"https://a"`),
+		}}
+		b := DeepCopyModel(a)
+		b.Extra["tier"] = json.RawMessage(`{"b":[1,2],"a":1}`)
+		Expect(ModelsEqual(a, b)).To(BeTrue())
+
+		b.Extra["href"] = json.RawMessage(`"https://b"`)
+		Expect(ModelsEqual(a, b)).To(BeFalse())
+		Expect(ModelsEqual(a, b, IgnoreServerManaged())).To(BeTrue())
+		delete(b.Extra, "tier")
+		Expect(ModelsEqual(a, b, IgnoreServerManaged())).To(BeFalse())
+	})
+})
+
+// BenchmarkListInstances measures the unmarshalling of the response of ListInstances, a page of
+// instances with properties unknown to the SDK, as the API returns them after it adds properties.
+func BenchmarkListInstances(b *testing.B) {
+	instances := make([]string, 50)
+	for i := range instances {
+		instances[i] = fmt.Sprintf(`{"id": "i-%[1]d", "name": "web-%[1]d", "status": "running", "quantum_mode": "sgx", `+
+			`"vcpu": {"architecture": "amd64", "count": 2, "turbo": {"limit": 200}}, `+
+			`"primary_network_interface": {"id": "n-%[1]d", "name": "eth0", "subnet": {"id": "s-1", "name": "subnet"}}}`, i)
+	}
+	body := []byte(fmt.Sprintf(`{"limit": 50, "total_count": 50, "first": {"href": "https://vpc/instances"}, "instances": [%s]}`,
+		strings.Join(instances, ", ")))
+
+	for _, retain := range []bool{false, true} {
+		b.Run(fmt.Sprintf("retain=%t", retain), func(b *testing.B) {
+			vpc, err := NewVpcV1(&VpcV1Options{URL: "http://localhost", Authenticator: &core.NoAuthAuthenticator{}})
+			if err != nil {
+				b.Fatal(err)
+			}
+			vpc.SetRetainUnknownProperties(retain)
+			b.ReportAllocs()
+			for b.Loop() {
+				var rawResponse map[string]json.RawMessage
+				if err := json.Unmarshal(body, &rawResponse); err != nil {
+					b.Fatal(err)
+				}
+				var result *InstanceCollection
+				if err := vpc.unmarshalModel(rawResponse, "", &result, UnmarshalInstanceCollection); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
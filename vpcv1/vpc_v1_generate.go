@@ -0,0 +1,36 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// The VpcV1API interfaces (vpc_v1_api.go), their mock (../vpcv1mock), the iterators over the items
+// of the pagers (vpc_v1_iterators_gen.go) and the constructors resuming the pagers
+// (vpc_v1_checkpoints_gen.go) are derived from the methods of VpcV1, and the DeepCopy and Equal
+// methods of the models (vpc_v1_models_gen.go) from the models. The Unmarshal functions of the
+// discriminated unions are rewritten to accept variants unknown to the SDK (see
+// UnknownVariantError), the models to keep the properties unknown to the SDK (see
+// VpcV1.SetRetainUnknownProperties), the methods to return a problem unwrapping to an *APIError for
+// an error response, to pass their operation to the transport of the client on the context of their
+// request, to send it with VpcV1.request, which reports the operation to the metrics recorder of
+// the client once all of its attempts are made, and to apply the settings of the client for the
+// values unknown to the SDK to their result, the pagers become aliases of Pager, and VpcV1Options
+// gets the options set on the client after it is constructed, such as EndpointResolver, which
+// NewVpcV1 applies before NewVpcV1UsingExternalConfig configures the client with configureService.
+// Regenerate them whenever the service code changes:
+//
+//	go generate ./vpcv1
+
+//go:generate go run ../internal/apigen -unions -extra -errors -context -pagers -unmarshal -options -requests -type VpcV1 -output vpc_v1_api.go -mock ../vpcv1mock/vpc_v1_mock.go -iterators vpc_v1_iterators_gen.go -checkpoints vpc_v1_checkpoints_gen.go -models vpc_v1_models_gen.go
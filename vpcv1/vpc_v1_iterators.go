@@ -0,0 +1,72 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// The iterator method of VpcV1 matching each pager (for example VpcV1.Instances for
+// InstancesPager) is generated into vpc_v1_iterators_gen.go by apigen (see vpc_v1_generate.go).
+// It is built on PagerItems, like the All method of Pager.
+
+import (
+	"context"
+	"iter"
+)
+
+// PageSource : The paging methods shared by the pagers, such as InstancesPager
+type PageSource[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) ([]T, error)
+}
+
+// PagerItems : Return an iterator over the items of the remaining pages of "pager"
+// Pages are retrieved with "ctx" as the iteration progresses, so that a loop that stops early does
+// not retrieve the pages it does not need. The iteration stops at the first error, which is yielded
+// with the zero item; that includes the error of "ctx" once it is canceled.
+//
+// The pager is consumed: the items of a page that a loop stops in are not returned again.
+func PagerItems[T any](ctx context.Context, pager PageSource[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for pager.HasNext() {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// pagerItems returns an iterator over the items of "pager", as returned with "err" by the pager's
+// constructor. The iterator yields "err" if it is not nil.
+func pagerItems[T any, P PageSource[T]](ctx context.Context, pager P, err error) iter.Seq2[T, error] {
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+	return PagerItems[T](ctx, pager)
+}
@@ -0,0 +1,656 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package vpcv1
+
+import (
+	"context"
+	"iter"
+)
+
+// BackupPolicies : Return an iterator over the items of all pages of NewBackupPoliciesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BackupPolicies(ctx context.Context, options *ListBackupPoliciesOptions) iter.Seq2[BackupPolicyIntf, error] {
+	pager, err := vpc.NewBackupPoliciesPager(options)
+	return pagerItems[BackupPolicyIntf](ctx, pager, err)
+}
+
+// BackupPolicyJobs : Return an iterator over the items of all pages of NewBackupPolicyJobsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BackupPolicyJobs(ctx context.Context, options *ListBackupPolicyJobsOptions) iter.Seq2[BackupPolicyJob, error] {
+	pager, err := vpc.NewBackupPolicyJobsPager(options)
+	return pagerItems[BackupPolicyJob](ctx, pager, err)
+}
+
+// BareMetalServerNetworkAttachments : Return an iterator over the items of all pages of NewBareMetalServerNetworkAttachmentsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BareMetalServerNetworkAttachments(ctx context.Context, options *ListBareMetalServerNetworkAttachmentsOptions) iter.Seq2[BareMetalServerNetworkAttachmentIntf, error] {
+	pager, err := vpc.NewBareMetalServerNetworkAttachmentsPager(options)
+	return pagerItems[BareMetalServerNetworkAttachmentIntf](ctx, pager, err)
+}
+
+// BareMetalServerNetworkInterfaces : Return an iterator over the items of all pages of NewBareMetalServerNetworkInterfacesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BareMetalServerNetworkInterfaces(ctx context.Context, options *ListBareMetalServerNetworkInterfacesOptions) iter.Seq2[BareMetalServerNetworkInterfaceIntf, error] {
+	pager, err := vpc.NewBareMetalServerNetworkInterfacesPager(options)
+	return pagerItems[BareMetalServerNetworkInterfaceIntf](ctx, pager, err)
+}
+
+// BareMetalServerProfiles : Return an iterator over the items of all pages of NewBareMetalServerProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BareMetalServerProfiles(ctx context.Context, options *ListBareMetalServerProfilesOptions) iter.Seq2[BareMetalServerProfile, error] {
+	pager, err := vpc.NewBareMetalServerProfilesPager(options)
+	return pagerItems[BareMetalServerProfile](ctx, pager, err)
+}
+
+// BareMetalServers : Return an iterator over the items of all pages of NewBareMetalServersPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) BareMetalServers(ctx context.Context, options *ListBareMetalServersOptions) iter.Seq2[BareMetalServer, error] {
+	pager, err := vpc.NewBareMetalServersPager(options)
+	return pagerItems[BareMetalServer](ctx, pager, err)
+}
+
+// ClusterNetworkInterfaces : Return an iterator over the items of all pages of NewClusterNetworkInterfacesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ClusterNetworkInterfaces(ctx context.Context, options *ListClusterNetworkInterfacesOptions) iter.Seq2[ClusterNetworkInterface, error] {
+	pager, err := vpc.NewClusterNetworkInterfacesPager(options)
+	return pagerItems[ClusterNetworkInterface](ctx, pager, err)
+}
+
+// ClusterNetworkProfiles : Return an iterator over the items of all pages of NewClusterNetworkProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ClusterNetworkProfiles(ctx context.Context, options *ListClusterNetworkProfilesOptions) iter.Seq2[ClusterNetworkProfile, error] {
+	pager, err := vpc.NewClusterNetworkProfilesPager(options)
+	return pagerItems[ClusterNetworkProfile](ctx, pager, err)
+}
+
+// ClusterNetworkSubnetReservedIps : Return an iterator over the items of all pages of NewClusterNetworkSubnetReservedIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ClusterNetworkSubnetReservedIps(ctx context.Context, options *ListClusterNetworkSubnetReservedIpsOptions) iter.Seq2[ClusterNetworkSubnetReservedIP, error] {
+	pager, err := vpc.NewClusterNetworkSubnetReservedIpsPager(options)
+	return pagerItems[ClusterNetworkSubnetReservedIP](ctx, pager, err)
+}
+
+// ClusterNetworkSubnets : Return an iterator over the items of all pages of NewClusterNetworkSubnetsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ClusterNetworkSubnets(ctx context.Context, options *ListClusterNetworkSubnetsOptions) iter.Seq2[ClusterNetworkSubnet, error] {
+	pager, err := vpc.NewClusterNetworkSubnetsPager(options)
+	return pagerItems[ClusterNetworkSubnet](ctx, pager, err)
+}
+
+// ClusterNetworks : Return an iterator over the items of all pages of NewClusterNetworksPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ClusterNetworks(ctx context.Context, options *ListClusterNetworksOptions) iter.Seq2[ClusterNetwork, error] {
+	pager, err := vpc.NewClusterNetworksPager(options)
+	return pagerItems[ClusterNetwork](ctx, pager, err)
+}
+
+// DedicatedHostGroups : Return an iterator over the items of all pages of NewDedicatedHostGroupsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) DedicatedHostGroups(ctx context.Context, options *ListDedicatedHostGroupsOptions) iter.Seq2[DedicatedHostGroup, error] {
+	pager, err := vpc.NewDedicatedHostGroupsPager(options)
+	return pagerItems[DedicatedHostGroup](ctx, pager, err)
+}
+
+// DedicatedHostProfiles : Return an iterator over the items of all pages of NewDedicatedHostProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) DedicatedHostProfiles(ctx context.Context, options *ListDedicatedHostProfilesOptions) iter.Seq2[DedicatedHostProfile, error] {
+	pager, err := vpc.NewDedicatedHostProfilesPager(options)
+	return pagerItems[DedicatedHostProfile](ctx, pager, err)
+}
+
+// DedicatedHosts : Return an iterator over the items of all pages of NewDedicatedHostsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) DedicatedHosts(ctx context.Context, options *ListDedicatedHostsOptions) iter.Seq2[DedicatedHost, error] {
+	pager, err := vpc.NewDedicatedHostsPager(options)
+	return pagerItems[DedicatedHost](ctx, pager, err)
+}
+
+// EndpointGatewayIps : Return an iterator over the items of all pages of NewEndpointGatewayIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) EndpointGatewayIps(ctx context.Context, options *ListEndpointGatewayIpsOptions) iter.Seq2[ReservedIP, error] {
+	pager, err := vpc.NewEndpointGatewayIpsPager(options)
+	return pagerItems[ReservedIP](ctx, pager, err)
+}
+
+// EndpointGatewayResourceBindings : Return an iterator over the items of all pages of NewEndpointGatewayResourceBindingsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) EndpointGatewayResourceBindings(ctx context.Context, options *ListEndpointGatewayResourceBindingsOptions) iter.Seq2[EndpointGatewayResourceBinding, error] {
+	pager, err := vpc.NewEndpointGatewayResourceBindingsPager(options)
+	return pagerItems[EndpointGatewayResourceBinding](ctx, pager, err)
+}
+
+// EndpointGateways : Return an iterator over the items of all pages of NewEndpointGatewaysPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) EndpointGateways(ctx context.Context, options *ListEndpointGatewaysOptions) iter.Seq2[EndpointGateway, error] {
+	pager, err := vpc.NewEndpointGatewaysPager(options)
+	return pagerItems[EndpointGateway](ctx, pager, err)
+}
+
+// FloatingIps : Return an iterator over the items of all pages of NewFloatingIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) FloatingIps(ctx context.Context, options *ListFloatingIpsOptions) iter.Seq2[FloatingIP, error] {
+	pager, err := vpc.NewFloatingIpsPager(options)
+	return pagerItems[FloatingIP](ctx, pager, err)
+}
+
+// FlowLogCollectors : Return an iterator over the items of all pages of NewFlowLogCollectorsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) FlowLogCollectors(ctx context.Context, options *ListFlowLogCollectorsOptions) iter.Seq2[FlowLogCollector, error] {
+	pager, err := vpc.NewFlowLogCollectorsPager(options)
+	return pagerItems[FlowLogCollector](ctx, pager, err)
+}
+
+// IkePolicies : Return an iterator over the items of all pages of NewIkePoliciesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) IkePolicies(ctx context.Context, options *ListIkePoliciesOptions) iter.Seq2[IkePolicy, error] {
+	pager, err := vpc.NewIkePoliciesPager(options)
+	return pagerItems[IkePolicy](ctx, pager, err)
+}
+
+// IkePolicyConnections : Return an iterator over the items of all pages of NewIkePolicyConnectionsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) IkePolicyConnections(ctx context.Context, options *ListIkePolicyConnectionsOptions) iter.Seq2[VPNGatewayConnectionIntf, error] {
+	pager, err := vpc.NewIkePolicyConnectionsPager(options)
+	return pagerItems[VPNGatewayConnectionIntf](ctx, pager, err)
+}
+
+// ImageBareMetalServerProfiles : Return an iterator over the items of all pages of NewImageBareMetalServerProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ImageBareMetalServerProfiles(ctx context.Context, options *ListImageBareMetalServerProfilesOptions) iter.Seq2[BareMetalServerProfileReference, error] {
+	pager, err := vpc.NewImageBareMetalServerProfilesPager(options)
+	return pagerItems[BareMetalServerProfileReference](ctx, pager, err)
+}
+
+// ImageInstanceProfiles : Return an iterator over the items of all pages of NewImageInstanceProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ImageInstanceProfiles(ctx context.Context, options *ListImageInstanceProfilesOptions) iter.Seq2[InstanceProfileReference, error] {
+	pager, err := vpc.NewImageInstanceProfilesPager(options)
+	return pagerItems[InstanceProfileReference](ctx, pager, err)
+}
+
+// Images : Return an iterator over the items of all pages of NewImagesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Images(ctx context.Context, options *ListImagesOptions) iter.Seq2[Image, error] {
+	pager, err := vpc.NewImagesPager(options)
+	return pagerItems[Image](ctx, pager, err)
+}
+
+// InstanceClusterNetworkAttachments : Return an iterator over the items of all pages of NewInstanceClusterNetworkAttachmentsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceClusterNetworkAttachments(ctx context.Context, options *ListInstanceClusterNetworkAttachmentsOptions) iter.Seq2[InstanceClusterNetworkAttachment, error] {
+	pager, err := vpc.NewInstanceClusterNetworkAttachmentsPager(options)
+	return pagerItems[InstanceClusterNetworkAttachment](ctx, pager, err)
+}
+
+// InstanceGroupManagerActions : Return an iterator over the items of all pages of NewInstanceGroupManagerActionsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceGroupManagerActions(ctx context.Context, options *ListInstanceGroupManagerActionsOptions) iter.Seq2[InstanceGroupManagerActionIntf, error] {
+	pager, err := vpc.NewInstanceGroupManagerActionsPager(options)
+	return pagerItems[InstanceGroupManagerActionIntf](ctx, pager, err)
+}
+
+// InstanceGroupManagerPolicies : Return an iterator over the items of all pages of NewInstanceGroupManagerPoliciesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceGroupManagerPolicies(ctx context.Context, options *ListInstanceGroupManagerPoliciesOptions) iter.Seq2[InstanceGroupManagerPolicyIntf, error] {
+	pager, err := vpc.NewInstanceGroupManagerPoliciesPager(options)
+	return pagerItems[InstanceGroupManagerPolicyIntf](ctx, pager, err)
+}
+
+// InstanceGroupManagers : Return an iterator over the items of all pages of NewInstanceGroupManagersPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceGroupManagers(ctx context.Context, options *ListInstanceGroupManagersOptions) iter.Seq2[InstanceGroupManagerIntf, error] {
+	pager, err := vpc.NewInstanceGroupManagersPager(options)
+	return pagerItems[InstanceGroupManagerIntf](ctx, pager, err)
+}
+
+// InstanceGroupMemberships : Return an iterator over the items of all pages of NewInstanceGroupMembershipsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceGroupMemberships(ctx context.Context, options *ListInstanceGroupMembershipsOptions) iter.Seq2[InstanceGroupMembership, error] {
+	pager, err := vpc.NewInstanceGroupMembershipsPager(options)
+	return pagerItems[InstanceGroupMembership](ctx, pager, err)
+}
+
+// InstanceGroups : Return an iterator over the items of all pages of NewInstanceGroupsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceGroups(ctx context.Context, options *ListInstanceGroupsOptions) iter.Seq2[InstanceGroup, error] {
+	pager, err := vpc.NewInstanceGroupsPager(options)
+	return pagerItems[InstanceGroup](ctx, pager, err)
+}
+
+// InstanceNetworkInterfaceIps : Return an iterator over the items of all pages of NewInstanceNetworkInterfaceIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceNetworkInterfaceIps(ctx context.Context, options *ListInstanceNetworkInterfaceIpsOptions) iter.Seq2[ReservedIP, error] {
+	pager, err := vpc.NewInstanceNetworkInterfaceIpsPager(options)
+	return pagerItems[ReservedIP](ctx, pager, err)
+}
+
+// InstanceProfiles : Return an iterator over the items of all pages of NewInstanceProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) InstanceProfiles(ctx context.Context, options *ListInstanceProfilesOptions) iter.Seq2[InstanceProfile, error] {
+	pager, err := vpc.NewInstanceProfilesPager(options)
+	return pagerItems[InstanceProfile](ctx, pager, err)
+}
+
+// Instances : Return an iterator over the items of all pages of NewInstancesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Instances(ctx context.Context, options *ListInstancesOptions) iter.Seq2[Instance, error] {
+	pager, err := vpc.NewInstancesPager(options)
+	return pagerItems[Instance](ctx, pager, err)
+}
+
+// IpsecPolicies : Return an iterator over the items of all pages of NewIpsecPoliciesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) IpsecPolicies(ctx context.Context, options *ListIpsecPoliciesOptions) iter.Seq2[IPsecPolicy, error] {
+	pager, err := vpc.NewIpsecPoliciesPager(options)
+	return pagerItems[IPsecPolicy](ctx, pager, err)
+}
+
+// IpsecPolicyConnections : Return an iterator over the items of all pages of NewIpsecPolicyConnectionsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) IpsecPolicyConnections(ctx context.Context, options *ListIpsecPolicyConnectionsOptions) iter.Seq2[VPNGatewayConnectionIntf, error] {
+	pager, err := vpc.NewIpsecPolicyConnectionsPager(options)
+	return pagerItems[VPNGatewayConnectionIntf](ctx, pager, err)
+}
+
+// Keys : Return an iterator over the items of all pages of NewKeysPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Keys(ctx context.Context, options *ListKeysOptions) iter.Seq2[Key, error] {
+	pager, err := vpc.NewKeysPager(options)
+	return pagerItems[Key](ctx, pager, err)
+}
+
+// LoadBalancerProfiles : Return an iterator over the items of all pages of NewLoadBalancerProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) LoadBalancerProfiles(ctx context.Context, options *ListLoadBalancerProfilesOptions) iter.Seq2[LoadBalancerProfile, error] {
+	pager, err := vpc.NewLoadBalancerProfilesPager(options)
+	return pagerItems[LoadBalancerProfile](ctx, pager, err)
+}
+
+// LoadBalancers : Return an iterator over the items of all pages of NewLoadBalancersPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) LoadBalancers(ctx context.Context, options *ListLoadBalancersOptions) iter.Seq2[LoadBalancer, error] {
+	pager, err := vpc.NewLoadBalancersPager(options)
+	return pagerItems[LoadBalancer](ctx, pager, err)
+}
+
+// NetworkACLRules : Return an iterator over the items of all pages of NewNetworkACLRulesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) NetworkACLRules(ctx context.Context, options *ListNetworkACLRulesOptions) iter.Seq2[NetworkACLRuleItemIntf, error] {
+	pager, err := vpc.NewNetworkACLRulesPager(options)
+	return pagerItems[NetworkACLRuleItemIntf](ctx, pager, err)
+}
+
+// NetworkAcls : Return an iterator over the items of all pages of NewNetworkAclsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) NetworkAcls(ctx context.Context, options *ListNetworkAclsOptions) iter.Seq2[NetworkACL, error] {
+	pager, err := vpc.NewNetworkAclsPager(options)
+	return pagerItems[NetworkACL](ctx, pager, err)
+}
+
+// NetworkInterfaceFloatingIps : Return an iterator over the items of all pages of NewNetworkInterfaceFloatingIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) NetworkInterfaceFloatingIps(ctx context.Context, options *ListNetworkInterfaceFloatingIpsOptions) iter.Seq2[FloatingIPReference, error] {
+	pager, err := vpc.NewNetworkInterfaceFloatingIpsPager(options)
+	return pagerItems[FloatingIPReference](ctx, pager, err)
+}
+
+// OperatingSystems : Return an iterator over the items of all pages of NewOperatingSystemsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) OperatingSystems(ctx context.Context, options *ListOperatingSystemsOptions) iter.Seq2[OperatingSystem, error] {
+	pager, err := vpc.NewOperatingSystemsPager(options)
+	return pagerItems[OperatingSystem](ctx, pager, err)
+}
+
+// PlacementGroups : Return an iterator over the items of all pages of NewPlacementGroupsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PlacementGroups(ctx context.Context, options *ListPlacementGroupsOptions) iter.Seq2[PlacementGroup, error] {
+	pager, err := vpc.NewPlacementGroupsPager(options)
+	return pagerItems[PlacementGroup](ctx, pager, err)
+}
+
+// PrivatePathServiceGatewayAccountPolicies : Return an iterator over the items of all pages of NewPrivatePathServiceGatewayAccountPoliciesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PrivatePathServiceGatewayAccountPolicies(ctx context.Context, options *ListPrivatePathServiceGatewayAccountPoliciesOptions) iter.Seq2[PrivatePathServiceGatewayAccountPolicy, error] {
+	pager, err := vpc.NewPrivatePathServiceGatewayAccountPoliciesPager(options)
+	return pagerItems[PrivatePathServiceGatewayAccountPolicy](ctx, pager, err)
+}
+
+// PrivatePathServiceGatewayEndpointGatewayBindings : Return an iterator over the items of all pages of NewPrivatePathServiceGatewayEndpointGatewayBindingsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PrivatePathServiceGatewayEndpointGatewayBindings(ctx context.Context, options *ListPrivatePathServiceGatewayEndpointGatewayBindingsOptions) iter.Seq2[PrivatePathServiceGatewayEndpointGatewayBinding, error] {
+	pager, err := vpc.NewPrivatePathServiceGatewayEndpointGatewayBindingsPager(options)
+	return pagerItems[PrivatePathServiceGatewayEndpointGatewayBinding](ctx, pager, err)
+}
+
+// PrivatePathServiceGateways : Return an iterator over the items of all pages of NewPrivatePathServiceGatewaysPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PrivatePathServiceGateways(ctx context.Context, options *ListPrivatePathServiceGatewaysOptions) iter.Seq2[PrivatePathServiceGateway, error] {
+	pager, err := vpc.NewPrivatePathServiceGatewaysPager(options)
+	return pagerItems[PrivatePathServiceGateway](ctx, pager, err)
+}
+
+// PublicAddressRanges : Return an iterator over the items of all pages of NewPublicAddressRangesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PublicAddressRanges(ctx context.Context, options *ListPublicAddressRangesOptions) iter.Seq2[PublicAddressRange, error] {
+	pager, err := vpc.NewPublicAddressRangesPager(options)
+	return pagerItems[PublicAddressRange](ctx, pager, err)
+}
+
+// PublicGateways : Return an iterator over the items of all pages of NewPublicGatewaysPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) PublicGateways(ctx context.Context, options *ListPublicGatewaysOptions) iter.Seq2[PublicGateway, error] {
+	pager, err := vpc.NewPublicGatewaysPager(options)
+	return pagerItems[PublicGateway](ctx, pager, err)
+}
+
+// Reservations : Return an iterator over the items of all pages of NewReservationsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Reservations(ctx context.Context, options *ListReservationsOptions) iter.Seq2[Reservation, error] {
+	pager, err := vpc.NewReservationsPager(options)
+	return pagerItems[Reservation](ctx, pager, err)
+}
+
+// SecurityGroupTargets : Return an iterator over the items of all pages of NewSecurityGroupTargetsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) SecurityGroupTargets(ctx context.Context, options *ListSecurityGroupTargetsOptions) iter.Seq2[SecurityGroupTargetReferenceIntf, error] {
+	pager, err := vpc.NewSecurityGroupTargetsPager(options)
+	return pagerItems[SecurityGroupTargetReferenceIntf](ctx, pager, err)
+}
+
+// SecurityGroups : Return an iterator over the items of all pages of NewSecurityGroupsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) SecurityGroups(ctx context.Context, options *ListSecurityGroupsOptions) iter.Seq2[SecurityGroup, error] {
+	pager, err := vpc.NewSecurityGroupsPager(options)
+	return pagerItems[SecurityGroup](ctx, pager, err)
+}
+
+// ShareAccessorBindings : Return an iterator over the items of all pages of NewShareAccessorBindingsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ShareAccessorBindings(ctx context.Context, options *ListShareAccessorBindingsOptions) iter.Seq2[ShareAccessorBinding, error] {
+	pager, err := vpc.NewShareAccessorBindingsPager(options)
+	return pagerItems[ShareAccessorBinding](ctx, pager, err)
+}
+
+// ShareMountTargets : Return an iterator over the items of all pages of NewShareMountTargetsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ShareMountTargets(ctx context.Context, options *ListShareMountTargetsOptions) iter.Seq2[ShareMountTarget, error] {
+	pager, err := vpc.NewShareMountTargetsPager(options)
+	return pagerItems[ShareMountTarget](ctx, pager, err)
+}
+
+// ShareProfiles : Return an iterator over the items of all pages of NewShareProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ShareProfiles(ctx context.Context, options *ListShareProfilesOptions) iter.Seq2[ShareProfile, error] {
+	pager, err := vpc.NewShareProfilesPager(options)
+	return pagerItems[ShareProfile](ctx, pager, err)
+}
+
+// ShareSnapshots : Return an iterator over the items of all pages of NewShareSnapshotsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) ShareSnapshots(ctx context.Context, options *ListShareSnapshotsOptions) iter.Seq2[ShareSnapshot, error] {
+	pager, err := vpc.NewShareSnapshotsPager(options)
+	return pagerItems[ShareSnapshot](ctx, pager, err)
+}
+
+// Shares : Return an iterator over the items of all pages of NewSharesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Shares(ctx context.Context, options *ListSharesOptions) iter.Seq2[Share, error] {
+	pager, err := vpc.NewSharesPager(options)
+	return pagerItems[Share](ctx, pager, err)
+}
+
+// SnapshotConsistencyGroups : Return an iterator over the items of all pages of NewSnapshotConsistencyGroupsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) SnapshotConsistencyGroups(ctx context.Context, options *ListSnapshotConsistencyGroupsOptions) iter.Seq2[SnapshotConsistencyGroup, error] {
+	pager, err := vpc.NewSnapshotConsistencyGroupsPager(options)
+	return pagerItems[SnapshotConsistencyGroup](ctx, pager, err)
+}
+
+// SnapshotInstanceProfiles : Return an iterator over the items of all pages of NewSnapshotInstanceProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) SnapshotInstanceProfiles(ctx context.Context, options *ListSnapshotInstanceProfilesOptions) iter.Seq2[InstanceProfileReference, error] {
+	pager, err := vpc.NewSnapshotInstanceProfilesPager(options)
+	return pagerItems[InstanceProfileReference](ctx, pager, err)
+}
+
+// Snapshots : Return an iterator over the items of all pages of NewSnapshotsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Snapshots(ctx context.Context, options *ListSnapshotsOptions) iter.Seq2[Snapshot, error] {
+	pager, err := vpc.NewSnapshotsPager(options)
+	return pagerItems[Snapshot](ctx, pager, err)
+}
+
+// SubnetReservedIps : Return an iterator over the items of all pages of NewSubnetReservedIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) SubnetReservedIps(ctx context.Context, options *ListSubnetReservedIpsOptions) iter.Seq2[ReservedIP, error] {
+	pager, err := vpc.NewSubnetReservedIpsPager(options)
+	return pagerItems[ReservedIP](ctx, pager, err)
+}
+
+// Subnets : Return an iterator over the items of all pages of NewSubnetsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Subnets(ctx context.Context, options *ListSubnetsOptions) iter.Seq2[Subnet, error] {
+	pager, err := vpc.NewSubnetsPager(options)
+	return pagerItems[Subnet](ctx, pager, err)
+}
+
+// VPCAddressPrefixes : Return an iterator over the items of all pages of NewVPCAddressPrefixesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPCAddressPrefixes(ctx context.Context, options *ListVPCAddressPrefixesOptions) iter.Seq2[AddressPrefix, error] {
+	pager, err := vpc.NewVPCAddressPrefixesPager(options)
+	return pagerItems[AddressPrefix](ctx, pager, err)
+}
+
+// VPCDnsResolutionBindings : Return an iterator over the items of all pages of NewVPCDnsResolutionBindingsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPCDnsResolutionBindings(ctx context.Context, options *ListVPCDnsResolutionBindingsOptions) iter.Seq2[VpcdnsResolutionBinding, error] {
+	pager, err := vpc.NewVPCDnsResolutionBindingsPager(options)
+	return pagerItems[VpcdnsResolutionBinding](ctx, pager, err)
+}
+
+// VPCRoutes : Return an iterator over the items of all pages of NewVPCRoutesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPCRoutes(ctx context.Context, options *ListVPCRoutesOptions) iter.Seq2[Route, error] {
+	pager, err := vpc.NewVPCRoutesPager(options)
+	return pagerItems[Route](ctx, pager, err)
+}
+
+// VPCRoutingTableRoutes : Return an iterator over the items of all pages of NewVPCRoutingTableRoutesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPCRoutingTableRoutes(ctx context.Context, options *ListVPCRoutingTableRoutesOptions) iter.Seq2[Route, error] {
+	pager, err := vpc.NewVPCRoutingTableRoutesPager(options)
+	return pagerItems[Route](ctx, pager, err)
+}
+
+// VPCRoutingTables : Return an iterator over the items of all pages of NewVPCRoutingTablesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPCRoutingTables(ctx context.Context, options *ListVPCRoutingTablesOptions) iter.Seq2[RoutingTable, error] {
+	pager, err := vpc.NewVPCRoutingTablesPager(options)
+	return pagerItems[RoutingTable](ctx, pager, err)
+}
+
+// VPNGatewayConnections : Return an iterator over the items of all pages of NewVPNGatewayConnectionsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNGatewayConnections(ctx context.Context, options *ListVPNGatewayConnectionsOptions) iter.Seq2[VPNGatewayConnectionIntf, error] {
+	pager, err := vpc.NewVPNGatewayConnectionsPager(options)
+	return pagerItems[VPNGatewayConnectionIntf](ctx, pager, err)
+}
+
+// VPNGatewayServiceConnections : Return an iterator over the items of all pages of NewVPNGatewayServiceConnectionsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNGatewayServiceConnections(ctx context.Context, options *ListVPNGatewayServiceConnectionsOptions) iter.Seq2[VPNGatewayServiceConnection, error] {
+	pager, err := vpc.NewVPNGatewayServiceConnectionsPager(options)
+	return pagerItems[VPNGatewayServiceConnection](ctx, pager, err)
+}
+
+// VPNGateways : Return an iterator over the items of all pages of NewVPNGatewaysPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNGateways(ctx context.Context, options *ListVPNGatewaysOptions) iter.Seq2[VPNGatewayIntf, error] {
+	pager, err := vpc.NewVPNGatewaysPager(options)
+	return pagerItems[VPNGatewayIntf](ctx, pager, err)
+}
+
+// VPNServerClients : Return an iterator over the items of all pages of NewVPNServerClientsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNServerClients(ctx context.Context, options *ListVPNServerClientsOptions) iter.Seq2[VPNServerClient, error] {
+	pager, err := vpc.NewVPNServerClientsPager(options)
+	return pagerItems[VPNServerClient](ctx, pager, err)
+}
+
+// VPNServerRoutes : Return an iterator over the items of all pages of NewVPNServerRoutesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNServerRoutes(ctx context.Context, options *ListVPNServerRoutesOptions) iter.Seq2[VPNServerRoute, error] {
+	pager, err := vpc.NewVPNServerRoutesPager(options)
+	return pagerItems[VPNServerRoute](ctx, pager, err)
+}
+
+// VPNServers : Return an iterator over the items of all pages of NewVPNServersPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VPNServers(ctx context.Context, options *ListVPNServersOptions) iter.Seq2[VPNServer, error] {
+	pager, err := vpc.NewVPNServersPager(options)
+	return pagerItems[VPNServer](ctx, pager, err)
+}
+
+// VirtualNetworkInterfaceIps : Return an iterator over the items of all pages of NewVirtualNetworkInterfaceIpsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VirtualNetworkInterfaceIps(ctx context.Context, options *ListVirtualNetworkInterfaceIpsOptions) iter.Seq2[ReservedIPReference, error] {
+	pager, err := vpc.NewVirtualNetworkInterfaceIpsPager(options)
+	return pagerItems[ReservedIPReference](ctx, pager, err)
+}
+
+// VirtualNetworkInterfaces : Return an iterator over the items of all pages of NewVirtualNetworkInterfacesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VirtualNetworkInterfaces(ctx context.Context, options *ListVirtualNetworkInterfacesOptions) iter.Seq2[VirtualNetworkInterface, error] {
+	pager, err := vpc.NewVirtualNetworkInterfacesPager(options)
+	return pagerItems[VirtualNetworkInterface](ctx, pager, err)
+}
+
+// VolumeInstanceProfiles : Return an iterator over the items of all pages of NewVolumeInstanceProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VolumeInstanceProfiles(ctx context.Context, options *ListVolumeInstanceProfilesOptions) iter.Seq2[InstanceProfileReference, error] {
+	pager, err := vpc.NewVolumeInstanceProfilesPager(options)
+	return pagerItems[InstanceProfileReference](ctx, pager, err)
+}
+
+// VolumeJobs : Return an iterator over the items of all pages of NewVolumeJobsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VolumeJobs(ctx context.Context, options *ListVolumeJobsOptions) iter.Seq2[VolumeJobIntf, error] {
+	pager, err := vpc.NewVolumeJobsPager(options)
+	return pagerItems[VolumeJobIntf](ctx, pager, err)
+}
+
+// VolumeProfiles : Return an iterator over the items of all pages of NewVolumeProfilesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) VolumeProfiles(ctx context.Context, options *ListVolumeProfilesOptions) iter.Seq2[VolumeProfile, error] {
+	pager, err := vpc.NewVolumeProfilesPager(options)
+	return pagerItems[VolumeProfile](ctx, pager, err)
+}
+
+// Volumes : Return an iterator over the items of all pages of NewVolumesPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Volumes(ctx context.Context, options *ListVolumesOptions) iter.Seq2[Volume, error] {
+	pager, err := vpc.NewVolumesPager(options)
+	return pagerItems[Volume](ctx, pager, err)
+}
+
+// Vpcs : Return an iterator over the items of all pages of NewVpcsPager
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems). An error
+// creating the pager is yielded by the iterator.
+func (vpc *VpcV1) Vpcs(ctx context.Context, options *ListVpcsOptions) iter.Seq2[VPC, error] {
+	pager, err := vpc.NewVpcsPager(options)
+	return pagerItems[VPC](ctx, pager, err)
+}
@@ -0,0 +1,136 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newInstancePagesServer returns a server listing instances in pages of two, or of the requested
+// limit, and the number of pages it served.
+func newInstancePagesServer(ids ...string) (*httptest.Server, *int32) {
+	var count int32
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&count, 1)
+		start, limit := 0, 2
+		fmt.Sscan(req.URL.Query().Get("start"), &start)
+		fmt.Sscan(req.URL.Query().Get("limit"), &limit)
+		end := min(start+limit, len(ids))
+		instances := ""
+		for i, id := range ids[start:end] {
+			if i > 0 {
+				instances += ", "
+			}
+			instances += fmt.Sprintf(`{"id": %q}`, id)
+		}
+		next := ""
+		if end < len(ids) {
+			next = fmt.Sprintf(`, "next": {"href": "%s/v1/instances?start=%d"}`, server.URL, end)
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprintf(res, `{"instances": [%s], "limit": %d, "total_count": %d%s}`, instances, limit, len(ids), next)
+	}))
+	return server, &count
+}
+
+var _ = Describe(`Iterators`, func() {
+	Describe(`PagerItems`, func() {
+		It(`Should yield the items of all pages`, func() {
+			server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{})
+			Expect(err).To(BeNil())
+			var ids []string
+			for instance, err := range vpcv1.PagerItems(context.Background(), pager) {
+				Expect(err).To(BeNil())
+				ids = append(ids, *instance.ID)
+			}
+			Expect(ids).To(Equal([]string{"i-1", "i-2", "i-3", "i-4", "i-5"}))
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+			Expect(pager.HasNext()).To(BeFalse())
+		})
+		It(`Should not retrieve the pages after a break`, func() {
+			server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{})
+			Expect(err).To(BeNil())
+			var ids []string
+			for instance, err := range vpcv1.PagerItems(context.Background(), pager) {
+				Expect(err).To(BeNil())
+				ids = append(ids, *instance.ID)
+				if len(ids) == 3 {
+					break
+				}
+			}
+			Expect(ids).To(Equal([]string{"i-1", "i-2", "i-3"}))
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+			Expect(pager.HasNext()).To(BeTrue())
+		})
+		It(`Should stop with the error of a canceled context`, func() {
+			server, count := newInstancePagesServer("i-1", "i-2", "i-3")
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{})
+			Expect(err).To(BeNil())
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var ids []string
+			var errs []error
+			for instance, err := range vpcv1.PagerItems(ctx, pager) {
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				ids = append(ids, *instance.ID)
+				cancel()
+			}
+			Expect(ids).To(Equal([]string{"i-1", "i-2"}))
+			Expect(errs).To(Equal([]error{context.Canceled}))
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+		})
+		It(`Should stop with the error of a page`, func() {
+			server := newErrorServer(500, `{"errors": [{"code": "internal_error", "message": "Internal error"}]}`)
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{})
+			Expect(err).To(BeNil())
+			var errs []error
+			for instance, err := range vpcv1.PagerItems(context.Background(), pager) {
+				Expect(instance.ID).To(BeNil())
+				errs = append(errs, err)
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).ToNot(BeNil())
+		})
+	})
+})
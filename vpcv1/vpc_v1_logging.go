@@ -0,0 +1,308 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// RedactedValue replaces the values of sensitive headers and properties in logs.
+const RedactedValue = "[REDACTED]"
+
+// DefaultSensitiveHeaders are the headers that a RequestLogger, and the recorder of the vpcv1recorder
+// package, redact by default.
+var DefaultSensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Auth-Refresh-Token",
+}
+
+// DefaultSensitiveProperties are the JSON properties that a RequestLogger, and the recorder of the
+// vpcv1recorder package, redact by default, wherever they appear in a body: instance user data, VPN
+// pre-shared keys, console access tokens, and the passwords and keys of instance initializations.
+var DefaultSensitiveProperties = []string{
+	"access_token",
+	"api_key",
+	"apikey",
+	"client_secret",
+	"encrypted_password",
+	"passphrase",
+	"password",
+	"private_key",
+	"psk",
+	"refresh_token",
+	"user_data",
+}
+
+// RequestLogger : Logs the requests sent by a VpcV1 instance with log/slog.
+// Each attempt of an operation is logged at the debug level, once when it is sent and once when
+// its response is received, with the operation ID, method, URL and request ID. Attempts that fail
+// to get a response are logged at the warning level. Headers, and bodies if LogBodies is set, are
+// included with the values of sensitive headers and JSON properties replaced with RedactedValue.
+//
+// Unlike the debug logging of the core library, which logs raw bodies, a RequestLogger is meant to
+// be safe to enable in production, provided that any additional sensitive property is registered
+// with RedactProperties.
+type RequestLogger struct {
+	// The logger that the records are written to.
+	Logger *slog.Logger
+
+	// Whether the request and response bodies are logged.
+	LogBodies bool
+
+	// The headers whose values are redacted.
+	SensitiveHeaders []string
+
+	// The JSON properties whose values are redacted.
+	SensitiveProperties map[string]bool
+}
+
+// NewRequestLogger : Instantiate RequestLogger writing to "logger" (slog.Default() if nil), with
+// the default sensitive headers and properties.
+func NewRequestLogger(logger *slog.Logger) *RequestLogger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	requestLogger := &RequestLogger{
+		Logger:              logger,
+		SensitiveHeaders:    slices.Clone(DefaultSensitiveHeaders),
+		SensitiveProperties: make(map[string]bool),
+	}
+	return requestLogger.RedactProperties(DefaultSensitiveProperties...)
+}
+
+// SetLogBodies : Allow user to set LogBodies
+func (requestLogger *RequestLogger) SetLogBodies(logBodies bool) *RequestLogger {
+	requestLogger.LogBodies = logBodies
+	return requestLogger
+}
+
+// RedactHeaders : Redact the specified headers, in addition to the current ones
+func (requestLogger *RequestLogger) RedactHeaders(names ...string) *RequestLogger {
+	requestLogger.SensitiveHeaders = append(requestLogger.SensitiveHeaders, names...)
+	return requestLogger
+}
+
+// RedactProperties : Redact the specified JSON properties, in addition to the current ones
+func (requestLogger *RequestLogger) RedactProperties(names ...string) *RequestLogger {
+	if requestLogger.SensitiveProperties == nil {
+		requestLogger.SensitiveProperties = make(map[string]bool)
+	}
+	for _, name := range names {
+		requestLogger.SensitiveProperties[name] = true
+	}
+	return requestLogger
+}
+
+// SetRequestLogger : Log the requests sent by the service with "requestLogger"
+// The logger is copied, so later changes to "requestLogger" do not apply to the service. A nil
+// logger stops the logging.
+func (vpc *VpcV1) SetRequestLogger(requestLogger *RequestLogger) {
+	if requestLogger != nil {
+		requestLogger = requestLogger.clone()
+	}
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.requestLogger = requestLogger
+	})
+}
+
+// GetRequestLogger : Return the request logger set with SetRequestLogger, or nil
+func (vpc *VpcV1) GetRequestLogger() *RequestLogger {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.requestLogger
+	}
+	return nil
+}
+
+// clone returns a copy of "requestLogger" that shares no state with it.
+func (requestLogger *RequestLogger) clone() *RequestLogger {
+	clone := *requestLogger
+	if clone.Logger == nil {
+		clone.Logger = slog.Default()
+	}
+	clone.SensitiveHeaders = slices.Clone(requestLogger.SensitiveHeaders)
+	clone.SensitiveProperties = maps.Clone(requestLogger.SensitiveProperties)
+	return &clone
+}
+
+// roundTrip sends "req", an attempt of operation "operationID", with "next" and logs it.
+func (requestLogger *RequestLogger) roundTrip(next http.RoundTripper, operationID string, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	debug := requestLogger.Logger.Enabled(ctx, slog.LevelDebug)
+	if !debug && !requestLogger.Logger.Enabled(ctx, slog.LevelWarn) {
+		return next.RoundTrip(req)
+	}
+
+	attributes := []slog.Attr{
+		slog.String("operation_id", operationID),
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.String()),
+		slog.String("request_id", req.Header.Get(common.X_REQUEST_ID)),
+	}
+	if debug {
+		requestAttributes := append(slices.Clone(attributes), requestLogger.headersAttr(req.Header))
+		if requestLogger.LogBodies && req.Body != nil && req.Body != http.NoBody {
+			var body []byte
+			var err error
+			req, body, err = readRequestBody(req)
+			if err != nil {
+				return nil, err
+			}
+			requestAttributes = append(requestAttributes, slog.String("body", requestLogger.redactBody(body, req.Header)))
+		}
+		requestLogger.Logger.LogAttrs(ctx, slog.LevelDebug, "VPC API request", requestAttributes...)
+	}
+
+	start := time.Now()
+	resp, err := next.RoundTrip(req)
+	attributes = append(attributes, slog.Duration("duration", time.Since(start)))
+	if err != nil {
+		attributes = append(attributes, slog.String("error", err.Error()))
+		requestLogger.Logger.LogAttrs(ctx, slog.LevelWarn, "VPC API request failed", attributes...)
+		return resp, err
+	}
+	if !debug {
+		return resp, err
+	}
+
+	attributes = append(attributes, slog.Int("status", resp.StatusCode), requestLogger.headersAttr(resp.Header))
+	if requestLogger.LogBodies && resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			return nil, readErr
+		}
+		attributes = append(attributes, slog.String("body", requestLogger.redactBody(body, resp.Header)))
+	}
+	requestLogger.Logger.LogAttrs(ctx, slog.LevelDebug, "VPC API response", attributes...)
+	return resp, err
+}
+
+// readRequestBody returns the body of "req", and a request that can still be sent.
+func readRequestBody(req *http.Request) (*http.Request, []byte, error) {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, err
+		}
+		defer body.Close()
+		data, err := io.ReadAll(body)
+		return req, data, err
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, nil, err
+	}
+	// A RoundTripper must not modify the caller's request.
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+	return req, data, nil
+}
+
+// headersAttr returns "headers", with the sensitive ones redacted, as a group attribute.
+func (requestLogger *RequestLogger) headersAttr(headers http.Header) slog.Attr {
+	names := slices.Sorted(maps.Keys(headers))
+	attributes := make([]any, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(headers.Values(name), ", ")
+		if slices.ContainsFunc(requestLogger.SensitiveHeaders, func(sensitive string) bool {
+			return strings.EqualFold(sensitive, name)
+		}) {
+			value = RedactedValue
+		}
+		attributes = append(attributes, slog.String(name, value))
+	}
+	return slog.Group("headers", attributes...)
+}
+
+// redactBody returns "body" with the sensitive JSON properties redacted. Bodies that are not
+// JSON are replaced with a description, since they cannot be redacted.
+func (requestLogger *RequestLogger) redactBody(body []byte, headers http.Header) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if headers.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err == nil {
+			body, err = io.ReadAll(reader)
+		}
+		if err != nil {
+			return fmt.Sprintf("[%d bytes of compressed data]", len(body))
+		}
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&value) != nil {
+		return fmt.Sprintf("[%d bytes of %s data]", len(body), headers.Get("Content-Type"))
+	}
+	redacted, err := json.Marshal(requestLogger.RedactJSON(value))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes of %s data]", len(body), headers.Get("Content-Type"))
+	}
+	return string(redacted)
+}
+
+// RedactJSON : Redact the sensitive properties of a decoded JSON value
+// The values of the sensitive properties of "value", as decoded by encoding/json, are replaced
+// with RedactedValue wherever they appear, in place, and "value" is returned. The recorder of the
+// vpcv1recorder package redacts its cassettes with it.
+func (requestLogger *RequestLogger) RedactJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if requestLogger.SensitiveProperties[key] {
+				value[key] = RedactedValue
+			} else {
+				value[key] = requestLogger.RedactJSON(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = requestLogger.RedactJSON(item)
+		}
+	}
+	return value
+}
+
+// logAttempts returns a transport that sends each attempt of operation "operationID" with "next"
+// and logs it with "requestLogger".
+func logAttempts(requestLogger *RequestLogger, operationID string, next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return requestLogger.roundTrip(next, operationID, req)
+	})
+}
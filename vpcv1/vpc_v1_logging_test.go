@@ -0,0 +1,128 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// decodeRecords returns the JSON log records written to "output".
+func decodeRecords(output *bytes.Buffer) []map[string]interface{} {
+	var records []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var record map[string]interface{}
+		Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+		records = append(records, record)
+	}
+	return records
+}
+
+var _ = Describe(`RequestLogger`, func() {
+	var output *bytes.Buffer
+	var logger *slog.Logger
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		logger = slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	})
+
+	It(`Should log requests and responses without secrets`, func() {
+		server, received := newHeaderServer(200, `{"keys": [{"id": "k-1"}], "password": {"encrypted_password": "c2VjcmV0", "encryption_key": {"id": "k-1"}}, "user_data": "#cloud-config"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		requestLogger := vpcv1.NewRequestLogger(logger).SetLogBodies(true).RedactProperties("tenant_secret")
+		vpcService.SetRequestLogger(requestLogger)
+		requestLogger.SetLogBodies(false)
+		Expect(vpcService.GetRequestLogger().LogBodies).To(BeTrue())
+
+		req, err := http.NewRequest(http.MethodPost, server.URL+"/vpn_gateways/g-1/connections",
+			strings.NewReader(`{"name": "connection", "psk": "lkj14b1oi0alcniejkso", "extra": {"tenant_secret": "t0p"}}`))
+		Expect(err).To(BeNil())
+		req.Header.Set("Authorization", "Bearer secret-token")
+		req.Header.Set("Content-Type", "application/json")
+		res, err := vpcService.Service.GetHTTPClient().Do(req)
+		Expect(err).To(BeNil())
+		body, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		Expect(string(body)).To(ContainSubstring("c2VjcmV0"))
+		Expect(received.Get("Authorization")).To(Equal("Bearer secret-token"))
+
+		logged := output.String()
+		for _, secret := range []string{"secret-token", "lkj14b1oi0alcniejkso", "t0p", "c2VjcmV0", "#cloud-config"} {
+			Expect(logged).ToNot(ContainSubstring(secret))
+		}
+		records := decodeRecords(output)
+		Expect(records).To(HaveLen(2))
+		Expect(records[0]["msg"]).To(Equal("VPC API request"))
+		Expect(records[0]["level"]).To(Equal("DEBUG"))
+		Expect(records[0]["method"]).To(Equal("POST"))
+		Expect(records[0]["headers"]).To(HaveKeyWithValue("Authorization", vpcv1.RedactedValue))
+		Expect(records[0]["body"]).To(ContainSubstring(`"name":"connection"`))
+		Expect(records[1]["msg"]).To(Equal("VPC API response"))
+		Expect(records[1]["status"]).To(Equal(float64(200)))
+		Expect(records[1]["body"]).To(ContainSubstring(`"id":"k-1"`))
+	})
+	It(`Should log the operation of each attempt`, func() {
+		server, _, _ := newFlakyServer(1, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(fastRetries)
+		vpcService.SetRequestLogger(vpcv1.NewRequestLogger(logger))
+		_, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+
+		records := decodeRecords(output)
+		Expect(records).To(HaveLen(4))
+		for _, record := range records {
+			Expect(record["operation_id"]).To(Equal("GetInstance"))
+			Expect(record).ToNot(HaveKey("body"))
+		}
+		Expect(records[1]["status"]).ToNot(Equal(float64(200)))
+		Expect(records[3]["status"]).To(Equal(float64(200)))
+		Expect(records[3]["request_id"]).To(Equal(response.GetHeaders().Get("X-Request-Id")))
+	})
+	It(`Should log failed requests as warnings`, func() {
+		logger = slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		vpcService := newTestVpcService("http://127.0.0.1:1")
+		vpcService.SetRequestLogger(vpcv1.NewRequestLogger(logger))
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+
+		records := decodeRecords(output)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0]["level"]).To(Equal("WARN"))
+		Expect(records[0]["msg"]).To(Equal("VPC API request failed"))
+		Expect(records[0]).To(HaveKey("error"))
+
+		vpcService.SetRequestLogger(nil)
+		Expect(vpcService.GetRequestLogger()).To(BeNil())
+	})
+})
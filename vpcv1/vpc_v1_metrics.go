@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// MetricsRecorder : The receiver of the measurements of the requests sent by a VpcV1 instance
+// The operation IDs are those of the service methods, for example "GetInstance". The methods are
+// called concurrently by the requests in flight, and must not block.
+type MetricsRecorder interface {
+	// ObserveOperation is called when an operation completes, with the status code of its last
+	// response (0 if none was received), the error of the transport if any, and the time taken by
+	// all of its attempts.
+	ObserveOperation(operationID string, statusCode int, err error, duration time.Duration)
+
+	// ObserveRetry is called before each attempt of an operation after the first, whether it is
+	// made by the retry policy (see SetRetryPolicy) or by the automatic retries enabled with
+	// EnableRetries.
+	ObserveRetry(operationID string)
+
+	// ObserveRateLimited is called for each response throttled by the service (a 429 response).
+	ObserveRateLimited(operationID string)
+
+	// ObserveRateLimitWait is called for each attempt of an operation that the client-side rate
+	// limiter (see SetRateLimiter) held back, with the time it waited.
+	ObserveRateLimitWait(operationID string, wait time.Duration)
+}
+
+// SetMetricsRecorder : Report the requests sent by the service to "recorder"
+// The vpcv1prometheus package provides a recorder that exposes the measurements as Prometheus
+// metrics. A nil recorder stops the reporting.
+func (vpc *VpcV1) SetMetricsRecorder(recorder MetricsRecorder) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.metrics = recorder
+	})
+}
+
+// GetMetricsRecorder : Return the recorder set with SetMetricsRecorder, or nil
+func (vpc *VpcV1) GetMetricsRecorder() MetricsRecorder {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.metrics
+	}
+	return nil
+}
+
+// operationAttempts is the outcome of the attempts of an operation sent by a service method. The
+// automatic retries enabled with EnableRetries send each attempt through the transport as a
+// separate request, so the attempts record their outcome on the context of the request, and
+// VpcV1.request reports the operation once all of them are made. The attempts of an operation are
+// sequential.
+type operationAttempts struct {
+	count      int
+	statusCode int
+	err        error
+}
+
+// operationAttemptsKey is the context key of the operationAttempts of a request.
+type operationAttemptsKey struct{}
+
+// operationAttemptsFromContext returns the operationAttempts carried by "ctx", or nil.
+func operationAttemptsFromContext(ctx context.Context) *operationAttempts {
+	attempts, _ := ctx.Value(operationAttemptsKey{}).(*operationAttempts)
+	return attempts
+}
+
+// request sends "req" with the base service, as core.BaseService.Request does, with the request ID
+// and headers carried by its context (see common.WithRequestID and common.WithHeaders), and, when
+// a metrics recorder is set, reports the operation once all of its attempts are made. The request
+// ID that was sent is set on the response if the service did not echo it. The requests of the
+// mutating calls of a dry-run client are planned rather than sent (see DryRun). The generated
+// service methods send their requests with it (see vpc_v1_generate.go).
+func (vpc *VpcV1) request(req *http.Request, result interface{}) (response *core.DetailedResponse, err error) {
+	req = common.ApplyContextHeaders(req)
+	transport := vpc.getTransport()
+	if transport != nil && transport.dryRun != nil && isMutating(req.Method) {
+		return nil, vpc.planRequest(transport, req)
+	}
+
+	if transport == nil || transport.metrics == nil {
+		response, err = vpc.Service.Request(req, result)
+	} else {
+		attempts := &operationAttempts{}
+		req = req.WithContext(context.WithValue(req.Context(), operationAttemptsKey{}, attempts))
+		start := time.Now()
+		response, err = vpc.Service.Request(req, result)
+		// Requests answered without an attempt, such as dry runs, are not reported.
+		if attempts.count > 0 {
+			transport.metrics.ObserveOperation(operationIDFromContext(req.Context()), attempts.statusCode, attempts.err, time.Since(start))
+		}
+	}
+
+	// Surface the request ID that was sent even if the service did not echo it. An error response
+	// is the one of the returned problem, so its APIError gets the request ID as well.
+	if requestID := req.Header.Get(common.X_REQUEST_ID); response != nil && requestID != "" && common.GetRequestID(response) == "" {
+		if response.Headers == nil {
+			response.Headers = make(http.Header)
+		}
+		response.Headers.Set(common.X_REQUEST_ID, requestID)
+	}
+	return response, err
+}
+
+// observeOperation sends "req", on behalf of operation "operationID", with "send" and reports the
+// outcome to "recorder".
+func observeOperation(recorder MetricsRecorder, operationID string, req *http.Request,
+	send func(operationID string, req *http.Request) (*http.Response, error)) (*http.Response, error) {
+	start := time.Now()
+	resp, err := send(operationID, req)
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+	recorder.ObserveOperation(operationID, statusCode, err, time.Since(start))
+	return resp, err
+}
+
+// observeAttempts returns a transport that sends each attempt of operation "operationID" with
+// "next", records its outcome in "attempts", and reports its retries and throttled responses to
+// "recorder". A nil "attempts" counts the attempts made through the transport only.
+func observeAttempts(recorder MetricsRecorder, operationID string, attempts *operationAttempts, next http.RoundTripper) http.RoundTripper {
+	if attempts == nil {
+		attempts = &operationAttempts{}
+	}
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if attempts.count > 0 {
+			recorder.ObserveRetry(operationID)
+		}
+		attempts.count++
+
+		resp, err := next.RoundTrip(req)
+		attempts.statusCode, attempts.err = 0, err
+		if resp != nil {
+			attempts.statusCode = resp.StatusCode
+			if resp.StatusCode == http.StatusTooManyRequests {
+				recorder.ObserveRateLimited(operationID)
+			}
+		}
+		return resp, err
+	})
+}
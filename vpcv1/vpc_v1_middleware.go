@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"maps"
+	"net/http"
+	"strings"
+)
+
+// Middleware : A function that wraps the transport of the requests sent by a VpcV1 instance
+// A middleware returns a transport that handles each request, typically by passing it on to
+// "next". The metadata of the operation a request belongs to is available with
+// OperationInfoFromRequest.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// OperationInfo : The metadata of the VPC API operation that a request belongs to
+type OperationInfo struct {
+	// The operation ID of the service method, for example "GetInstance".
+	ID string
+
+	// The HTTP method of the request.
+	Method string
+
+	// The path of the operation relative to the service URL, with a placeholder for each path
+	// parameter, for example "/instances/{instance_id}/network_interfaces/{id}".
+	PathTemplate string
+
+	// The values of the path parameters by placeholder name, for example
+	// {"instance_id": "0717_...", "id": "0717-..."}.
+	ResourceIDs map[string]string
+
+	// The value of the last path parameter, for example the ID of the instance for
+	// "/instances/{id}/actions", or "" if there is none.
+	ResourceID string
+}
+
+type operationInfoKey struct{}
+
+// OperationInfoFromRequest : Return the operation that a request sent by a VpcV1 instance belongs to
+// Returns nil for requests that are not sent by the transport of a VpcV1 instance.
+func OperationInfoFromRequest(req *http.Request) *OperationInfo {
+	operation, _ := req.Context().Value(operationInfoKey{}).(*OperationInfo)
+	return operation
+}
+
+// withOperationInfo returns a copy of "ctx" that carries "operation".
+func withOperationInfo(ctx context.Context, operation *OperationInfo) context.Context {
+	return context.WithValue(ctx, operationInfoKey{}, operation)
+}
+
+// withOperation returns a copy of "ctx" that carries the operation "operationID", for example
+// "GetInstance", which sends requests with it to the path "pathTemplate" with the path parameters
+// "pathParams", as passed to core.RequestBuilder.ResolveRequestURL. The generated service methods
+// call it on the context of their requests.
+func withOperation(ctx context.Context, operationID string, pathTemplate string, pathParams map[string]string) context.Context {
+	if ctx == nil {
+		return ctx
+	}
+	operation := &OperationInfo{
+		ID:           operationID,
+		PathTemplate: pathTemplate,
+		ResourceIDs:  maps.Clone(pathParams),
+	}
+	if start := strings.LastIndex(pathTemplate, "{"); start >= 0 {
+		if end := strings.Index(pathTemplate[start:], "}"); end > 0 {
+			operation.ResourceID = pathParams[pathTemplate[start+1:start+end]]
+		}
+	}
+	return withOperationInfo(ctx, operation)
+}
+
+// operationIDFromContext returns the ID of the operation carried by "ctx", or "".
+func operationIDFromContext(ctx context.Context) string {
+	if operation, ok := ctx.Value(operationInfoKey{}).(*OperationInfo); ok {
+		return operation.ID
+	}
+	return ""
+}
+
+// Use : Send the requests of the service through "middlewares"
+// The middlewares are applied in order, around those added by earlier calls: the first one sees
+// each request first, and its response last. They wrap each attempt made by the retry policy, after
+// the rate limiter and the tracer, so that they see the requests as sent.
+// The middlewares apply to this instance and to the clones made from it afterwards.
+func (vpc *VpcV1) Use(middlewares ...Middleware) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		// Copy, so that the transports installed earlier keep their own chain.
+		chain := make([]Middleware, 0, len(transport.middlewares)+len(middlewares))
+		chain = append(chain, transport.middlewares...)
+		transport.middlewares = append(chain, middlewares...)
+	})
+}
+
+// chain returns "next" wrapped in "middlewares", the first one outermost.
+func chain(middlewares []Middleware, next http.RoundTripper) http.RoundTripper {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		next = middlewares[i](next)
+	}
+	return next
+}
+
+// newOperationInfo returns the metadata of the operation that "req" belongs to, the one carried by
+// its context with the method of the request.
+func newOperationInfo(req *http.Request) *OperationInfo {
+	operation := &OperationInfo{}
+	if carried, ok := req.Context().Value(operationInfoKey{}).(*OperationInfo); ok {
+		*operation = *carried
+	}
+	operation.Method = req.Method
+	return operation
+}
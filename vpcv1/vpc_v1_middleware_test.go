@@ -0,0 +1,151 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// recordingMiddleware returns a middleware that appends "name" and the operation of each request
+// to "calls".
+func recordingMiddleware(name string, calls *[]string, operations *[]*vpcv1.OperationInfo) vpcv1.Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return roundTripper(func(req *http.Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+			*operations = append(*operations, vpcv1.OperationInfoFromRequest(req))
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+type roundTripper func(req *http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+var _ = Describe(`Middleware`, func() {
+	It(`Should apply middlewares in order with the operation metadata`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		var calls []string
+		var operations []*vpcv1.OperationInfo
+		vpcService := newTestVpcService(server.URL + "/v1")
+		vpcService.Use(recordingMiddleware("first", &calls, &operations))
+		clone := vpcService.Clone()
+		vpcService.Use(recordingMiddleware("second", &calls, &operations), func(next http.RoundTripper) http.RoundTripper {
+			return roundTripper(func(req *http.Request) (*http.Response, error) {
+				req = req.Clone(req.Context())
+				req.Header.Set("X-Tenant", "tenant-1")
+				return next.RoundTrip(req)
+			})
+		})
+
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first", "second"}))
+		Expect(received.Get("X-Tenant")).To(Equal("tenant-1"))
+		Expect(*operations[0]).To(Equal(vpcv1.OperationInfo{
+			ID:           "GetInstance",
+			Method:       http.MethodGet,
+			PathTemplate: "/instances/{id}",
+			ResourceIDs:  map[string]string{"id": "i-1"},
+			ResourceID:   "i-1",
+		}))
+
+		// Clones keep the middlewares they were made with.
+		calls = nil
+		_, _, err = clone.GetInstance(clone.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(calls).To(Equal([]string{"first"}))
+	})
+	It(`Should describe the path of the operation`, func() {
+		server, _ := newHeaderServer(200, `{}`)
+		defer server.Close()
+
+		var calls []string
+		var operations []*vpcv1.OperationInfo
+		vpcService := newTestVpcService(server.URL)
+		vpcService.Use(recordingMiddleware("first", &calls, &operations))
+
+		_, _, err := vpcService.GetVPCAddressPrefix(vpcService.NewGetVPCAddressPrefixOptions("r006-1", "r006-2"))
+		Expect(err).To(BeNil())
+		_, _, err = vpcService.GetInstanceTemplate(vpcService.NewGetInstanceTemplateOptions("0717-3"))
+		Expect(err).To(BeNil())
+		_, err = vpcService.UpdateFirmwareForBareMetalServer(vpcService.NewUpdateFirmwareForBareMetalServerOptions("0717-4"))
+		Expect(err).To(BeNil())
+		_, err = vpcService.CheckVPNGatewayConnectionsLocalCIDR(vpcService.NewCheckVPNGatewayConnectionsLocalCIDROptions("r006-5", "r006-6", "10.0.0.0/24"))
+		Expect(err).To(BeNil())
+		_, _, err = vpcService.ListInstanceProfiles(vpcService.NewListInstanceProfilesOptions())
+		Expect(err).To(BeNil())
+		res, err := vpcService.Service.GetHTTPClient().Get(server.URL + "/v1/network_acls")
+		Expect(err).To(BeNil())
+		_, _ = io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		Expect(operations[0].PathTemplate).To(Equal("/vpcs/{vpc_id}/address_prefixes/{id}"))
+		Expect(operations[0].ResourceIDs).To(Equal(map[string]string{"vpc_id": "r006-1", "id": "r006-2"}))
+		Expect(operations[0].ResourceID).To(Equal("r006-2"))
+		Expect(operations[1].PathTemplate).To(Equal("/instance/templates/{id}"))
+		Expect(operations[1].ResourceID).To(Equal("0717-3"))
+		Expect(operations[2].PathTemplate).To(Equal("/bare_metal_servers/{id}/firmware/update"))
+		Expect(operations[2].ResourceID).To(Equal("0717-4"))
+		Expect(operations[3].PathTemplate).To(Equal("/vpn_gateways/{vpn_gateway_id}/connections/{id}/local/cidrs/{cidr}"))
+		Expect(operations[3].ResourceIDs).To(HaveKeyWithValue("cidr", "10.0.0.0/24"))
+		Expect(operations[3].ResourceID).To(Equal("10.0.0.0/24"))
+		Expect(operations[4].PathTemplate).To(Equal("/instance/profiles"))
+		Expect(operations[4].ResourceIDs).To(BeEmpty())
+		Expect(operations[4].ResourceID).To(BeEmpty())
+
+		// Requests not sent by a method only have their HTTP method.
+		Expect(*operations[5]).To(Equal(vpcv1.OperationInfo{Method: http.MethodGet}))
+	})
+	It(`Should see each attempt`, func() {
+		server, count, _ := newFlakyServer(0, `{"id": "i-1"}`)
+		defer server.Close()
+
+		// Inject one failure.
+		failures := 1
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(fastRetries)
+		vpcService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripper(func(req *http.Request) (*http.Response, error) {
+				if failures > 0 {
+					failures--
+					return &http.Response{
+						StatusCode: 503,
+						Header:     http.Header{"Content-Type": []string{"application/json"}},
+						Body:       io.NopCloser(strings.NewReader(`{"errors": [{"code": "service_unavailable"}]}`)),
+						Request:    req,
+					}, nil
+				}
+				return next.RoundTrip(req)
+			})
+		})
+
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(*count).To(Equal(int32(1)))
+	})
+})
@@ -0,0 +1,231 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// Each model and union variant has a DeepCopy method calling deepCopy and an Equal method calling
+// modelsEqual, generated by apigen (see vpc_v1_generate.go).
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// ServerManagedProperties are the properties of the models that are set by the server, ignored by
+// the comparisons with the IgnoreServerManaged option.
+var ServerManagedProperties = []string{"created_at", "crn", "href", "lifecycle_reasons", "lifecycle_state"}
+
+// EqualOption : An option of the Equal methods of the models and of ModelsEqual
+type EqualOption func(options *equalOptions)
+
+// equalOptions are the options of a comparison.
+type equalOptions struct {
+	// The JSON names of the ignored properties.
+	ignored map[string]bool
+}
+
+// IgnoreProperties : Ignore the properties with the JSON names "names", such as "created_at", at
+// any depth of the compared models
+func IgnoreProperties(names ...string) EqualOption {
+	return func(options *equalOptions) {
+		for _, name := range names {
+			options.ignored[name] = true
+		}
+	}
+}
+
+// IgnoreServerManaged : Ignore the properties set by the server (see ServerManagedProperties), to
+// compare a desired model with the actual one
+func IgnoreServerManaged() EqualOption {
+	return IgnoreProperties(ServerManagedProperties...)
+}
+
+// DeepCopyModel : Return a deep copy of "model", a model, a union value (such as a
+// SecurityGroupRuleIntf) or a slice or map of them
+// Nothing is shared between the model and its copy, which can be modified independently.
+func DeepCopyModel[T any](model T) T {
+	var copied T
+	reflect.ValueOf(&copied).Elem().Set(deepCopyValue(reflect.ValueOf(&model).Elem()))
+	return copied
+}
+
+// ModelsEqual : Return true if "a" and "b", models, union values or slices or maps of them, are
+// semantically equal
+// The values of the pointers are compared rather than the pointers, the variants of the union
+// values are compared, dates are compared as instants, nil and empty slices and maps are equal, and
+// the properties unknown to the SDK (see VpcV1.SetRetainUnknownProperties) are compared as JSON values.
+func ModelsEqual(a interface{}, b interface{}, options ...EqualOption) bool {
+	return modelsEqual(a, b, options)
+}
+
+// deepCopy returns a deep copy of "model", which may be nil.
+func deepCopy[T any](model *T) *T {
+	return DeepCopyModel(model)
+}
+
+// modelsEqual returns true if "a" and "b" are semantically equal with "options" (see ModelsEqual).
+func modelsEqual(a interface{}, b interface{}, options []EqualOption) bool {
+	settings := &equalOptions{ignored: make(map[string]bool)}
+	for _, option := range options {
+		option(settings)
+	}
+	return valuesEqual(reflect.ValueOf(a), reflect.ValueOf(b), settings)
+}
+
+// deepCopyValue returns a deep copy of "value".
+func deepCopyValue(value reflect.Value) reflect.Value {
+	result := reflect.New(value.Type()).Elem()
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			pointer := reflect.New(value.Type().Elem())
+			pointer.Elem().Set(deepCopyValue(value.Elem()))
+			result.Set(pointer)
+		}
+	case reflect.Interface:
+		if !value.IsNil() {
+			result.Set(deepCopyValue(value.Elem()))
+		}
+	case reflect.Struct:
+		// The unexported fields, such as those of time.Time, are copied by value.
+		result.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				result.Field(i).Set(deepCopyValue(value.Field(i)))
+			}
+		}
+	case reflect.Slice:
+		if !value.IsNil() {
+			result.Set(reflect.MakeSlice(value.Type(), value.Len(), value.Len()))
+			for i := 0; i < value.Len(); i++ {
+				result.Index(i).Set(deepCopyValue(value.Index(i)))
+			}
+		}
+	case reflect.Map:
+		if !value.IsNil() {
+			result.Set(reflect.MakeMapWithSize(value.Type(), value.Len()))
+			for iter := value.MapRange(); iter.Next(); {
+				result.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+			}
+		}
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(deepCopyValue(value.Index(i)))
+		}
+	default:
+		result.Set(value)
+	}
+	return result
+}
+
+// rawMessagesType is the type of the Extra field of the models.
+var rawMessagesType = reflect.TypeOf(map[string]json.RawMessage(nil))
+
+// valuesEqual returns true if "a" and "b" are semantically equal with "options".
+func valuesEqual(a reflect.Value, b reflect.Value, options *equalOptions) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return valuesEqual(a.Elem(), b.Elem(), options)
+	case reflect.Struct:
+		// Types with an Equal method, such as strfmt.DateTime, compare themselves.
+		if equal, found := a.Type().MethodByName("Equal"); found && equal.Type.NumIn() == 2 && equal.Type.In(1) == a.Type() &&
+			equal.Type.NumOut() == 1 && equal.Type.Out(0).Kind() == reflect.Bool {
+			return a.Method(equal.Index).Call([]reflect.Value{b})[0].Bool()
+		}
+		for i := 0; i < a.NumField(); i++ {
+			if !a.Type().Field(i).IsExported() {
+				return reflect.DeepEqual(a.Interface(), b.Interface())
+			}
+		}
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" && field.Type == rawMessagesType {
+				if !unknownPropertiesEqual(a.Field(i).Interface().(map[string]json.RawMessage), b.Field(i).Interface().(map[string]json.RawMessage), options) {
+					return false
+				}
+				continue
+			}
+			if options.ignored[name] {
+				continue
+			}
+			if !valuesEqual(a.Field(i), b.Field(i), options) {
+				return false
+			}
+		}
+		return true
+	case reflect.Slice, reflect.Array:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !valuesEqual(a.Index(i), b.Index(i), options) {
+				return false
+			}
+		}
+		return true
+	case reflect.Map:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for iter := a.MapRange(); iter.Next(); {
+			other := b.MapIndex(iter.Key())
+			if !other.IsValid() || !valuesEqual(iter.Value(), other, options) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// unknownPropertiesEqual returns true if the properties unknown to the SDK "a" and "b" have equal
+// JSON values, except those ignored by "options".
+func unknownPropertiesEqual(a map[string]json.RawMessage, b map[string]json.RawMessage, options *equalOptions) bool {
+	for _, properties := range [][2]map[string]json.RawMessage{{a, b}, {b, a}} {
+		for name, value := range properties[0] {
+			other, found := properties[1][name]
+			if options.ignored[name] {
+				continue
+			}
+			if !found || !jsonEqual(value, other) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// jsonEqual returns true if "a" and "b" encode the same JSON value.
+func jsonEqual(a json.RawMessage, b json.RawMessage) bool {
+	var aValue, bValue interface{}
+	if json.Unmarshal(a, &aValue) != nil || json.Unmarshal(b, &bValue) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(aValue, bValue)
+}
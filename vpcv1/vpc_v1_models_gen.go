@@ -0,0 +1,15109 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Code generated by apigen. DO NOT EDIT.
+
+package vpcv1
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AccountIdentity) DeepCopy() *AccountIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AccountIdentity) Equal(other *AccountIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AccountIdentityByID) DeepCopy() *AccountIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AccountIdentityByID) Equal(other *AccountIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AccountReference) DeepCopy() *AccountReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AccountReference) Equal(other *AccountReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AddressPrefix) DeepCopy() *AddressPrefix {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AddressPrefix) Equal(other *AddressPrefix, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AddressPrefixCollection) DeepCopy() *AddressPrefixCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AddressPrefixCollection) Equal(other *AddressPrefixCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *AddressPrefixPatch) DeepCopy() *AddressPrefixPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *AddressPrefixPatch) Equal(other *AddressPrefixPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicy) DeepCopy() *BackupPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicy) Equal(other *BackupPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyCollection) DeepCopy() *BackupPolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyCollection) Equal(other *BackupPolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyHealthReason) DeepCopy() *BackupPolicyHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyHealthReason) Equal(other *BackupPolicyHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJob) DeepCopy() *BackupPolicyJob {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJob) Equal(other *BackupPolicyJob, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobCollection) DeepCopy() *BackupPolicyJobCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobCollection) Equal(other *BackupPolicyJobCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobReference) DeepCopy() *BackupPolicyJobReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobReference) Equal(other *BackupPolicyJobReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobSource) DeepCopy() *BackupPolicyJobSource {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobSource) Equal(other *BackupPolicyJobSource, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobSourceInstanceReference) DeepCopy() *BackupPolicyJobSourceInstanceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobSourceInstanceReference) Equal(other *BackupPolicyJobSourceInstanceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobSourceShareReference) DeepCopy() *BackupPolicyJobSourceShareReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobSourceShareReference) Equal(other *BackupPolicyJobSourceShareReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobSourceVolumeReference) DeepCopy() *BackupPolicyJobSourceVolumeReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobSourceVolumeReference) Equal(other *BackupPolicyJobSourceVolumeReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyJobStatusReason) DeepCopy() *BackupPolicyJobStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyJobStatusReason) Equal(other *BackupPolicyJobStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyMatchResourceTypeInstance) DeepCopy() *BackupPolicyMatchResourceTypeInstance {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyMatchResourceTypeInstance) Equal(other *BackupPolicyMatchResourceTypeInstance, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyMatchResourceTypeShare) DeepCopy() *BackupPolicyMatchResourceTypeShare {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyMatchResourceTypeShare) Equal(other *BackupPolicyMatchResourceTypeShare, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyMatchResourceTypeVolume) DeepCopy() *BackupPolicyMatchResourceTypeVolume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyMatchResourceTypeVolume) Equal(other *BackupPolicyMatchResourceTypeVolume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPatch) DeepCopy() *BackupPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPatch) Equal(other *BackupPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlan) DeepCopy() *BackupPolicyPlan {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlan) Equal(other *BackupPolicyPlan, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanClonePolicy) DeepCopy() *BackupPolicyPlanClonePolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanClonePolicy) Equal(other *BackupPolicyPlanClonePolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanClonePolicyPatch) DeepCopy() *BackupPolicyPlanClonePolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanClonePolicyPatch) Equal(other *BackupPolicyPlanClonePolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanClonePolicyPrototype) DeepCopy() *BackupPolicyPlanClonePolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanClonePolicyPrototype) Equal(other *BackupPolicyPlanClonePolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanCollection) DeepCopy() *BackupPolicyPlanCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanCollection) Equal(other *BackupPolicyPlanCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanDeletionTrigger) DeepCopy() *BackupPolicyPlanDeletionTrigger {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanDeletionTrigger) Equal(other *BackupPolicyPlanDeletionTrigger, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanDeletionTriggerPatch) DeepCopy() *BackupPolicyPlanDeletionTriggerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanDeletionTriggerPatch) Equal(other *BackupPolicyPlanDeletionTriggerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanDeletionTriggerPrototype) DeepCopy() *BackupPolicyPlanDeletionTriggerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanDeletionTriggerPrototype) Equal(other *BackupPolicyPlanDeletionTriggerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanPatch) DeepCopy() *BackupPolicyPlanPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanPatch) Equal(other *BackupPolicyPlanPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanPrototype) DeepCopy() *BackupPolicyPlanPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanPrototype) Equal(other *BackupPolicyPlanPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanReference) DeepCopy() *BackupPolicyPlanReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanReference) Equal(other *BackupPolicyPlanReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanRemote) DeepCopy() *BackupPolicyPlanRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanRemote) Equal(other *BackupPolicyPlanRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanRemoteRegionPolicy) DeepCopy() *BackupPolicyPlanRemoteRegionPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanRemoteRegionPolicy) Equal(other *BackupPolicyPlanRemoteRegionPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPlanRemoteRegionPolicyPrototype) DeepCopy() *BackupPolicyPlanRemoteRegionPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPlanRemoteRegionPolicyPrototype) Equal(other *BackupPolicyPlanRemoteRegionPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPrototype) DeepCopy() *BackupPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPrototype) Equal(other *BackupPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeInstancePrototype) DeepCopy() *BackupPolicyPrototypeBackupPolicyMatchResourceTypeInstancePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeInstancePrototype) Equal(other *BackupPolicyPrototypeBackupPolicyMatchResourceTypeInstancePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeSharePrototype) DeepCopy() *BackupPolicyPrototypeBackupPolicyMatchResourceTypeSharePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeSharePrototype) Equal(other *BackupPolicyPrototypeBackupPolicyMatchResourceTypeSharePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeVolumePrototype) DeepCopy() *BackupPolicyPrototypeBackupPolicyMatchResourceTypeVolumePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyPrototypeBackupPolicyMatchResourceTypeVolumePrototype) Equal(other *BackupPolicyPrototypeBackupPolicyMatchResourceTypeVolumePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScope) DeepCopy() *BackupPolicyScope {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScope) Equal(other *BackupPolicyScope, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScopeAccountReference) DeepCopy() *BackupPolicyScopeAccountReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScopeAccountReference) Equal(other *BackupPolicyScopeAccountReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScopeEnterpriseReference) DeepCopy() *BackupPolicyScopeEnterpriseReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScopeEnterpriseReference) Equal(other *BackupPolicyScopeEnterpriseReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScopePrototype) DeepCopy() *BackupPolicyScopePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScopePrototype) Equal(other *BackupPolicyScopePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScopePrototypeEnterpriseIdentity) DeepCopy() *BackupPolicyScopePrototypeEnterpriseIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScopePrototypeEnterpriseIdentity) Equal(other *BackupPolicyScopePrototypeEnterpriseIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyScopePrototypeEnterpriseIdentityEnterpriseIdentityByCRN) DeepCopy() *BackupPolicyScopePrototypeEnterpriseIdentityEnterpriseIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyScopePrototypeEnterpriseIdentityEnterpriseIdentityByCRN) Equal(other *BackupPolicyScopePrototypeEnterpriseIdentityEnterpriseIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyTargetSnapshot) DeepCopy() *BackupPolicyTargetSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyTargetSnapshot) Equal(other *BackupPolicyTargetSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyTargetSnapshotShareSnapshotReference) DeepCopy() *BackupPolicyTargetSnapshotShareSnapshotReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyTargetSnapshotShareSnapshotReference) Equal(other *BackupPolicyTargetSnapshotShareSnapshotReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BackupPolicyTargetSnapshotSnapshotReference) DeepCopy() *BackupPolicyTargetSnapshotSnapshotReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BackupPolicyTargetSnapshotSnapshotReference) Equal(other *BackupPolicyTargetSnapshotSnapshotReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServer) DeepCopy() *BareMetalServer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServer) Equal(other *BareMetalServer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerBootTarget) DeepCopy() *BareMetalServerBootTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerBootTarget) Equal(other *BareMetalServerBootTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerBootTargetBareMetalServerDiskReference) DeepCopy() *BareMetalServerBootTargetBareMetalServerDiskReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerBootTargetBareMetalServerDiskReference) Equal(other *BareMetalServerBootTargetBareMetalServerDiskReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerCollection) DeepCopy() *BareMetalServerCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerCollection) Equal(other *BareMetalServerCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerConsoleAccessToken) DeepCopy() *BareMetalServerConsoleAccessToken {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerConsoleAccessToken) Equal(other *BareMetalServerConsoleAccessToken, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerCpu) DeepCopy() *BareMetalServerCpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerCpu) Equal(other *BareMetalServerCpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerDisk) DeepCopy() *BareMetalServerDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerDisk) Equal(other *BareMetalServerDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerDiskAllowedUse) DeepCopy() *BareMetalServerDiskAllowedUse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerDiskAllowedUse) Equal(other *BareMetalServerDiskAllowedUse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerDiskCollection) DeepCopy() *BareMetalServerDiskCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerDiskCollection) Equal(other *BareMetalServerDiskCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerDiskPatch) DeepCopy() *BareMetalServerDiskPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerDiskPatch) Equal(other *BareMetalServerDiskPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerFirmware) DeepCopy() *BareMetalServerFirmware {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerFirmware) Equal(other *BareMetalServerFirmware, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerHealthReason) DeepCopy() *BareMetalServerHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerHealthReason) Equal(other *BareMetalServerHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitialization) DeepCopy() *BareMetalServerInitialization {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitialization) Equal(other *BareMetalServerInitialization, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitializationDefaultTrustedProfile) DeepCopy() *BareMetalServerInitializationDefaultTrustedProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitializationDefaultTrustedProfile) Equal(other *BareMetalServerInitializationDefaultTrustedProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitializationDefaultTrustedProfilePrototype) DeepCopy() *BareMetalServerInitializationDefaultTrustedProfilePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitializationDefaultTrustedProfilePrototype) Equal(other *BareMetalServerInitializationDefaultTrustedProfilePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitializationPrototype) DeepCopy() *BareMetalServerInitializationPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitializationPrototype) Equal(other *BareMetalServerInitializationPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitializationUserAccount) DeepCopy() *BareMetalServerInitializationUserAccount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitializationUserAccount) Equal(other *BareMetalServerInitializationUserAccount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerInitializationUserAccountBareMetalServerInitializationHostUserAccount) DeepCopy() *BareMetalServerInitializationUserAccountBareMetalServerInitializationHostUserAccount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerInitializationUserAccountBareMetalServerInitializationHostUserAccount) Equal(other *BareMetalServerInitializationUserAccountBareMetalServerInitializationHostUserAccount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerLifecycleReason) DeepCopy() *BareMetalServerLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerLifecycleReason) Equal(other *BareMetalServerLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerMetadataService) DeepCopy() *BareMetalServerMetadataService {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerMetadataService) Equal(other *BareMetalServerMetadataService, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerMetadataServicePatch) DeepCopy() *BareMetalServerMetadataServicePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerMetadataServicePatch) Equal(other *BareMetalServerMetadataServicePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerMetadataServicePrototype) DeepCopy() *BareMetalServerMetadataServicePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerMetadataServicePrototype) Equal(other *BareMetalServerMetadataServicePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachment) DeepCopy() *BareMetalServerNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachment) Equal(other *BareMetalServerNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentByPci) DeepCopy() *BareMetalServerNetworkAttachmentByPci {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentByPci) Equal(other *BareMetalServerNetworkAttachmentByPci, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentByVlan) DeepCopy() *BareMetalServerNetworkAttachmentByVlan {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentByVlan) Equal(other *BareMetalServerNetworkAttachmentByVlan, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentCollection) DeepCopy() *BareMetalServerNetworkAttachmentCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentCollection) Equal(other *BareMetalServerNetworkAttachmentCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPatch) DeepCopy() *BareMetalServerNetworkAttachmentPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPatch) Equal(other *BareMetalServerNetworkAttachmentPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototype) DeepCopy() *BareMetalServerNetworkAttachmentPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototype) Equal(other *BareMetalServerNetworkAttachmentPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByPciPrototype) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByPciPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByPciPrototype) Equal(other *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByPciPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByVlanPrototype) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByVlanPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByVlanPrototype) Equal(other *BareMetalServerNetworkAttachmentPrototypeBareMetalServerNetworkAttachmentByVlanPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeBareMetalServerNetworkAttachmentContext) DeepCopy() *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeBareMetalServerNetworkAttachmentContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeBareMetalServerNetworkAttachmentContext) Equal(other *BareMetalServerNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeBareMetalServerNetworkAttachmentContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkAttachmentReference) DeepCopy() *BareMetalServerNetworkAttachmentReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkAttachmentReference) Equal(other *BareMetalServerNetworkAttachmentReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterface) DeepCopy() *BareMetalServerNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterface) Equal(other *BareMetalServerNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfaceByHiperSocket) DeepCopy() *BareMetalServerNetworkInterfaceByHiperSocket {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfaceByHiperSocket) Equal(other *BareMetalServerNetworkInterfaceByHiperSocket, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfaceByPci) DeepCopy() *BareMetalServerNetworkInterfaceByPci {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfaceByPci) Equal(other *BareMetalServerNetworkInterfaceByPci, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfaceByVlan) DeepCopy() *BareMetalServerNetworkInterfaceByVlan {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfaceByVlan) Equal(other *BareMetalServerNetworkInterfaceByVlan, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfaceCollection) DeepCopy() *BareMetalServerNetworkInterfaceCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfaceCollection) Equal(other *BareMetalServerNetworkInterfaceCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfacePatch) DeepCopy() *BareMetalServerNetworkInterfacePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfacePatch) Equal(other *BareMetalServerNetworkInterfacePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfacePrototype) DeepCopy() *BareMetalServerNetworkInterfacePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfacePrototype) Equal(other *BareMetalServerNetworkInterfacePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByHiperSocketPrototype) DeepCopy() *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByHiperSocketPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByHiperSocketPrototype) Equal(other *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByHiperSocketPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByPciPrototype) DeepCopy() *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByPciPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByPciPrototype) Equal(other *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByPciPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByVlanPrototype) DeepCopy() *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByVlanPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByVlanPrototype) Equal(other *BareMetalServerNetworkInterfacePrototypeBareMetalServerNetworkInterfaceByVlanPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPatch) DeepCopy() *BareMetalServerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPatch) Equal(other *BareMetalServerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrimaryNetworkAttachmentPrototype) DeepCopy() *BareMetalServerPrimaryNetworkAttachmentPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrimaryNetworkAttachmentPrototype) Equal(other *BareMetalServerPrimaryNetworkAttachmentPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrimaryNetworkAttachmentPrototypeBareMetalServerPrimaryNetworkAttachmentByPciPrototype) DeepCopy() *BareMetalServerPrimaryNetworkAttachmentPrototypeBareMetalServerPrimaryNetworkAttachmentByPciPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrimaryNetworkAttachmentPrototypeBareMetalServerPrimaryNetworkAttachmentByPciPrototype) Equal(other *BareMetalServerPrimaryNetworkAttachmentPrototypeBareMetalServerPrimaryNetworkAttachmentByPciPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrimaryNetworkInterfacePrototype) DeepCopy() *BareMetalServerPrimaryNetworkInterfacePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrimaryNetworkInterfacePrototype) Equal(other *BareMetalServerPrimaryNetworkInterfacePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfile) DeepCopy() *BareMetalServerProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfile) Equal(other *BareMetalServerProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileBandwidth) DeepCopy() *BareMetalServerProfileBandwidth {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileBandwidth) Equal(other *BareMetalServerProfileBandwidth, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileBandwidthDependent) DeepCopy() *BareMetalServerProfileBandwidthDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileBandwidthDependent) Equal(other *BareMetalServerProfileBandwidthDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileBandwidthEnum) DeepCopy() *BareMetalServerProfileBandwidthEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileBandwidthEnum) Equal(other *BareMetalServerProfileBandwidthEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileBandwidthFixed) DeepCopy() *BareMetalServerProfileBandwidthFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileBandwidthFixed) Equal(other *BareMetalServerProfileBandwidthFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileBandwidthRange) DeepCopy() *BareMetalServerProfileBandwidthRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileBandwidthRange) Equal(other *BareMetalServerProfileBandwidthRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCollection) DeepCopy() *BareMetalServerProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCollection) Equal(other *BareMetalServerProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileConsoleTypes) DeepCopy() *BareMetalServerProfileConsoleTypes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileConsoleTypes) Equal(other *BareMetalServerProfileConsoleTypes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuArchitecture) DeepCopy() *BareMetalServerProfileCpuArchitecture {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuArchitecture) Equal(other *BareMetalServerProfileCpuArchitecture, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuCoreCount) DeepCopy() *BareMetalServerProfileCpuCoreCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuCoreCount) Equal(other *BareMetalServerProfileCpuCoreCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuCoreCountDependent) DeepCopy() *BareMetalServerProfileCpuCoreCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuCoreCountDependent) Equal(other *BareMetalServerProfileCpuCoreCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuCoreCountEnum) DeepCopy() *BareMetalServerProfileCpuCoreCountEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuCoreCountEnum) Equal(other *BareMetalServerProfileCpuCoreCountEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuCoreCountFixed) DeepCopy() *BareMetalServerProfileCpuCoreCountFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuCoreCountFixed) Equal(other *BareMetalServerProfileCpuCoreCountFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuCoreCountRange) DeepCopy() *BareMetalServerProfileCpuCoreCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuCoreCountRange) Equal(other *BareMetalServerProfileCpuCoreCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuSocketCount) DeepCopy() *BareMetalServerProfileCpuSocketCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuSocketCount) Equal(other *BareMetalServerProfileCpuSocketCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuSocketCountDependent) DeepCopy() *BareMetalServerProfileCpuSocketCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuSocketCountDependent) Equal(other *BareMetalServerProfileCpuSocketCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuSocketCountEnum) DeepCopy() *BareMetalServerProfileCpuSocketCountEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuSocketCountEnum) Equal(other *BareMetalServerProfileCpuSocketCountEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuSocketCountFixed) DeepCopy() *BareMetalServerProfileCpuSocketCountFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuSocketCountFixed) Equal(other *BareMetalServerProfileCpuSocketCountFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileCpuSocketCountRange) DeepCopy() *BareMetalServerProfileCpuSocketCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileCpuSocketCountRange) Equal(other *BareMetalServerProfileCpuSocketCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDisk) DeepCopy() *BareMetalServerProfileDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDisk) Equal(other *BareMetalServerProfileDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskQuantity) DeepCopy() *BareMetalServerProfileDiskQuantity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskQuantity) Equal(other *BareMetalServerProfileDiskQuantity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskQuantityDependent) DeepCopy() *BareMetalServerProfileDiskQuantityDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskQuantityDependent) Equal(other *BareMetalServerProfileDiskQuantityDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskQuantityEnum) DeepCopy() *BareMetalServerProfileDiskQuantityEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskQuantityEnum) Equal(other *BareMetalServerProfileDiskQuantityEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskQuantityFixed) DeepCopy() *BareMetalServerProfileDiskQuantityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskQuantityFixed) Equal(other *BareMetalServerProfileDiskQuantityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskQuantityRange) DeepCopy() *BareMetalServerProfileDiskQuantityRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskQuantityRange) Equal(other *BareMetalServerProfileDiskQuantityRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSize) DeepCopy() *BareMetalServerProfileDiskSize {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSize) Equal(other *BareMetalServerProfileDiskSize, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSizeDependent) DeepCopy() *BareMetalServerProfileDiskSizeDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSizeDependent) Equal(other *BareMetalServerProfileDiskSizeDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSizeEnum) DeepCopy() *BareMetalServerProfileDiskSizeEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSizeEnum) Equal(other *BareMetalServerProfileDiskSizeEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSizeFixed) DeepCopy() *BareMetalServerProfileDiskSizeFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSizeFixed) Equal(other *BareMetalServerProfileDiskSizeFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSizeRange) DeepCopy() *BareMetalServerProfileDiskSizeRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSizeRange) Equal(other *BareMetalServerProfileDiskSizeRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileDiskSupportedInterfaces) DeepCopy() *BareMetalServerProfileDiskSupportedInterfaces {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileDiskSupportedInterfaces) Equal(other *BareMetalServerProfileDiskSupportedInterfaces, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileIdentity) DeepCopy() *BareMetalServerProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileIdentity) Equal(other *BareMetalServerProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileIdentityByHref) DeepCopy() *BareMetalServerProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileIdentityByHref) Equal(other *BareMetalServerProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileIdentityByName) DeepCopy() *BareMetalServerProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileIdentityByName) Equal(other *BareMetalServerProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileMemory) DeepCopy() *BareMetalServerProfileMemory {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileMemory) Equal(other *BareMetalServerProfileMemory, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileMemoryDependent) DeepCopy() *BareMetalServerProfileMemoryDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileMemoryDependent) Equal(other *BareMetalServerProfileMemoryDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileMemoryEnum) DeepCopy() *BareMetalServerProfileMemoryEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileMemoryEnum) Equal(other *BareMetalServerProfileMemoryEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileMemoryFixed) DeepCopy() *BareMetalServerProfileMemoryFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileMemoryFixed) Equal(other *BareMetalServerProfileMemoryFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileMemoryRange) DeepCopy() *BareMetalServerProfileMemoryRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileMemoryRange) Equal(other *BareMetalServerProfileMemoryRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkAttachmentCount) DeepCopy() *BareMetalServerProfileNetworkAttachmentCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkAttachmentCount) Equal(other *BareMetalServerProfileNetworkAttachmentCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkAttachmentCountDependent) DeepCopy() *BareMetalServerProfileNetworkAttachmentCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkAttachmentCountDependent) Equal(other *BareMetalServerProfileNetworkAttachmentCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkAttachmentCountRange) DeepCopy() *BareMetalServerProfileNetworkAttachmentCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkAttachmentCountRange) Equal(other *BareMetalServerProfileNetworkAttachmentCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkInterfaceCount) DeepCopy() *BareMetalServerProfileNetworkInterfaceCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkInterfaceCount) Equal(other *BareMetalServerProfileNetworkInterfaceCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkInterfaceCountDependent) DeepCopy() *BareMetalServerProfileNetworkInterfaceCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkInterfaceCountDependent) Equal(other *BareMetalServerProfileNetworkInterfaceCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileNetworkInterfaceCountRange) DeepCopy() *BareMetalServerProfileNetworkInterfaceCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileNetworkInterfaceCountRange) Equal(other *BareMetalServerProfileNetworkInterfaceCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileOsArchitecture) DeepCopy() *BareMetalServerProfileOsArchitecture {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileOsArchitecture) Equal(other *BareMetalServerProfileOsArchitecture, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileReference) DeepCopy() *BareMetalServerProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileReference) Equal(other *BareMetalServerProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileReservationTerms) DeepCopy() *BareMetalServerProfileReservationTerms {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileReservationTerms) Equal(other *BareMetalServerProfileReservationTerms, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileSupportedTrustedPlatformModuleModes) DeepCopy() *BareMetalServerProfileSupportedTrustedPlatformModuleModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileSupportedTrustedPlatformModuleModes) Equal(other *BareMetalServerProfileSupportedTrustedPlatformModuleModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerProfileVirtualNetworkInterfacesSupported) DeepCopy() *BareMetalServerProfileVirtualNetworkInterfacesSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerProfileVirtualNetworkInterfacesSupported) Equal(other *BareMetalServerProfileVirtualNetworkInterfacesSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrototype) DeepCopy() *BareMetalServerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrototype) Equal(other *BareMetalServerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrototypeBareMetalServerByNetworkAttachment) DeepCopy() *BareMetalServerPrototypeBareMetalServerByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrototypeBareMetalServerByNetworkAttachment) Equal(other *BareMetalServerPrototypeBareMetalServerByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerPrototypeBareMetalServerByNetworkInterface) DeepCopy() *BareMetalServerPrototypeBareMetalServerByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerPrototypeBareMetalServerByNetworkInterface) Equal(other *BareMetalServerPrototypeBareMetalServerByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerReservationAffinity) DeepCopy() *BareMetalServerReservationAffinity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerReservationAffinity) Equal(other *BareMetalServerReservationAffinity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerReservationAffinityPatch) DeepCopy() *BareMetalServerReservationAffinityPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerReservationAffinityPatch) Equal(other *BareMetalServerReservationAffinityPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerReservationAffinityPrototype) DeepCopy() *BareMetalServerReservationAffinityPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerReservationAffinityPrototype) Equal(other *BareMetalServerReservationAffinityPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerStatusReason) DeepCopy() *BareMetalServerStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerStatusReason) Equal(other *BareMetalServerStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerTrustedPlatformModule) DeepCopy() *BareMetalServerTrustedPlatformModule {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerTrustedPlatformModule) Equal(other *BareMetalServerTrustedPlatformModule, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerTrustedPlatformModulePatch) DeepCopy() *BareMetalServerTrustedPlatformModulePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerTrustedPlatformModulePatch) Equal(other *BareMetalServerTrustedPlatformModulePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *BareMetalServerTrustedPlatformModulePrototype) DeepCopy() *BareMetalServerTrustedPlatformModulePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *BareMetalServerTrustedPlatformModulePrototype) Equal(other *BareMetalServerTrustedPlatformModulePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingIdentity) DeepCopy() *CatalogOfferingIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingIdentity) Equal(other *CatalogOfferingIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingIdentityCatalogOfferingByCRN) DeepCopy() *CatalogOfferingIdentityCatalogOfferingByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingIdentityCatalogOfferingByCRN) Equal(other *CatalogOfferingIdentityCatalogOfferingByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionIdentity) DeepCopy() *CatalogOfferingVersionIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionIdentity) Equal(other *CatalogOfferingVersionIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN) DeepCopy() *CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN) Equal(other *CatalogOfferingVersionIdentityCatalogOfferingVersionByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionPlanIdentity) DeepCopy() *CatalogOfferingVersionPlanIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionPlanIdentity) Equal(other *CatalogOfferingVersionPlanIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionPlanIdentityCatalogOfferingVersionPlanByCRN) DeepCopy() *CatalogOfferingVersionPlanIdentityCatalogOfferingVersionPlanByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionPlanIdentityCatalogOfferingVersionPlanByCRN) Equal(other *CatalogOfferingVersionPlanIdentityCatalogOfferingVersionPlanByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionPlanReference) DeepCopy() *CatalogOfferingVersionPlanReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionPlanReference) Equal(other *CatalogOfferingVersionPlanReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CatalogOfferingVersionReference) DeepCopy() *CatalogOfferingVersionReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CatalogOfferingVersionReference) Equal(other *CatalogOfferingVersionReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CertificateInstanceIdentity) DeepCopy() *CertificateInstanceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CertificateInstanceIdentity) Equal(other *CertificateInstanceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CertificateInstanceIdentityByCRN) DeepCopy() *CertificateInstanceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CertificateInstanceIdentityByCRN) Equal(other *CertificateInstanceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CertificateInstanceReference) DeepCopy() *CertificateInstanceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CertificateInstanceReference) Equal(other *CertificateInstanceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CloudObjectStorageBucketIdentity) DeepCopy() *CloudObjectStorageBucketIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CloudObjectStorageBucketIdentity) Equal(other *CloudObjectStorageBucketIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CloudObjectStorageBucketIdentityByCRN) DeepCopy() *CloudObjectStorageBucketIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CloudObjectStorageBucketIdentityByCRN) Equal(other *CloudObjectStorageBucketIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName) DeepCopy() *CloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName) Equal(other *CloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CloudObjectStorageBucketReference) DeepCopy() *CloudObjectStorageBucketReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CloudObjectStorageBucketReference) Equal(other *CloudObjectStorageBucketReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *CloudObjectStorageObjectReference) DeepCopy() *CloudObjectStorageObjectReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *CloudObjectStorageObjectReference) Equal(other *CloudObjectStorageObjectReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetwork) DeepCopy() *ClusterNetwork {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetwork) Equal(other *ClusterNetwork, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkCollection) DeepCopy() *ClusterNetworkCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkCollection) Equal(other *ClusterNetworkCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterface) DeepCopy() *ClusterNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterface) Equal(other *ClusterNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfaceCollection) DeepCopy() *ClusterNetworkInterfaceCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfaceCollection) Equal(other *ClusterNetworkInterfaceCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfaceLifecycleReason) DeepCopy() *ClusterNetworkInterfaceLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfaceLifecycleReason) Equal(other *ClusterNetworkInterfaceLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePatch) DeepCopy() *ClusterNetworkInterfacePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePatch) Equal(other *ClusterNetworkInterfacePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePrimaryIPPrototype) DeepCopy() *ClusterNetworkInterfacePrimaryIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePrimaryIPPrototype) Equal(other *ClusterNetworkInterfacePrimaryIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContext) DeepCopy() *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContext) Equal(other *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByHref) DeepCopy() *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByHref) Equal(other *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByID) DeepCopy() *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByID) Equal(other *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPIdentityClusterNetworkInterfacePrimaryIPContextByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPPrototypeClusterNetworkInterfacePrimaryIPContext) DeepCopy() *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPPrototypeClusterNetworkInterfacePrimaryIPContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPPrototypeClusterNetworkInterfacePrimaryIPContext) Equal(other *ClusterNetworkInterfacePrimaryIPPrototypeClusterNetworkSubnetReservedIPPrototypeClusterNetworkInterfacePrimaryIPContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfaceReference) DeepCopy() *ClusterNetworkInterfaceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfaceReference) Equal(other *ClusterNetworkInterfaceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfaceTarget) DeepCopy() *ClusterNetworkInterfaceTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfaceTarget) Equal(other *ClusterNetworkInterfaceTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkInterfaceTargetInstanceClusterNetworkAttachmentReferenceClusterNetworkInterfaceContext) DeepCopy() *ClusterNetworkInterfaceTargetInstanceClusterNetworkAttachmentReferenceClusterNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkInterfaceTargetInstanceClusterNetworkAttachmentReferenceClusterNetworkInterfaceContext) Equal(other *ClusterNetworkInterfaceTargetInstanceClusterNetworkAttachmentReferenceClusterNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkLifecycleReason) DeepCopy() *ClusterNetworkLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkLifecycleReason) Equal(other *ClusterNetworkLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkPatch) DeepCopy() *ClusterNetworkPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkPatch) Equal(other *ClusterNetworkPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfile) DeepCopy() *ClusterNetworkProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfile) Equal(other *ClusterNetworkProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfileCollection) DeepCopy() *ClusterNetworkProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfileCollection) Equal(other *ClusterNetworkProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfileIdentity) DeepCopy() *ClusterNetworkProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfileIdentity) Equal(other *ClusterNetworkProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfileIdentityByHref) DeepCopy() *ClusterNetworkProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfileIdentityByHref) Equal(other *ClusterNetworkProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfileIdentityByName) DeepCopy() *ClusterNetworkProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfileIdentityByName) Equal(other *ClusterNetworkProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkProfileReference) DeepCopy() *ClusterNetworkProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkProfileReference) Equal(other *ClusterNetworkProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkReference) DeepCopy() *ClusterNetworkReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkReference) Equal(other *ClusterNetworkReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnet) DeepCopy() *ClusterNetworkSubnet {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnet) Equal(other *ClusterNetworkSubnet, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetCollection) DeepCopy() *ClusterNetworkSubnetCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetCollection) Equal(other *ClusterNetworkSubnetCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetIdentity) DeepCopy() *ClusterNetworkSubnetIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetIdentity) Equal(other *ClusterNetworkSubnetIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetIdentityByHref) DeepCopy() *ClusterNetworkSubnetIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetIdentityByHref) Equal(other *ClusterNetworkSubnetIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetIdentityByID) DeepCopy() *ClusterNetworkSubnetIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetIdentityByID) Equal(other *ClusterNetworkSubnetIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetLifecycleReason) DeepCopy() *ClusterNetworkSubnetLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetLifecycleReason) Equal(other *ClusterNetworkSubnetLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPatch) DeepCopy() *ClusterNetworkSubnetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPatch) Equal(other *ClusterNetworkSubnetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPrefix) DeepCopy() *ClusterNetworkSubnetPrefix {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPrefix) Equal(other *ClusterNetworkSubnetPrefix, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPrefixPrototype) DeepCopy() *ClusterNetworkSubnetPrefixPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPrefixPrototype) Equal(other *ClusterNetworkSubnetPrefixPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPrototype) DeepCopy() *ClusterNetworkSubnetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPrototype) Equal(other *ClusterNetworkSubnetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByIPv4CIDRBlockPrototype) DeepCopy() *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByIPv4CIDRBlockPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByIPv4CIDRBlockPrototype) Equal(other *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByIPv4CIDRBlockPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByTotalCountPrototype) DeepCopy() *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByTotalCountPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByTotalCountPrototype) Equal(other *ClusterNetworkSubnetPrototypeClusterNetworkSubnetByTotalCountPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReference) DeepCopy() *ClusterNetworkSubnetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReference) Equal(other *ClusterNetworkSubnetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIP) DeepCopy() *ClusterNetworkSubnetReservedIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIP) Equal(other *ClusterNetworkSubnetReservedIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPCollection) DeepCopy() *ClusterNetworkSubnetReservedIPCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPCollection) Equal(other *ClusterNetworkSubnetReservedIPCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPLifecycleReason) DeepCopy() *ClusterNetworkSubnetReservedIPLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPLifecycleReason) Equal(other *ClusterNetworkSubnetReservedIPLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPPatch) DeepCopy() *ClusterNetworkSubnetReservedIPPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPPatch) Equal(other *ClusterNetworkSubnetReservedIPPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPReference) DeepCopy() *ClusterNetworkSubnetReservedIPReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPReference) Equal(other *ClusterNetworkSubnetReservedIPReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPTarget) DeepCopy() *ClusterNetworkSubnetReservedIPTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPTarget) Equal(other *ClusterNetworkSubnetReservedIPTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ClusterNetworkSubnetReservedIPTargetClusterNetworkInterfaceReferenceClusterNetworkSubnetReservedIPTargetContext) DeepCopy() *ClusterNetworkSubnetReservedIPTargetClusterNetworkInterfaceReferenceClusterNetworkSubnetReservedIPTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ClusterNetworkSubnetReservedIPTargetClusterNetworkInterfaceReferenceClusterNetworkSubnetReservedIPTargetContext) Equal(other *ClusterNetworkSubnetReservedIPTargetClusterNetworkInterfaceReferenceClusterNetworkSubnetReservedIPTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHost) DeepCopy() *DedicatedHost {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHost) Equal(other *DedicatedHost, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostCollection) DeepCopy() *DedicatedHostCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostCollection) Equal(other *DedicatedHostCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostDisk) DeepCopy() *DedicatedHostDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostDisk) Equal(other *DedicatedHostDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostDiskCollection) DeepCopy() *DedicatedHostDiskCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostDiskCollection) Equal(other *DedicatedHostDiskCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostDiskPatch) DeepCopy() *DedicatedHostDiskPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostDiskPatch) Equal(other *DedicatedHostDiskPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroup) DeepCopy() *DedicatedHostGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroup) Equal(other *DedicatedHostGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupCollection) DeepCopy() *DedicatedHostGroupCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupCollection) Equal(other *DedicatedHostGroupCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupIdentity) DeepCopy() *DedicatedHostGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupIdentity) Equal(other *DedicatedHostGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupIdentityByCRN) DeepCopy() *DedicatedHostGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupIdentityByCRN) Equal(other *DedicatedHostGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupIdentityByHref) DeepCopy() *DedicatedHostGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupIdentityByHref) Equal(other *DedicatedHostGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupIdentityByID) DeepCopy() *DedicatedHostGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupIdentityByID) Equal(other *DedicatedHostGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupPatch) DeepCopy() *DedicatedHostGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupPatch) Equal(other *DedicatedHostGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupPrototypeDedicatedHostByZoneContext) DeepCopy() *DedicatedHostGroupPrototypeDedicatedHostByZoneContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupPrototypeDedicatedHostByZoneContext) Equal(other *DedicatedHostGroupPrototypeDedicatedHostByZoneContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostGroupReference) DeepCopy() *DedicatedHostGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostGroupReference) Equal(other *DedicatedHostGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostNuma) DeepCopy() *DedicatedHostNuma {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostNuma) Equal(other *DedicatedHostNuma, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostNumaNode) DeepCopy() *DedicatedHostNumaNode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostNumaNode) Equal(other *DedicatedHostNumaNode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostPatch) DeepCopy() *DedicatedHostPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostPatch) Equal(other *DedicatedHostPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfile) DeepCopy() *DedicatedHostProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfile) Equal(other *DedicatedHostProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileCollection) DeepCopy() *DedicatedHostProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileCollection) Equal(other *DedicatedHostProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileDisk) DeepCopy() *DedicatedHostProfileDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileDisk) Equal(other *DedicatedHostProfileDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileDiskInterface) DeepCopy() *DedicatedHostProfileDiskInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileDiskInterface) Equal(other *DedicatedHostProfileDiskInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileDiskQuantity) DeepCopy() *DedicatedHostProfileDiskQuantity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileDiskQuantity) Equal(other *DedicatedHostProfileDiskQuantity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileDiskSize) DeepCopy() *DedicatedHostProfileDiskSize {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileDiskSize) Equal(other *DedicatedHostProfileDiskSize, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileDiskSupportedInterfaces) DeepCopy() *DedicatedHostProfileDiskSupportedInterfaces {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileDiskSupportedInterfaces) Equal(other *DedicatedHostProfileDiskSupportedInterfaces, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileIdentity) DeepCopy() *DedicatedHostProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileIdentity) Equal(other *DedicatedHostProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileIdentityByHref) DeepCopy() *DedicatedHostProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileIdentityByHref) Equal(other *DedicatedHostProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileIdentityByName) DeepCopy() *DedicatedHostProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileIdentityByName) Equal(other *DedicatedHostProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileMemory) DeepCopy() *DedicatedHostProfileMemory {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileMemory) Equal(other *DedicatedHostProfileMemory, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileMemoryDependent) DeepCopy() *DedicatedHostProfileMemoryDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileMemoryDependent) Equal(other *DedicatedHostProfileMemoryDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileMemoryEnum) DeepCopy() *DedicatedHostProfileMemoryEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileMemoryEnum) Equal(other *DedicatedHostProfileMemoryEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileMemoryFixed) DeepCopy() *DedicatedHostProfileMemoryFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileMemoryFixed) Equal(other *DedicatedHostProfileMemoryFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileMemoryRange) DeepCopy() *DedicatedHostProfileMemoryRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileMemoryRange) Equal(other *DedicatedHostProfileMemoryRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileReference) DeepCopy() *DedicatedHostProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileReference) Equal(other *DedicatedHostProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileSocket) DeepCopy() *DedicatedHostProfileSocket {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileSocket) Equal(other *DedicatedHostProfileSocket, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileSocketDependent) DeepCopy() *DedicatedHostProfileSocketDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileSocketDependent) Equal(other *DedicatedHostProfileSocketDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileSocketEnum) DeepCopy() *DedicatedHostProfileSocketEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileSocketEnum) Equal(other *DedicatedHostProfileSocketEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileSocketFixed) DeepCopy() *DedicatedHostProfileSocketFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileSocketFixed) Equal(other *DedicatedHostProfileSocketFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileSocketRange) DeepCopy() *DedicatedHostProfileSocketRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileSocketRange) Equal(other *DedicatedHostProfileSocketRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpu) DeepCopy() *DedicatedHostProfileVcpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpu) Equal(other *DedicatedHostProfileVcpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuArchitecture) DeepCopy() *DedicatedHostProfileVcpuArchitecture {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuArchitecture) Equal(other *DedicatedHostProfileVcpuArchitecture, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuDependent) DeepCopy() *DedicatedHostProfileVcpuDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuDependent) Equal(other *DedicatedHostProfileVcpuDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuEnum) DeepCopy() *DedicatedHostProfileVcpuEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuEnum) Equal(other *DedicatedHostProfileVcpuEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuFixed) DeepCopy() *DedicatedHostProfileVcpuFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuFixed) Equal(other *DedicatedHostProfileVcpuFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuManufacturer) DeepCopy() *DedicatedHostProfileVcpuManufacturer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuManufacturer) Equal(other *DedicatedHostProfileVcpuManufacturer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostProfileVcpuRange) DeepCopy() *DedicatedHostProfileVcpuRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostProfileVcpuRange) Equal(other *DedicatedHostProfileVcpuRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostPrototype) DeepCopy() *DedicatedHostPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostPrototype) Equal(other *DedicatedHostPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostPrototypeDedicatedHostByGroup) DeepCopy() *DedicatedHostPrototypeDedicatedHostByGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostPrototypeDedicatedHostByGroup) Equal(other *DedicatedHostPrototypeDedicatedHostByGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostPrototypeDedicatedHostByZone) DeepCopy() *DedicatedHostPrototypeDedicatedHostByZone {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostPrototypeDedicatedHostByZone) Equal(other *DedicatedHostPrototypeDedicatedHostByZone, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DedicatedHostReference) DeepCopy() *DedicatedHostReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DedicatedHostReference) Equal(other *DedicatedHostReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DefaultNetworkACL) DeepCopy() *DefaultNetworkACL {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DefaultNetworkACL) Equal(other *DefaultNetworkACL, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DefaultRoutingTable) DeepCopy() *DefaultRoutingTable {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DefaultRoutingTable) Equal(other *DefaultRoutingTable, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DefaultSecurityGroup) DeepCopy() *DefaultSecurityGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DefaultSecurityGroup) Equal(other *DefaultSecurityGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Deleted) DeepCopy() *Deleted {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Deleted) Equal(other *Deleted, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsInstanceIdentity) DeepCopy() *DnsInstanceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsInstanceIdentity) Equal(other *DnsInstanceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsInstanceIdentityByCRN) DeepCopy() *DnsInstanceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsInstanceIdentityByCRN) Equal(other *DnsInstanceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsInstanceReferenceLoadBalancerDnsContext) DeepCopy() *DnsInstanceReferenceLoadBalancerDnsContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsInstanceReferenceLoadBalancerDnsContext) Equal(other *DnsInstanceReferenceLoadBalancerDnsContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsServer) DeepCopy() *DnsServer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsServer) Equal(other *DnsServer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsServerPrototype) DeepCopy() *DnsServerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsServerPrototype) Equal(other *DnsServerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsZoneIdentity) DeepCopy() *DnsZoneIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsZoneIdentity) Equal(other *DnsZoneIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsZoneIdentityByID) DeepCopy() *DnsZoneIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsZoneIdentityByID) Equal(other *DnsZoneIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *DnsZoneReference) DeepCopy() *DnsZoneReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *DnsZoneReference) Equal(other *DnsZoneReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EncryptionKeyIdentity) DeepCopy() *EncryptionKeyIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EncryptionKeyIdentity) Equal(other *EncryptionKeyIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EncryptionKeyIdentityByCRN) DeepCopy() *EncryptionKeyIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EncryptionKeyIdentityByCRN) Equal(other *EncryptionKeyIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EncryptionKeyReference) DeepCopy() *EncryptionKeyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EncryptionKeyReference) Equal(other *EncryptionKeyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGateway) DeepCopy() *EndpointGateway {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGateway) Equal(other *EndpointGateway, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayCollection) DeepCopy() *EndpointGatewayCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayCollection) Equal(other *EndpointGatewayCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayLifecycleReason) DeepCopy() *EndpointGatewayLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayLifecycleReason) Equal(other *EndpointGatewayLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayPatch) DeepCopy() *EndpointGatewayPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayPatch) Equal(other *EndpointGatewayPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReferenceRemote) DeepCopy() *EndpointGatewayReferenceRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReferenceRemote) Equal(other *EndpointGatewayReferenceRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayRemote) DeepCopy() *EndpointGatewayRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayRemote) Equal(other *EndpointGatewayRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReservedIP) DeepCopy() *EndpointGatewayReservedIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReservedIP) Equal(other *EndpointGatewayReservedIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReservedIPReservedIPIdentity) DeepCopy() *EndpointGatewayReservedIPReservedIPIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReservedIPReservedIPIdentity) Equal(other *EndpointGatewayReservedIPReservedIPIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReservedIPReservedIPIdentityByHref) DeepCopy() *EndpointGatewayReservedIPReservedIPIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReservedIPReservedIPIdentityByHref) Equal(other *EndpointGatewayReservedIPReservedIPIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReservedIPReservedIPIdentityByID) DeepCopy() *EndpointGatewayReservedIPReservedIPIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReservedIPReservedIPIdentityByID) Equal(other *EndpointGatewayReservedIPReservedIPIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayReservedIPReservedIPPrototypeTargetContext) DeepCopy() *EndpointGatewayReservedIPReservedIPPrototypeTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayReservedIPReservedIPPrototypeTargetContext) Equal(other *EndpointGatewayReservedIPReservedIPPrototypeTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBinding) DeepCopy() *EndpointGatewayResourceBinding {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBinding) Equal(other *EndpointGatewayResourceBinding, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingCollection) DeepCopy() *EndpointGatewayResourceBindingCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingCollection) Equal(other *EndpointGatewayResourceBindingCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingLifecycleReason) DeepCopy() *EndpointGatewayResourceBindingLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingLifecycleReason) Equal(other *EndpointGatewayResourceBindingLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingPatch) DeepCopy() *EndpointGatewayResourceBindingPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingPatch) Equal(other *EndpointGatewayResourceBindingPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingTarget) DeepCopy() *EndpointGatewayResourceBindingTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingTarget) Equal(other *EndpointGatewayResourceBindingTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingTargetCRN) DeepCopy() *EndpointGatewayResourceBindingTargetCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingTargetCRN) Equal(other *EndpointGatewayResourceBindingTargetCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingTargetPrototype) DeepCopy() *EndpointGatewayResourceBindingTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingTargetPrototype) Equal(other *EndpointGatewayResourceBindingTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayResourceBindingTargetPrototypeEndpointGatewayResourceBindingTargetByCRN) DeepCopy() *EndpointGatewayResourceBindingTargetPrototypeEndpointGatewayResourceBindingTargetByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayResourceBindingTargetPrototypeEndpointGatewayResourceBindingTargetByCRN) Equal(other *EndpointGatewayResourceBindingTargetPrototypeEndpointGatewayResourceBindingTargetByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTarget) DeepCopy() *EndpointGatewayTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTarget) Equal(other *EndpointGatewayTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetPrivatePathServiceGatewayReference) DeepCopy() *EndpointGatewayTargetPrivatePathServiceGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetPrivatePathServiceGatewayReference) Equal(other *EndpointGatewayTargetPrivatePathServiceGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetPrototype) DeepCopy() *EndpointGatewayTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetPrototype) Equal(other *EndpointGatewayTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypePrivatePathServiceGatewayPrototype) DeepCopy() *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypePrivatePathServiceGatewayPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypePrivatePathServiceGatewayPrototype) Equal(other *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypePrivatePathServiceGatewayPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype) DeepCopy() *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype) Equal(other *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderCloudServicePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderInfrastructureServicePrototype) DeepCopy() *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderInfrastructureServicePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderInfrastructureServicePrototype) Equal(other *EndpointGatewayTargetPrototypeEndpointGatewayTargetResourceTypeProviderInfrastructureServicePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetProviderCloudServiceReference) DeepCopy() *EndpointGatewayTargetProviderCloudServiceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetProviderCloudServiceReference) Equal(other *EndpointGatewayTargetProviderCloudServiceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *EndpointGatewayTargetProviderInfrastructureServiceReference) DeepCopy() *EndpointGatewayTargetProviderInfrastructureServiceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *EndpointGatewayTargetProviderInfrastructureServiceReference) Equal(other *EndpointGatewayTargetProviderInfrastructureServiceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIP) DeepCopy() *FloatingIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIP) Equal(other *FloatingIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPCollection) DeepCopy() *FloatingIPCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPCollection) Equal(other *FloatingIPCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPCollectionVirtualNetworkInterfaceContext) DeepCopy() *FloatingIPCollectionVirtualNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPCollectionVirtualNetworkInterfaceContext) Equal(other *FloatingIPCollectionVirtualNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPPatch) DeepCopy() *FloatingIPPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPPatch) Equal(other *FloatingIPPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPPrototype) DeepCopy() *FloatingIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPPrototype) Equal(other *FloatingIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPPrototypeFloatingIPByTarget) DeepCopy() *FloatingIPPrototypeFloatingIPByTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPPrototypeFloatingIPByTarget) Equal(other *FloatingIPPrototypeFloatingIPByTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPPrototypeFloatingIPByZone) DeepCopy() *FloatingIPPrototypeFloatingIPByZone {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPPrototypeFloatingIPByZone) Equal(other *FloatingIPPrototypeFloatingIPByZone, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPReference) DeepCopy() *FloatingIPReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPReference) Equal(other *FloatingIPReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTarget) DeepCopy() *FloatingIPTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTarget) Equal(other *FloatingIPTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetBareMetalServerNetworkInterfaceReference) DeepCopy() *FloatingIPTargetBareMetalServerNetworkInterfaceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetBareMetalServerNetworkInterfaceReference) Equal(other *FloatingIPTargetBareMetalServerNetworkInterfaceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetNetworkInterfaceReference) DeepCopy() *FloatingIPTargetNetworkInterfaceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetNetworkInterfaceReference) Equal(other *FloatingIPTargetNetworkInterfaceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatch) DeepCopy() *FloatingIPTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatch) Equal(other *FloatingIPTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPatchBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPatchNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPatchNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPatchNetworkInterfaceIdentityNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPatchVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPatchVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPatchVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototype) DeepCopy() *FloatingIPTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototype) Equal(other *FloatingIPTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPrototypeBareMetalServerNetworkInterfaceIdentityBareMetalServerNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPrototypeNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPrototypeNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentity) DeepCopy() *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentity) Equal(other *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *FloatingIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetPublicGatewayReference) DeepCopy() *FloatingIPTargetPublicGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetPublicGatewayReference) Equal(other *FloatingIPTargetPublicGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPTargetVirtualNetworkInterfaceReference) DeepCopy() *FloatingIPTargetVirtualNetworkInterfaceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPTargetVirtualNetworkInterfaceReference) Equal(other *FloatingIPTargetVirtualNetworkInterfaceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FloatingIPUnpaginatedCollection) DeepCopy() *FloatingIPUnpaginatedCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FloatingIPUnpaginatedCollection) Equal(other *FloatingIPUnpaginatedCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollector) DeepCopy() *FlowLogCollector {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollector) Equal(other *FlowLogCollector, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorCollection) DeepCopy() *FlowLogCollectorCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorCollection) Equal(other *FlowLogCollectorCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorPatch) DeepCopy() *FlowLogCollectorPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorPatch) Equal(other *FlowLogCollectorPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTarget) DeepCopy() *FlowLogCollectorTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTarget) Equal(other *FlowLogCollectorTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetInstanceNetworkAttachmentReferenceFlowLogCollectorContext) DeepCopy() *FlowLogCollectorTargetInstanceNetworkAttachmentReferenceFlowLogCollectorContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetInstanceNetworkAttachmentReferenceFlowLogCollectorContext) Equal(other *FlowLogCollectorTargetInstanceNetworkAttachmentReferenceFlowLogCollectorContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetInstanceReference) DeepCopy() *FlowLogCollectorTargetInstanceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetInstanceReference) Equal(other *FlowLogCollectorTargetInstanceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetNetworkInterfaceReferenceTargetContext) DeepCopy() *FlowLogCollectorTargetNetworkInterfaceReferenceTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetNetworkInterfaceReferenceTargetContext) Equal(other *FlowLogCollectorTargetNetworkInterfaceReferenceTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototype) DeepCopy() *FlowLogCollectorTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototype) Equal(other *FlowLogCollectorTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentity) Equal(other *FlowLogCollectorTargetPrototypeInstanceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByCRN) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByCRN) Equal(other *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeInstanceIdentityInstanceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentity) Equal(other *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeInstanceNetworkAttachmentIdentityInstanceNetworkAttachmentIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentity) Equal(other *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeNetworkInterfaceIdentityNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeSubnetIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentity) Equal(other *FlowLogCollectorTargetPrototypeSubnetIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByCRN) DeepCopy() *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByCRN) Equal(other *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeSubnetIdentitySubnetIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVPCIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeVPCIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVPCIdentity) Equal(other *FlowLogCollectorTargetPrototypeVPCIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByCRN) DeepCopy() *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByCRN) Equal(other *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeVPCIdentityVPCIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentity) DeepCopy() *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentity) Equal(other *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *FlowLogCollectorTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetSubnetReference) DeepCopy() *FlowLogCollectorTargetSubnetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetSubnetReference) Equal(other *FlowLogCollectorTargetSubnetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetVPCReference) DeepCopy() *FlowLogCollectorTargetVPCReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetVPCReference) Equal(other *FlowLogCollectorTargetVPCReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *FlowLogCollectorTargetVirtualNetworkInterfaceReferenceAttachmentContext) DeepCopy() *FlowLogCollectorTargetVirtualNetworkInterfaceReferenceAttachmentContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *FlowLogCollectorTargetVirtualNetworkInterfaceReferenceAttachmentContext) Equal(other *FlowLogCollectorTargetVirtualNetworkInterfaceReferenceAttachmentContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IP) DeepCopy() *IP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IP) Equal(other *IP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IPsecPolicy) DeepCopy() *IPsecPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IPsecPolicy) Equal(other *IPsecPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IPsecPolicyCollection) DeepCopy() *IPsecPolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IPsecPolicyCollection) Equal(other *IPsecPolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IPsecPolicyConnectionCollection) DeepCopy() *IPsecPolicyConnectionCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IPsecPolicyConnectionCollection) Equal(other *IPsecPolicyConnectionCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IPsecPolicyPatch) DeepCopy() *IPsecPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IPsecPolicyPatch) Equal(other *IPsecPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IPsecPolicyReference) DeepCopy() *IPsecPolicyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IPsecPolicyReference) Equal(other *IPsecPolicyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IkePolicy) DeepCopy() *IkePolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IkePolicy) Equal(other *IkePolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IkePolicyCollection) DeepCopy() *IkePolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IkePolicyCollection) Equal(other *IkePolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IkePolicyConnectionCollection) DeepCopy() *IkePolicyConnectionCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IkePolicyConnectionCollection) Equal(other *IkePolicyConnectionCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IkePolicyPatch) DeepCopy() *IkePolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IkePolicyPatch) Equal(other *IkePolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *IkePolicyReference) DeepCopy() *IkePolicyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *IkePolicyReference) Equal(other *IkePolicyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Image) DeepCopy() *Image {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Image) Equal(other *Image, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageAllowedUse) DeepCopy() *ImageAllowedUse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageAllowedUse) Equal(other *ImageAllowedUse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageAllowedUsePatch) DeepCopy() *ImageAllowedUsePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageAllowedUsePatch) Equal(other *ImageAllowedUsePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageAllowedUsePrototype) DeepCopy() *ImageAllowedUsePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageAllowedUsePrototype) Equal(other *ImageAllowedUsePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageBareMetalServerProfileCollection) DeepCopy() *ImageBareMetalServerProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageBareMetalServerProfileCollection) Equal(other *ImageBareMetalServerProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageCatalogOffering) DeepCopy() *ImageCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageCatalogOffering) Equal(other *ImageCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageCollection) DeepCopy() *ImageCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageCollection) Equal(other *ImageCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageExportJob) DeepCopy() *ImageExportJob {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageExportJob) Equal(other *ImageExportJob, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageExportJobPatch) DeepCopy() *ImageExportJobPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageExportJobPatch) Equal(other *ImageExportJobPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageExportJobStatusReason) DeepCopy() *ImageExportJobStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageExportJobStatusReason) Equal(other *ImageExportJobStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageExportJobUnpaginatedCollection) DeepCopy() *ImageExportJobUnpaginatedCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageExportJobUnpaginatedCollection) Equal(other *ImageExportJobUnpaginatedCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageFile) DeepCopy() *ImageFile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageFile) Equal(other *ImageFile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageFileChecksums) DeepCopy() *ImageFileChecksums {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageFileChecksums) Equal(other *ImageFileChecksums, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageFilePrototype) DeepCopy() *ImageFilePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageFilePrototype) Equal(other *ImageFilePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageIdentity) DeepCopy() *ImageIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageIdentity) Equal(other *ImageIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageIdentityByCRN) DeepCopy() *ImageIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageIdentityByCRN) Equal(other *ImageIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageIdentityByHref) DeepCopy() *ImageIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageIdentityByHref) Equal(other *ImageIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageIdentityByID) DeepCopy() *ImageIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageIdentityByID) Equal(other *ImageIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageInstanceProfileCollection) DeepCopy() *ImageInstanceProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageInstanceProfileCollection) Equal(other *ImageInstanceProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImagePatch) DeepCopy() *ImagePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImagePatch) Equal(other *ImagePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImagePrototype) DeepCopy() *ImagePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImagePrototype) Equal(other *ImagePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImagePrototypeImageByFile) DeepCopy() *ImagePrototypeImageByFile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImagePrototypeImageByFile) Equal(other *ImagePrototypeImageByFile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImagePrototypeImageBySourceVolume) DeepCopy() *ImagePrototypeImageBySourceVolume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImagePrototypeImageBySourceVolume) Equal(other *ImagePrototypeImageBySourceVolume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageReference) DeepCopy() *ImageReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageReference) Equal(other *ImageReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageRemote) DeepCopy() *ImageRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageRemote) Equal(other *ImageRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageRemoteContextImageReference) DeepCopy() *ImageRemoteContextImageReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageRemoteContextImageReference) Equal(other *ImageRemoteContextImageReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ImageStatusReason) DeepCopy() *ImageStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ImageStatusReason) Equal(other *ImageStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Instance) DeepCopy() *Instance {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Instance) Equal(other *Instance, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAction) DeepCopy() *InstanceAction {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAction) Equal(other *InstanceAction, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailability) DeepCopy() *InstanceAvailability {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailability) Equal(other *InstanceAvailability, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailabilityPatch) DeepCopy() *InstanceAvailabilityPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailabilityPatch) Equal(other *InstanceAvailabilityPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailabilityPolicy) DeepCopy() *InstanceAvailabilityPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailabilityPolicy) Equal(other *InstanceAvailabilityPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailabilityPolicyPatch) DeepCopy() *InstanceAvailabilityPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailabilityPolicyPatch) Equal(other *InstanceAvailabilityPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailabilityPolicyPrototype) DeepCopy() *InstanceAvailabilityPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailabilityPolicyPrototype) Equal(other *InstanceAvailabilityPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceAvailabilityPrototype) DeepCopy() *InstanceAvailabilityPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceAvailabilityPrototype) Equal(other *InstanceAvailabilityPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceCatalogOffering) DeepCopy() *InstanceCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceCatalogOffering) Equal(other *InstanceCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceCatalogOfferingPrototype) DeepCopy() *InstanceCatalogOfferingPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceCatalogOfferingPrototype) Equal(other *InstanceCatalogOfferingPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceCatalogOfferingPrototypeCatalogOfferingByOffering) DeepCopy() *InstanceCatalogOfferingPrototypeCatalogOfferingByOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceCatalogOfferingPrototypeCatalogOfferingByOffering) Equal(other *InstanceCatalogOfferingPrototypeCatalogOfferingByOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceCatalogOfferingPrototypeCatalogOfferingByVersion) DeepCopy() *InstanceCatalogOfferingPrototypeCatalogOfferingByVersion {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceCatalogOfferingPrototypeCatalogOfferingByVersion) Equal(other *InstanceCatalogOfferingPrototypeCatalogOfferingByVersion, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachment) DeepCopy() *InstanceClusterNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachment) Equal(other *InstanceClusterNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentBefore) DeepCopy() *InstanceClusterNetworkAttachmentBefore {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentBefore) Equal(other *InstanceClusterNetworkAttachmentBefore, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentBeforePrototype) DeepCopy() *InstanceClusterNetworkAttachmentBeforePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentBeforePrototype) Equal(other *InstanceClusterNetworkAttachmentBeforePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByHref) DeepCopy() *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByHref) Equal(other *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByID) DeepCopy() *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByID) Equal(other *InstanceClusterNetworkAttachmentBeforePrototypeInstanceClusterNetworkAttachmentIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentCollection) DeepCopy() *InstanceClusterNetworkAttachmentCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentCollection) Equal(other *InstanceClusterNetworkAttachmentCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentLifecycleReason) DeepCopy() *InstanceClusterNetworkAttachmentLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentLifecycleReason) Equal(other *InstanceClusterNetworkAttachmentLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPatch) DeepCopy() *InstanceClusterNetworkAttachmentPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPatch) Equal(other *InstanceClusterNetworkAttachmentPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterface) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterface) Equal(other *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentity) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentity) Equal(other *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByHref) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByHref) Equal(other *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByID) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByID) Equal(other *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceClusterNetworkInterfaceIdentityClusterNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceInstanceClusterNetworkInterfacePrototypeInstanceClusterNetworkAttachment) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceInstanceClusterNetworkInterfacePrototypeInstanceClusterNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceInstanceClusterNetworkInterfacePrototypeInstanceClusterNetworkAttachment) Equal(other *InstanceClusterNetworkAttachmentPrototypeClusterNetworkInterfaceInstanceClusterNetworkInterfacePrototypeInstanceClusterNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentPrototypeInstanceContext) DeepCopy() *InstanceClusterNetworkAttachmentPrototypeInstanceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentPrototypeInstanceContext) Equal(other *InstanceClusterNetworkAttachmentPrototypeInstanceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceClusterNetworkAttachmentReference) DeepCopy() *InstanceClusterNetworkAttachmentReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceClusterNetworkAttachmentReference) Equal(other *InstanceClusterNetworkAttachmentReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceCollection) DeepCopy() *InstanceCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceCollection) Equal(other *InstanceCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceConsoleAccessToken) DeepCopy() *InstanceConsoleAccessToken {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceConsoleAccessToken) Equal(other *InstanceConsoleAccessToken, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceDefaultTrustedProfilePrototype) DeepCopy() *InstanceDefaultTrustedProfilePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceDefaultTrustedProfilePrototype) Equal(other *InstanceDefaultTrustedProfilePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceDisk) DeepCopy() *InstanceDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceDisk) Equal(other *InstanceDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceDiskCollection) DeepCopy() *InstanceDiskCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceDiskCollection) Equal(other *InstanceDiskCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceDiskPatch) DeepCopy() *InstanceDiskPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceDiskPatch) Equal(other *InstanceDiskPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceDiskReference) DeepCopy() *InstanceDiskReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceDiskReference) Equal(other *InstanceDiskReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGpu) DeepCopy() *InstanceGpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGpu) Equal(other *InstanceGpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroup) DeepCopy() *InstanceGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroup) Equal(other *InstanceGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupCollection) DeepCopy() *InstanceGroupCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupCollection) Equal(other *InstanceGroupCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupLifecycleReason) DeepCopy() *InstanceGroupLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupLifecycleReason) Equal(other *InstanceGroupLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManager) DeepCopy() *InstanceGroupManager {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManager) Equal(other *InstanceGroupManager, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerAction) DeepCopy() *InstanceGroupManagerAction {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerAction) Equal(other *InstanceGroupManagerAction, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionGroupPatch) DeepCopy() *InstanceGroupManagerActionGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionGroupPatch) Equal(other *InstanceGroupManagerActionGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionManagerPatch) DeepCopy() *InstanceGroupManagerActionManagerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionManagerPatch) Equal(other *InstanceGroupManagerActionManagerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPatch) DeepCopy() *InstanceGroupManagerActionPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPatch) Equal(other *InstanceGroupManagerActionPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototype) DeepCopy() *InstanceGroupManagerActionPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototype) Equal(other *InstanceGroupManagerActionPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototype) DeepCopy() *InstanceGroupManagerActionPrototypeScheduledActionPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototype) Equal(other *InstanceGroupManagerActionPrototypeScheduledActionPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithGroup) DeepCopy() *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithGroup) Equal(other *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithManager) DeepCopy() *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithManager {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithManager) Equal(other *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByCronSpecWithManager, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithGroup) DeepCopy() *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithGroup) Equal(other *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithManager) DeepCopy() *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithManager {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithManager) Equal(other *InstanceGroupManagerActionPrototypeScheduledActionPrototypeByRunAtWithManager, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionReference) DeepCopy() *InstanceGroupManagerActionReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionReference) Equal(other *InstanceGroupManagerActionReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionScheduledAction) DeepCopy() *InstanceGroupManagerActionScheduledAction {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionScheduledAction) Equal(other *InstanceGroupManagerActionScheduledAction, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionScheduledActionGroupTarget) DeepCopy() *InstanceGroupManagerActionScheduledActionGroupTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionScheduledActionGroupTarget) Equal(other *InstanceGroupManagerActionScheduledActionGroupTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionScheduledActionManagerTarget) DeepCopy() *InstanceGroupManagerActionScheduledActionManagerTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionScheduledActionManagerTarget) Equal(other *InstanceGroupManagerActionScheduledActionManagerTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerActionsCollection) DeepCopy() *InstanceGroupManagerActionsCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerActionsCollection) Equal(other *InstanceGroupManagerActionsCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerAutoScale) DeepCopy() *InstanceGroupManagerAutoScale {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerAutoScale) Equal(other *InstanceGroupManagerAutoScale, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerCollection) DeepCopy() *InstanceGroupManagerCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerCollection) Equal(other *InstanceGroupManagerCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPatch) DeepCopy() *InstanceGroupManagerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPatch) Equal(other *InstanceGroupManagerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicy) DeepCopy() *InstanceGroupManagerPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicy) Equal(other *InstanceGroupManagerPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyCollection) DeepCopy() *InstanceGroupManagerPolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyCollection) Equal(other *InstanceGroupManagerPolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyInstanceGroupManagerTargetPolicy) DeepCopy() *InstanceGroupManagerPolicyInstanceGroupManagerTargetPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyInstanceGroupManagerTargetPolicy) Equal(other *InstanceGroupManagerPolicyInstanceGroupManagerTargetPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyPatch) DeepCopy() *InstanceGroupManagerPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyPatch) Equal(other *InstanceGroupManagerPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyPrototype) DeepCopy() *InstanceGroupManagerPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyPrototype) Equal(other *InstanceGroupManagerPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyPrototypeInstanceGroupManagerTargetPolicyPrototype) DeepCopy() *InstanceGroupManagerPolicyPrototypeInstanceGroupManagerTargetPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyPrototypeInstanceGroupManagerTargetPolicyPrototype) Equal(other *InstanceGroupManagerPolicyPrototypeInstanceGroupManagerTargetPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPolicyReference) DeepCopy() *InstanceGroupManagerPolicyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPolicyReference) Equal(other *InstanceGroupManagerPolicyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPrototype) DeepCopy() *InstanceGroupManagerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPrototype) Equal(other *InstanceGroupManagerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPrototypeInstanceGroupManagerAutoScalePrototype) DeepCopy() *InstanceGroupManagerPrototypeInstanceGroupManagerAutoScalePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPrototypeInstanceGroupManagerAutoScalePrototype) Equal(other *InstanceGroupManagerPrototypeInstanceGroupManagerAutoScalePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerPrototypeInstanceGroupManagerScheduledPrototype) DeepCopy() *InstanceGroupManagerPrototypeInstanceGroupManagerScheduledPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerPrototypeInstanceGroupManagerScheduledPrototype) Equal(other *InstanceGroupManagerPrototypeInstanceGroupManagerScheduledPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerReference) DeepCopy() *InstanceGroupManagerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerReference) Equal(other *InstanceGroupManagerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduled) DeepCopy() *InstanceGroupManagerScheduled {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduled) Equal(other *InstanceGroupManagerScheduled, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionGroup) DeepCopy() *InstanceGroupManagerScheduledActionGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionGroup) Equal(other *InstanceGroupManagerScheduledActionGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionGroupPrototype) DeepCopy() *InstanceGroupManagerScheduledActionGroupPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionGroupPrototype) Equal(other *InstanceGroupManagerScheduledActionGroupPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManager) DeepCopy() *InstanceGroupManagerScheduledActionManager {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManager) Equal(other *InstanceGroupManagerScheduledActionManager, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManagerAutoScale) DeepCopy() *InstanceGroupManagerScheduledActionManagerAutoScale {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManagerAutoScale) Equal(other *InstanceGroupManagerScheduledActionManagerAutoScale, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManagerPrototype) DeepCopy() *InstanceGroupManagerScheduledActionManagerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManagerPrototype) Equal(other *InstanceGroupManagerScheduledActionManagerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototype) DeepCopy() *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototype) Equal(other *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByHref) DeepCopy() *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByHref) Equal(other *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByID) DeepCopy() *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByID) Equal(other *InstanceGroupManagerScheduledActionManagerPrototypeAutoScalePrototypeByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupMembership) DeepCopy() *InstanceGroupMembership {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupMembership) Equal(other *InstanceGroupMembership, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupMembershipCollection) DeepCopy() *InstanceGroupMembershipCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupMembershipCollection) Equal(other *InstanceGroupMembershipCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupMembershipPatch) DeepCopy() *InstanceGroupMembershipPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupMembershipPatch) Equal(other *InstanceGroupMembershipPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupMembershipReferenceInstanceContext) DeepCopy() *InstanceGroupMembershipReferenceInstanceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupMembershipReferenceInstanceContext) Equal(other *InstanceGroupMembershipReferenceInstanceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupPatch) DeepCopy() *InstanceGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupPatch) Equal(other *InstanceGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceGroupReference) DeepCopy() *InstanceGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceGroupReference) Equal(other *InstanceGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceHealthReason) DeepCopy() *InstanceHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceHealthReason) Equal(other *InstanceHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceInitialization) DeepCopy() *InstanceInitialization {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceInitialization) Equal(other *InstanceInitialization, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceInitializationDefaultTrustedProfile) DeepCopy() *InstanceInitializationDefaultTrustedProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceInitializationDefaultTrustedProfile) Equal(other *InstanceInitializationDefaultTrustedProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceInitializationPassword) DeepCopy() *InstanceInitializationPassword {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceInitializationPassword) Equal(other *InstanceInitializationPassword, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceLifecycleReason) DeepCopy() *InstanceLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceLifecycleReason) Equal(other *InstanceLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceMetadataService) DeepCopy() *InstanceMetadataService {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceMetadataService) Equal(other *InstanceMetadataService, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceMetadataServicePatch) DeepCopy() *InstanceMetadataServicePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceMetadataServicePatch) Equal(other *InstanceMetadataServicePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceMetadataServicePrototype) DeepCopy() *InstanceMetadataServicePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceMetadataServicePrototype) Equal(other *InstanceMetadataServicePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachment) DeepCopy() *InstanceNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachment) Equal(other *InstanceNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentCollection) DeepCopy() *InstanceNetworkAttachmentCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentCollection) Equal(other *InstanceNetworkAttachmentCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPatch) DeepCopy() *InstanceNetworkAttachmentPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPatch) Equal(other *InstanceNetworkAttachmentPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototype) DeepCopy() *InstanceNetworkAttachmentPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototype) Equal(other *InstanceNetworkAttachmentPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterface) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterface) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext) DeepCopy() *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext) Equal(other *InstanceNetworkAttachmentPrototypeVirtualNetworkInterfaceVirtualNetworkInterfacePrototypeInstanceNetworkAttachmentContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceNetworkAttachmentReference) DeepCopy() *InstanceNetworkAttachmentReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceNetworkAttachmentReference) Equal(other *InstanceNetworkAttachmentReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePatch) DeepCopy() *InstancePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePatch) Equal(other *InstancePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePatchProfile) DeepCopy() *InstancePatchProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePatchProfile) Equal(other *InstancePatchProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePatchProfileInstanceProfileIdentityByHref) DeepCopy() *InstancePatchProfileInstanceProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePatchProfileInstanceProfileIdentityByHref) Equal(other *InstancePatchProfileInstanceProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePatchProfileInstanceProfileIdentityByName) DeepCopy() *InstancePatchProfileInstanceProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePatchProfileInstanceProfileIdentityByName) Equal(other *InstancePatchProfileInstanceProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTarget) DeepCopy() *InstancePlacementTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTarget) Equal(other *InstancePlacementTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetDedicatedHostGroupReference) DeepCopy() *InstancePlacementTargetDedicatedHostGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetDedicatedHostGroupReference) Equal(other *InstancePlacementTargetDedicatedHostGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetDedicatedHostReference) DeepCopy() *InstancePlacementTargetDedicatedHostReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetDedicatedHostReference) Equal(other *InstancePlacementTargetDedicatedHostReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatch) DeepCopy() *InstancePlacementTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatch) Equal(other *InstancePlacementTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentity) DeepCopy() *InstancePlacementTargetPatchDedicatedHostGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentity) Equal(other *InstancePlacementTargetPatchDedicatedHostGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN) DeepCopy() *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN) Equal(other *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref) DeepCopy() *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref) Equal(other *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID) DeepCopy() *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID) Equal(other *InstancePlacementTargetPatchDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostIdentity) DeepCopy() *InstancePlacementTargetPatchDedicatedHostIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostIdentity) Equal(other *InstancePlacementTargetPatchDedicatedHostIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByCRN) DeepCopy() *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByCRN) Equal(other *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByHref) DeepCopy() *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByHref) Equal(other *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByID) DeepCopy() *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByID) Equal(other *InstancePlacementTargetPatchDedicatedHostIdentityDedicatedHostIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPlacementGroupReference) DeepCopy() *InstancePlacementTargetPlacementGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPlacementGroupReference) Equal(other *InstancePlacementTargetPlacementGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototype) DeepCopy() *InstancePlacementTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototype) Equal(other *InstancePlacementTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentity) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentity) Equal(other *InstancePlacementTargetPrototypeDedicatedHostGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN) Equal(other *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref) Equal(other *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID) Equal(other *InstancePlacementTargetPrototypeDedicatedHostGroupIdentityDedicatedHostGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentity) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentity) Equal(other *InstancePlacementTargetPrototypeDedicatedHostIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByCRN) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByCRN) Equal(other *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByHref) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByHref) Equal(other *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID) DeepCopy() *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID) Equal(other *InstancePlacementTargetPrototypeDedicatedHostIdentityDedicatedHostIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentity) DeepCopy() *InstancePlacementTargetPrototypePlacementGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentity) Equal(other *InstancePlacementTargetPrototypePlacementGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByCRN) DeepCopy() *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByCRN) Equal(other *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByHref) DeepCopy() *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByHref) Equal(other *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID) DeepCopy() *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID) Equal(other *InstancePlacementTargetPrototypePlacementGroupIdentityPlacementGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfile) DeepCopy() *InstanceProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfile) Equal(other *InstanceProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileAvailabilityClass) DeepCopy() *InstanceProfileAvailabilityClass {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileAvailabilityClass) Equal(other *InstanceProfileAvailabilityClass, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileAvailabilityClassEnum) DeepCopy() *InstanceProfileAvailabilityClassEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileAvailabilityClassEnum) Equal(other *InstanceProfileAvailabilityClassEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileAvailabilityClassFixed) DeepCopy() *InstanceProfileAvailabilityClassFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileAvailabilityClassFixed) Equal(other *InstanceProfileAvailabilityClassFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileBandwidth) DeepCopy() *InstanceProfileBandwidth {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileBandwidth) Equal(other *InstanceProfileBandwidth, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileBandwidthDependent) DeepCopy() *InstanceProfileBandwidthDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileBandwidthDependent) Equal(other *InstanceProfileBandwidthDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileBandwidthEnum) DeepCopy() *InstanceProfileBandwidthEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileBandwidthEnum) Equal(other *InstanceProfileBandwidthEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileBandwidthFixed) DeepCopy() *InstanceProfileBandwidthFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileBandwidthFixed) Equal(other *InstanceProfileBandwidthFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileBandwidthRange) DeepCopy() *InstanceProfileBandwidthRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileBandwidthRange) Equal(other *InstanceProfileBandwidthRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileClusterNetworkAttachmentCount) DeepCopy() *InstanceProfileClusterNetworkAttachmentCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileClusterNetworkAttachmentCount) Equal(other *InstanceProfileClusterNetworkAttachmentCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileClusterNetworkAttachmentCountDependent) DeepCopy() *InstanceProfileClusterNetworkAttachmentCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileClusterNetworkAttachmentCountDependent) Equal(other *InstanceProfileClusterNetworkAttachmentCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileClusterNetworkAttachmentCountEnum) DeepCopy() *InstanceProfileClusterNetworkAttachmentCountEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileClusterNetworkAttachmentCountEnum) Equal(other *InstanceProfileClusterNetworkAttachmentCountEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileClusterNetworkAttachmentCountRange) DeepCopy() *InstanceProfileClusterNetworkAttachmentCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileClusterNetworkAttachmentCountRange) Equal(other *InstanceProfileClusterNetworkAttachmentCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileCollection) DeepCopy() *InstanceProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileCollection) Equal(other *InstanceProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDisk) DeepCopy() *InstanceProfileDisk {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDisk) Equal(other *InstanceProfileDisk, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskQuantity) DeepCopy() *InstanceProfileDiskQuantity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskQuantity) Equal(other *InstanceProfileDiskQuantity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskQuantityDependent) DeepCopy() *InstanceProfileDiskQuantityDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskQuantityDependent) Equal(other *InstanceProfileDiskQuantityDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskQuantityEnum) DeepCopy() *InstanceProfileDiskQuantityEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskQuantityEnum) Equal(other *InstanceProfileDiskQuantityEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskQuantityFixed) DeepCopy() *InstanceProfileDiskQuantityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskQuantityFixed) Equal(other *InstanceProfileDiskQuantityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskQuantityRange) DeepCopy() *InstanceProfileDiskQuantityRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskQuantityRange) Equal(other *InstanceProfileDiskQuantityRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSize) DeepCopy() *InstanceProfileDiskSize {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSize) Equal(other *InstanceProfileDiskSize, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSizeDependent) DeepCopy() *InstanceProfileDiskSizeDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSizeDependent) Equal(other *InstanceProfileDiskSizeDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSizeEnum) DeepCopy() *InstanceProfileDiskSizeEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSizeEnum) Equal(other *InstanceProfileDiskSizeEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSizeFixed) DeepCopy() *InstanceProfileDiskSizeFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSizeFixed) Equal(other *InstanceProfileDiskSizeFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSizeRange) DeepCopy() *InstanceProfileDiskSizeRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSizeRange) Equal(other *InstanceProfileDiskSizeRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileDiskSupportedInterfaces) DeepCopy() *InstanceProfileDiskSupportedInterfaces {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileDiskSupportedInterfaces) Equal(other *InstanceProfileDiskSupportedInterfaces, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpu) DeepCopy() *InstanceProfileGpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpu) Equal(other *InstanceProfileGpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuDependent) DeepCopy() *InstanceProfileGpuDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuDependent) Equal(other *InstanceProfileGpuDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuEnum) DeepCopy() *InstanceProfileGpuEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuEnum) Equal(other *InstanceProfileGpuEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuFixed) DeepCopy() *InstanceProfileGpuFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuFixed) Equal(other *InstanceProfileGpuFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuManufacturer) DeepCopy() *InstanceProfileGpuManufacturer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuManufacturer) Equal(other *InstanceProfileGpuManufacturer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuMemory) DeepCopy() *InstanceProfileGpuMemory {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuMemory) Equal(other *InstanceProfileGpuMemory, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuMemoryDependent) DeepCopy() *InstanceProfileGpuMemoryDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuMemoryDependent) Equal(other *InstanceProfileGpuMemoryDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuMemoryEnum) DeepCopy() *InstanceProfileGpuMemoryEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuMemoryEnum) Equal(other *InstanceProfileGpuMemoryEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuMemoryFixed) DeepCopy() *InstanceProfileGpuMemoryFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuMemoryFixed) Equal(other *InstanceProfileGpuMemoryFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuMemoryRange) DeepCopy() *InstanceProfileGpuMemoryRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuMemoryRange) Equal(other *InstanceProfileGpuMemoryRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuModel) DeepCopy() *InstanceProfileGpuModel {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuModel) Equal(other *InstanceProfileGpuModel, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileGpuRange) DeepCopy() *InstanceProfileGpuRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileGpuRange) Equal(other *InstanceProfileGpuRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileIdentity) DeepCopy() *InstanceProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileIdentity) Equal(other *InstanceProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileIdentityByHref) DeepCopy() *InstanceProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileIdentityByHref) Equal(other *InstanceProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileIdentityByName) DeepCopy() *InstanceProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileIdentityByName) Equal(other *InstanceProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileMemory) DeepCopy() *InstanceProfileMemory {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileMemory) Equal(other *InstanceProfileMemory, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileMemoryDependent) DeepCopy() *InstanceProfileMemoryDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileMemoryDependent) Equal(other *InstanceProfileMemoryDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileMemoryEnum) DeepCopy() *InstanceProfileMemoryEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileMemoryEnum) Equal(other *InstanceProfileMemoryEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileMemoryFixed) DeepCopy() *InstanceProfileMemoryFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileMemoryFixed) Equal(other *InstanceProfileMemoryFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileMemoryRange) DeepCopy() *InstanceProfileMemoryRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileMemoryRange) Equal(other *InstanceProfileMemoryRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkAttachmentCount) DeepCopy() *InstanceProfileNetworkAttachmentCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkAttachmentCount) Equal(other *InstanceProfileNetworkAttachmentCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkAttachmentCountDependent) DeepCopy() *InstanceProfileNetworkAttachmentCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkAttachmentCountDependent) Equal(other *InstanceProfileNetworkAttachmentCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkAttachmentCountRange) DeepCopy() *InstanceProfileNetworkAttachmentCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkAttachmentCountRange) Equal(other *InstanceProfileNetworkAttachmentCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkBandwidthMode) DeepCopy() *InstanceProfileNetworkBandwidthMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkBandwidthMode) Equal(other *InstanceProfileNetworkBandwidthMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkBandwidthModeEnum) DeepCopy() *InstanceProfileNetworkBandwidthModeEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkBandwidthModeEnum) Equal(other *InstanceProfileNetworkBandwidthModeEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkBandwidthModeFixed) DeepCopy() *InstanceProfileNetworkBandwidthModeFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkBandwidthModeFixed) Equal(other *InstanceProfileNetworkBandwidthModeFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkInterfaceCount) DeepCopy() *InstanceProfileNetworkInterfaceCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkInterfaceCount) Equal(other *InstanceProfileNetworkInterfaceCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkInterfaceCountDependent) DeepCopy() *InstanceProfileNetworkInterfaceCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkInterfaceCountDependent) Equal(other *InstanceProfileNetworkInterfaceCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNetworkInterfaceCountRange) DeepCopy() *InstanceProfileNetworkInterfaceCountRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNetworkInterfaceCountRange) Equal(other *InstanceProfileNetworkInterfaceCountRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNumaCount) DeepCopy() *InstanceProfileNumaCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNumaCount) Equal(other *InstanceProfileNumaCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNumaCountDependent) DeepCopy() *InstanceProfileNumaCountDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNumaCountDependent) Equal(other *InstanceProfileNumaCountDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileNumaCountFixed) DeepCopy() *InstanceProfileNumaCountFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileNumaCountFixed) Equal(other *InstanceProfileNumaCountFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileOsArchitecture) DeepCopy() *InstanceProfileOsArchitecture {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileOsArchitecture) Equal(other *InstanceProfileOsArchitecture, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfilePortSpeed) DeepCopy() *InstanceProfilePortSpeed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfilePortSpeed) Equal(other *InstanceProfilePortSpeed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfilePortSpeedDependent) DeepCopy() *InstanceProfilePortSpeedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfilePortSpeedDependent) Equal(other *InstanceProfilePortSpeedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfilePortSpeedFixed) DeepCopy() *InstanceProfilePortSpeedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfilePortSpeedFixed) Equal(other *InstanceProfilePortSpeedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileReference) DeepCopy() *InstanceProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileReference) Equal(other *InstanceProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileReservationTerms) DeepCopy() *InstanceProfileReservationTerms {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileReservationTerms) Equal(other *InstanceProfileReservationTerms, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileSupportedConfidentialComputeModes) DeepCopy() *InstanceProfileSupportedConfidentialComputeModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileSupportedConfidentialComputeModes) Equal(other *InstanceProfileSupportedConfidentialComputeModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileSupportedSecureBootModes) DeepCopy() *InstanceProfileSupportedSecureBootModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileSupportedSecureBootModes) Equal(other *InstanceProfileSupportedSecureBootModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileSupportedVcpuCountEnum) DeepCopy() *InstanceProfileSupportedVcpuCountEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileSupportedVcpuCountEnum) Equal(other *InstanceProfileSupportedVcpuCountEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileThreadsPerCoreEnum) DeepCopy() *InstanceProfileThreadsPerCoreEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileThreadsPerCoreEnum) Equal(other *InstanceProfileThreadsPerCoreEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpu) DeepCopy() *InstanceProfileVcpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpu) Equal(other *InstanceProfileVcpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuArchitecture) DeepCopy() *InstanceProfileVcpuArchitecture {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuArchitecture) Equal(other *InstanceProfileVcpuArchitecture, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuBurstLimit) DeepCopy() *InstanceProfileVcpuBurstLimit {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuBurstLimit) Equal(other *InstanceProfileVcpuBurstLimit, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuBurstLimitFixed) DeepCopy() *InstanceProfileVcpuBurstLimitFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuBurstLimitFixed) Equal(other *InstanceProfileVcpuBurstLimitFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuDependent) DeepCopy() *InstanceProfileVcpuDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuDependent) Equal(other *InstanceProfileVcpuDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuEnum) DeepCopy() *InstanceProfileVcpuEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuEnum) Equal(other *InstanceProfileVcpuEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuFixed) DeepCopy() *InstanceProfileVcpuFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuFixed) Equal(other *InstanceProfileVcpuFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuManufacturer) DeepCopy() *InstanceProfileVcpuManufacturer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuManufacturer) Equal(other *InstanceProfileVcpuManufacturer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuManufacturerDependent) DeepCopy() *InstanceProfileVcpuManufacturerDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuManufacturerDependent) Equal(other *InstanceProfileVcpuManufacturerDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuManufacturerFixed) DeepCopy() *InstanceProfileVcpuManufacturerFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuManufacturerFixed) Equal(other *InstanceProfileVcpuManufacturerFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuPercentage) DeepCopy() *InstanceProfileVcpuPercentage {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuPercentage) Equal(other *InstanceProfileVcpuPercentage, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVcpuRange) DeepCopy() *InstanceProfileVcpuRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVcpuRange) Equal(other *InstanceProfileVcpuRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidth) DeepCopy() *InstanceProfileVolumeBandwidth {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidth) Equal(other *InstanceProfileVolumeBandwidth, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthDependent) DeepCopy() *InstanceProfileVolumeBandwidthDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthDependent) Equal(other *InstanceProfileVolumeBandwidthDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthEnum) DeepCopy() *InstanceProfileVolumeBandwidthEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthEnum) Equal(other *InstanceProfileVolumeBandwidthEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthFixed) DeepCopy() *InstanceProfileVolumeBandwidthFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthFixed) Equal(other *InstanceProfileVolumeBandwidthFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthQoSModes) DeepCopy() *InstanceProfileVolumeBandwidthQoSModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthQoSModes) Equal(other *InstanceProfileVolumeBandwidthQoSModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthQoSModesDependent) DeepCopy() *InstanceProfileVolumeBandwidthQoSModesDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthQoSModesDependent) Equal(other *InstanceProfileVolumeBandwidthQoSModesDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthQoSModesEnum) DeepCopy() *InstanceProfileVolumeBandwidthQoSModesEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthQoSModesEnum) Equal(other *InstanceProfileVolumeBandwidthQoSModesEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceProfileVolumeBandwidthRange) DeepCopy() *InstanceProfileVolumeBandwidthRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceProfileVolumeBandwidthRange) Equal(other *InstanceProfileVolumeBandwidthRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototype) DeepCopy() *InstancePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototype) Equal(other *InstancePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByCatalogOffering) DeepCopy() *InstancePrototypeInstanceByCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByCatalogOffering) Equal(other *InstancePrototypeInstanceByCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkAttachment) DeepCopy() *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkAttachment) Equal(other *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkInterface) DeepCopy() *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkInterface) Equal(other *InstancePrototypeInstanceByCatalogOfferingInstanceByCatalogOfferingInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByImage) DeepCopy() *InstancePrototypeInstanceByImage {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByImage) Equal(other *InstancePrototypeInstanceByImage, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkAttachment) DeepCopy() *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkAttachment) Equal(other *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkInterface) DeepCopy() *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkInterface) Equal(other *InstancePrototypeInstanceByImageInstanceByImageInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceBySourceSnapshot) DeepCopy() *InstancePrototypeInstanceBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceBySourceSnapshot) Equal(other *InstancePrototypeInstanceBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkAttachment) DeepCopy() *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkAttachment) Equal(other *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkInterface) DeepCopy() *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkInterface) Equal(other *InstancePrototypeInstanceBySourceSnapshotInstanceBySourceSnapshotInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceBySourceTemplate) DeepCopy() *InstancePrototypeInstanceBySourceTemplate {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceBySourceTemplate) Equal(other *InstancePrototypeInstanceBySourceTemplate, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByVolume) DeepCopy() *InstancePrototypeInstanceByVolume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByVolume) Equal(other *InstancePrototypeInstanceByVolume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkAttachment) DeepCopy() *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkAttachment) Equal(other *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkInterface) DeepCopy() *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkInterface) Equal(other *InstancePrototypeInstanceByVolumeInstanceByVolumeInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceReference) DeepCopy() *InstanceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceReference) Equal(other *InstanceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceReservationAffinity) DeepCopy() *InstanceReservationAffinity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceReservationAffinity) Equal(other *InstanceReservationAffinity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceReservationAffinityPatch) DeepCopy() *InstanceReservationAffinityPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceReservationAffinityPatch) Equal(other *InstanceReservationAffinityPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceReservationAffinityPrototype) DeepCopy() *InstanceReservationAffinityPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceReservationAffinityPrototype) Equal(other *InstanceReservationAffinityPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachment) DeepCopy() *InstanceSoftwareAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachment) Equal(other *InstanceSoftwareAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentCatalogOffering) DeepCopy() *InstanceSoftwareAttachmentCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentCatalogOffering) Equal(other *InstanceSoftwareAttachmentCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentCollection) DeepCopy() *InstanceSoftwareAttachmentCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentCollection) Equal(other *InstanceSoftwareAttachmentCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentEntitlement) DeepCopy() *InstanceSoftwareAttachmentEntitlement {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentEntitlement) Equal(other *InstanceSoftwareAttachmentEntitlement, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentEntitlementLicensedSoftware) DeepCopy() *InstanceSoftwareAttachmentEntitlementLicensedSoftware {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentEntitlementLicensedSoftware) Equal(other *InstanceSoftwareAttachmentEntitlementLicensedSoftware, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentEntitlementLicensedSoftwareVendor) DeepCopy() *InstanceSoftwareAttachmentEntitlementLicensedSoftwareVendor {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentEntitlementLicensedSoftwareVendor) Equal(other *InstanceSoftwareAttachmentEntitlementLicensedSoftwareVendor, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentLifecycleReason) DeepCopy() *InstanceSoftwareAttachmentLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentLifecycleReason) Equal(other *InstanceSoftwareAttachmentLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentOfferingInstance) DeepCopy() *InstanceSoftwareAttachmentOfferingInstance {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentOfferingInstance) Equal(other *InstanceSoftwareAttachmentOfferingInstance, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentPatch) DeepCopy() *InstanceSoftwareAttachmentPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentPatch) Equal(other *InstanceSoftwareAttachmentPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceSoftwareAttachmentReference) DeepCopy() *InstanceSoftwareAttachmentReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceSoftwareAttachmentReference) Equal(other *InstanceSoftwareAttachmentReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceStatusReason) DeepCopy() *InstanceStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceStatusReason) Equal(other *InstanceStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplate) DeepCopy() *InstanceTemplate {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplate) Equal(other *InstanceTemplate, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateCollection) DeepCopy() *InstanceTemplateCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateCollection) Equal(other *InstanceTemplateCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateIdentity) DeepCopy() *InstanceTemplateIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateIdentity) Equal(other *InstanceTemplateIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateIdentityByCRN) DeepCopy() *InstanceTemplateIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateIdentityByCRN) Equal(other *InstanceTemplateIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateIdentityByHref) DeepCopy() *InstanceTemplateIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateIdentityByHref) Equal(other *InstanceTemplateIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateIdentityByID) DeepCopy() *InstanceTemplateIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateIdentityByID) Equal(other *InstanceTemplateIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContext) DeepCopy() *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContext) Equal(other *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkAttachment) DeepCopy() *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkAttachment) Equal(other *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkInterface) DeepCopy() *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkInterface) Equal(other *InstanceTemplateInstanceByCatalogOfferingInstanceTemplateContextInstanceByCatalogOfferingInstanceTemplateContextInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContext) DeepCopy() *InstanceTemplateInstanceByImageInstanceTemplateContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContext) Equal(other *InstanceTemplateInstanceByImageInstanceTemplateContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkAttachment) DeepCopy() *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkAttachment) Equal(other *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkInterface) DeepCopy() *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkInterface) Equal(other *InstanceTemplateInstanceByImageInstanceTemplateContextInstanceByImageInstanceTemplateContextInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContext) DeepCopy() *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContext) Equal(other *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkAttachment) DeepCopy() *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkAttachment) Equal(other *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkInterface) DeepCopy() *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkInterface) Equal(other *InstanceTemplateInstanceBySourceSnapshotInstanceTemplateContextInstanceBySourceSnapshotInstanceTemplateContextInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePatch) DeepCopy() *InstanceTemplatePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePatch) Equal(other *InstanceTemplatePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototype) DeepCopy() *InstanceTemplatePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototype) Equal(other *InstanceTemplatePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOffering) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOffering) Equal(other *InstanceTemplatePrototypeInstanceTemplateByCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkAttachment) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkAttachment) Equal(other *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkInterface) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkInterface) Equal(other *InstanceTemplatePrototypeInstanceTemplateByCatalogOfferingInstanceTemplateByCatalogOfferingInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByImage) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByImage {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByImage) Equal(other *InstanceTemplatePrototypeInstanceTemplateByImage, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkAttachment) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkAttachment) Equal(other *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkInterface) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkInterface) Equal(other *InstanceTemplatePrototypeInstanceTemplateByImageInstanceTemplateByImageInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshot) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshot) Equal(other *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkAttachment) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkAttachment) Equal(other *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkInterface) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkInterface) Equal(other *InstanceTemplatePrototypeInstanceTemplateBySourceSnapshotInstanceTemplateBySourceSnapshotInstanceByNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceTemplate) DeepCopy() *InstanceTemplatePrototypeInstanceTemplateBySourceTemplate {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplatePrototypeInstanceTemplateBySourceTemplate) Equal(other *InstanceTemplatePrototypeInstanceTemplateBySourceTemplate, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceTemplateReference) DeepCopy() *InstanceTemplateReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceTemplateReference) Equal(other *InstanceTemplateReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceVcpu) DeepCopy() *InstanceVcpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceVcpu) Equal(other *InstanceVcpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceVcpuBurst) DeepCopy() *InstanceVcpuBurst {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceVcpuBurst) Equal(other *InstanceVcpuBurst, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceVcpuPatch) DeepCopy() *InstanceVcpuPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceVcpuPatch) Equal(other *InstanceVcpuPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *InstanceVcpuPrototype) DeepCopy() *InstanceVcpuPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *InstanceVcpuPrototype) Equal(other *InstanceVcpuPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Key) DeepCopy() *Key {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Key) Equal(other *Key, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyCollection) DeepCopy() *KeyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyCollection) Equal(other *KeyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyIdentity) DeepCopy() *KeyIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyIdentity) Equal(other *KeyIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyIdentityByCRN) DeepCopy() *KeyIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyIdentityByCRN) Equal(other *KeyIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyIdentityByFingerprint) DeepCopy() *KeyIdentityByFingerprint {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyIdentityByFingerprint) Equal(other *KeyIdentityByFingerprint, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyIdentityByHref) DeepCopy() *KeyIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyIdentityByHref) Equal(other *KeyIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyIdentityByID) DeepCopy() *KeyIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyIdentityByID) Equal(other *KeyIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyPatch) DeepCopy() *KeyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyPatch) Equal(other *KeyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *KeyReference) DeepCopy() *KeyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *KeyReference) Equal(other *KeyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LegacyCloudObjectStorageBucketIdentity) DeepCopy() *LegacyCloudObjectStorageBucketIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LegacyCloudObjectStorageBucketIdentity) Equal(other *LegacyCloudObjectStorageBucketIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName) DeepCopy() *LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName) Equal(other *LegacyCloudObjectStorageBucketIdentityCloudObjectStorageBucketIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LegacyCloudObjectStorageBucketReference) DeepCopy() *LegacyCloudObjectStorageBucketReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LegacyCloudObjectStorageBucketReference) Equal(other *LegacyCloudObjectStorageBucketReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancer) DeepCopy() *LoadBalancer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancer) Equal(other *LoadBalancer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerCollection) DeepCopy() *LoadBalancerCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerCollection) Equal(other *LoadBalancerCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerDns) DeepCopy() *LoadBalancerDns {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerDns) Equal(other *LoadBalancerDns, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerDnsPatch) DeepCopy() *LoadBalancerDnsPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerDnsPatch) Equal(other *LoadBalancerDnsPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerDnsPrototype) DeepCopy() *LoadBalancerDnsPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerDnsPrototype) Equal(other *LoadBalancerDnsPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerIdentity) DeepCopy() *LoadBalancerIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerIdentity) Equal(other *LoadBalancerIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerIdentityByCRN) DeepCopy() *LoadBalancerIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerIdentityByCRN) Equal(other *LoadBalancerIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerIdentityByHref) DeepCopy() *LoadBalancerIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerIdentityByHref) Equal(other *LoadBalancerIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerIdentityByID) DeepCopy() *LoadBalancerIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerIdentityByID) Equal(other *LoadBalancerIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListener) DeepCopy() *LoadBalancerListener {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListener) Equal(other *LoadBalancerListener, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerClientAuthentication) DeepCopy() *LoadBalancerListenerClientAuthentication {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerClientAuthentication) Equal(other *LoadBalancerListenerClientAuthentication, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatch) DeepCopy() *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatch) Equal(other *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatchByCRN) DeepCopy() *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatchByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatchByCRN) Equal(other *LoadBalancerListenerClientAuthenticationCertificateAuthorityPatchByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerClientAuthenticationPatch) DeepCopy() *LoadBalancerListenerClientAuthenticationPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerClientAuthenticationPatch) Equal(other *LoadBalancerListenerClientAuthenticationPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerClientAuthenticationPrototype) DeepCopy() *LoadBalancerListenerClientAuthenticationPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerClientAuthenticationPrototype) Equal(other *LoadBalancerListenerClientAuthenticationPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerCollection) DeepCopy() *LoadBalancerListenerCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerCollection) Equal(other *LoadBalancerListenerCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerDefaultPoolPatch) DeepCopy() *LoadBalancerListenerDefaultPoolPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerDefaultPoolPatch) Equal(other *LoadBalancerListenerDefaultPoolPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByHref) DeepCopy() *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByHref) Equal(other *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByID) DeepCopy() *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByID) Equal(other *LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerHTTPSRedirect) DeepCopy() *LoadBalancerListenerHTTPSRedirect {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerHTTPSRedirect) Equal(other *LoadBalancerListenerHTTPSRedirect, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerHTTPSRedirectPatch) DeepCopy() *LoadBalancerListenerHTTPSRedirectPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerHTTPSRedirectPatch) Equal(other *LoadBalancerListenerHTTPSRedirectPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerHTTPSRedirectPrototype) DeepCopy() *LoadBalancerListenerHTTPSRedirectPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerHTTPSRedirectPrototype) Equal(other *LoadBalancerListenerHTTPSRedirectPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerIdentity) DeepCopy() *LoadBalancerListenerIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerIdentity) Equal(other *LoadBalancerListenerIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerIdentityByHref) DeepCopy() *LoadBalancerListenerIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerIdentityByHref) Equal(other *LoadBalancerListenerIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerIdentityByID) DeepCopy() *LoadBalancerListenerIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerIdentityByID) Equal(other *LoadBalancerListenerIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPatch) DeepCopy() *LoadBalancerListenerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPatch) Equal(other *LoadBalancerListenerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicy) DeepCopy() *LoadBalancerListenerPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicy) Equal(other *LoadBalancerListenerPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyCollection) DeepCopy() *LoadBalancerListenerPolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyCollection) Equal(other *LoadBalancerListenerPolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyPatch) DeepCopy() *LoadBalancerListenerPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyPatch) Equal(other *LoadBalancerListenerPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyPrototype) DeepCopy() *LoadBalancerListenerPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyPrototype) Equal(other *LoadBalancerListenerPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyReference) DeepCopy() *LoadBalancerListenerPolicyReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyReference) Equal(other *LoadBalancerListenerPolicyReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyRule) DeepCopy() *LoadBalancerListenerPolicyRule {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyRule) Equal(other *LoadBalancerListenerPolicyRule, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyRuleCollection) DeepCopy() *LoadBalancerListenerPolicyRuleCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyRuleCollection) Equal(other *LoadBalancerListenerPolicyRuleCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyRulePatch) DeepCopy() *LoadBalancerListenerPolicyRulePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyRulePatch) Equal(other *LoadBalancerListenerPolicyRulePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyRulePrototype) DeepCopy() *LoadBalancerListenerPolicyRulePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyRulePrototype) Equal(other *LoadBalancerListenerPolicyRulePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyRuleReference) DeepCopy() *LoadBalancerListenerPolicyRuleReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyRuleReference) Equal(other *LoadBalancerListenerPolicyRuleReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTarget) DeepCopy() *LoadBalancerListenerPolicyTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTarget) Equal(other *LoadBalancerListenerPolicyTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyHTTPSRedirect) DeepCopy() *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyHTTPSRedirect {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyHTTPSRedirect) Equal(other *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyHTTPSRedirect, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL) DeepCopy() *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL) Equal(other *LoadBalancerListenerPolicyTargetLoadBalancerListenerPolicyRedirectURL, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerReference) DeepCopy() *LoadBalancerListenerPolicyTargetLoadBalancerListenerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerListenerReference) Equal(other *LoadBalancerListenerPolicyTargetLoadBalancerListenerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerPoolReference) DeepCopy() *LoadBalancerListenerPolicyTargetLoadBalancerPoolReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetLoadBalancerPoolReference) Equal(other *LoadBalancerListenerPolicyTargetLoadBalancerPoolReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatch) DeepCopy() *LoadBalancerListenerPolicyTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatch) Equal(other *LoadBalancerListenerPolicyTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentity) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentity) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyHTTPSRedirectPatch) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyHTTPSRedirectPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyHTTPSRedirectPatch) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyHTTPSRedirectPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyRedirectURLPatch) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyRedirectURLPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyRedirectURLPatch) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerListenerPolicyRedirectURLPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentity) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentity) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) DeepCopy() *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) Equal(other *LoadBalancerListenerPolicyTargetPatchLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototype) DeepCopy() *LoadBalancerListenerPolicyTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototype) Equal(other *LoadBalancerListenerPolicyTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentity) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentity) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerIdentityLoadBalancerListenerIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyHTTPSRedirectPrototype) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyHTTPSRedirectPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyHTTPSRedirectPrototype) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyHTTPSRedirectPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyRedirectURLPrototype) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyRedirectURLPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyRedirectURLPrototype) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerListenerPolicyRedirectURLPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentity) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentity) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) DeepCopy() *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) Equal(other *LoadBalancerListenerPolicyTargetPrototypeLoadBalancerPoolIdentityLoadBalancerPoolIdentityLoadBalancerPoolIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerPrototypeLoadBalancerContext) DeepCopy() *LoadBalancerListenerPrototypeLoadBalancerContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerPrototypeLoadBalancerContext) Equal(other *LoadBalancerListenerPrototypeLoadBalancerContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerListenerReference) DeepCopy() *LoadBalancerListenerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerListenerReference) Equal(other *LoadBalancerListenerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLogging) DeepCopy() *LoadBalancerLogging {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLogging) Equal(other *LoadBalancerLogging, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLoggingDatapath) DeepCopy() *LoadBalancerLoggingDatapath {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLoggingDatapath) Equal(other *LoadBalancerLoggingDatapath, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLoggingDatapathPatch) DeepCopy() *LoadBalancerLoggingDatapathPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLoggingDatapathPatch) Equal(other *LoadBalancerLoggingDatapathPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLoggingDatapathPrototype) DeepCopy() *LoadBalancerLoggingDatapathPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLoggingDatapathPrototype) Equal(other *LoadBalancerLoggingDatapathPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLoggingPatch) DeepCopy() *LoadBalancerLoggingPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLoggingPatch) Equal(other *LoadBalancerLoggingPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerLoggingPrototype) DeepCopy() *LoadBalancerLoggingPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerLoggingPrototype) Equal(other *LoadBalancerLoggingPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPatch) DeepCopy() *LoadBalancerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPatch) Equal(other *LoadBalancerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPool) DeepCopy() *LoadBalancerPool {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPool) Equal(other *LoadBalancerPool, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolClientAuthentication) DeepCopy() *LoadBalancerPoolClientAuthentication {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolClientAuthentication) Equal(other *LoadBalancerPoolClientAuthentication, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolClientAuthenticationPatch) DeepCopy() *LoadBalancerPoolClientAuthenticationPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolClientAuthenticationPatch) Equal(other *LoadBalancerPoolClientAuthenticationPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolClientAuthenticationPrototype) DeepCopy() *LoadBalancerPoolClientAuthenticationPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolClientAuthenticationPrototype) Equal(other *LoadBalancerPoolClientAuthenticationPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolCollection) DeepCopy() *LoadBalancerPoolCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolCollection) Equal(other *LoadBalancerPoolCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicy) DeepCopy() *LoadBalancerPoolFailsafePolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicy) Equal(other *LoadBalancerPoolFailsafePolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicyPatch) DeepCopy() *LoadBalancerPoolFailsafePolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicyPatch) Equal(other *LoadBalancerPoolFailsafePolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicyPrototype) DeepCopy() *LoadBalancerPoolFailsafePolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicyPrototype) Equal(other *LoadBalancerPoolFailsafePolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicyTargetPatch) DeepCopy() *LoadBalancerPoolFailsafePolicyTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicyTargetPatch) Equal(other *LoadBalancerPoolFailsafePolicyTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByHref) DeepCopy() *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByHref) Equal(other *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByID) DeepCopy() *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByID) Equal(other *LoadBalancerPoolFailsafePolicyTargetPatchLoadBalancerPoolIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitor) DeepCopy() *LoadBalancerPoolHealthMonitor {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitor) Equal(other *LoadBalancerPoolHealthMonitor, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorPatch) DeepCopy() *LoadBalancerPoolHealthMonitorPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorPatch) Equal(other *LoadBalancerPoolHealthMonitorPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorPrototype) Equal(other *LoadBalancerPoolHealthMonitorPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsPrototype) Equal(other *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeTCPPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeTCPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeTCPPrototype) Equal(other *LoadBalancerPoolHealthMonitorPrototypeLoadBalancerPoolHealthMonitorTypeTCPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttps) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttps {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttps) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttps, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequest) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequest {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequest) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequest, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeader) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeader {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeader) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeader, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeaderPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeaderPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeaderPrototype) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestHeaderPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPatch) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPatch) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototype) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestGetPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestGetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestGetPrototype) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestGetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestPostPrototype) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestPostPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestPostPrototype) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsRequestPrototypeLoadBalancerPoolHealthMonitorTypeHttphttpsRequestPostPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponse) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsResponse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponse) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsResponse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePatch) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePatch) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePrototype) DeepCopy() *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePrototype) Equal(other *LoadBalancerPoolHealthMonitorTypeHttphttpsResponsePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolHealthMonitorTypeTCP) DeepCopy() *LoadBalancerPoolHealthMonitorTypeTCP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolHealthMonitorTypeTCP) Equal(other *LoadBalancerPoolHealthMonitorTypeTCP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolIdentity) DeepCopy() *LoadBalancerPoolIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolIdentity) Equal(other *LoadBalancerPoolIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolIdentityByName) DeepCopy() *LoadBalancerPoolIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolIdentityByName) Equal(other *LoadBalancerPoolIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) DeepCopy() *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref) Equal(other *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) DeepCopy() *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID) Equal(other *LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMember) DeepCopy() *LoadBalancerPoolMember {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMember) Equal(other *LoadBalancerPoolMember, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberCollection) DeepCopy() *LoadBalancerPoolMemberCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberCollection) Equal(other *LoadBalancerPoolMemberCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberPatch) DeepCopy() *LoadBalancerPoolMemberPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberPatch) Equal(other *LoadBalancerPoolMemberPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberPrototype) DeepCopy() *LoadBalancerPoolMemberPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberPrototype) Equal(other *LoadBalancerPoolMemberPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberReference) DeepCopy() *LoadBalancerPoolMemberReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberReference) Equal(other *LoadBalancerPoolMemberReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTarget) DeepCopy() *LoadBalancerPoolMemberTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTarget) Equal(other *LoadBalancerPoolMemberTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetByReservedIP) DeepCopy() *LoadBalancerPoolMemberTargetByReservedIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetByReservedIP) Equal(other *LoadBalancerPoolMemberTargetByReservedIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetFqdn) DeepCopy() *LoadBalancerPoolMemberTargetFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetFqdn) Equal(other *LoadBalancerPoolMemberTargetFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetIPNotReservedIP) DeepCopy() *LoadBalancerPoolMemberTargetIPNotReservedIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetIPNotReservedIP) Equal(other *LoadBalancerPoolMemberTargetIPNotReservedIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetInstanceReference) DeepCopy() *LoadBalancerPoolMemberTargetInstanceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetInstanceReference) Equal(other *LoadBalancerPoolMemberTargetInstanceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetLoadBalancerReference) DeepCopy() *LoadBalancerPoolMemberTargetLoadBalancerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetLoadBalancerReference) Equal(other *LoadBalancerPoolMemberTargetLoadBalancerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototype) DeepCopy() *LoadBalancerPoolMemberTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototype) Equal(other *LoadBalancerPoolMemberTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeFqdn) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeFqdn) Equal(other *LoadBalancerPoolMemberTargetPrototypeFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeIP) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeIP) Equal(other *LoadBalancerPoolMemberTargetPrototypeIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentity) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeInstanceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentity) Equal(other *LoadBalancerPoolMemberTargetPrototypeInstanceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByCRN) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByCRN) Equal(other *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByHref) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByHref) Equal(other *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID) Equal(other *LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentity) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentity) Equal(other *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByCRN) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByCRN) Equal(other *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByHref) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByHref) Equal(other *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByID) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByID) Equal(other *LoadBalancerPoolMemberTargetPrototypeLoadBalancerIdentityLoadBalancerIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentity) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentity) Equal(other *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByHref) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByHref) Equal(other *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByID) DeepCopy() *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByID) Equal(other *LoadBalancerPoolMemberTargetPrototypeReservedIPIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolPatch) DeepCopy() *LoadBalancerPoolPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolPatch) Equal(other *LoadBalancerPoolPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolPrototypeLoadBalancerContext) DeepCopy() *LoadBalancerPoolPrototypeLoadBalancerContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolPrototypeLoadBalancerContext) Equal(other *LoadBalancerPoolPrototypeLoadBalancerContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolReference) DeepCopy() *LoadBalancerPoolReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolReference) Equal(other *LoadBalancerPoolReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolServerAuthentication) DeepCopy() *LoadBalancerPoolServerAuthentication {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolServerAuthentication) Equal(other *LoadBalancerPoolServerAuthentication, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolServerAuthenticationPatch) DeepCopy() *LoadBalancerPoolServerAuthenticationPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolServerAuthenticationPatch) Equal(other *LoadBalancerPoolServerAuthenticationPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolServerAuthenticationPrototype) DeepCopy() *LoadBalancerPoolServerAuthenticationPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolServerAuthenticationPrototype) Equal(other *LoadBalancerPoolServerAuthenticationPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolSessionPersistence) DeepCopy() *LoadBalancerPoolSessionPersistence {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolSessionPersistence) Equal(other *LoadBalancerPoolSessionPersistence, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolSessionPersistencePatch) DeepCopy() *LoadBalancerPoolSessionPersistencePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolSessionPersistencePatch) Equal(other *LoadBalancerPoolSessionPersistencePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerPoolSessionPersistencePrototype) DeepCopy() *LoadBalancerPoolSessionPersistencePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerPoolSessionPersistencePrototype) Equal(other *LoadBalancerPoolSessionPersistencePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfile) DeepCopy() *LoadBalancerProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfile) Equal(other *LoadBalancerProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAccessModes) DeepCopy() *LoadBalancerProfileAccessModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAccessModes) Equal(other *LoadBalancerProfileAccessModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAdvancedHealthCheckSupported) DeepCopy() *LoadBalancerProfileAdvancedHealthCheckSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAdvancedHealthCheckSupported) Equal(other *LoadBalancerProfileAdvancedHealthCheckSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAdvancedHealthCheckSupportedDependent) DeepCopy() *LoadBalancerProfileAdvancedHealthCheckSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAdvancedHealthCheckSupportedDependent) Equal(other *LoadBalancerProfileAdvancedHealthCheckSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAdvancedHealthCheckSupportedFixed) DeepCopy() *LoadBalancerProfileAdvancedHealthCheckSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAdvancedHealthCheckSupportedFixed) Equal(other *LoadBalancerProfileAdvancedHealthCheckSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAvailability) DeepCopy() *LoadBalancerProfileAvailability {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAvailability) Equal(other *LoadBalancerProfileAvailability, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAvailabilityDependent) DeepCopy() *LoadBalancerProfileAvailabilityDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAvailabilityDependent) Equal(other *LoadBalancerProfileAvailabilityDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileAvailabilityFixed) DeepCopy() *LoadBalancerProfileAvailabilityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileAvailabilityFixed) Equal(other *LoadBalancerProfileAvailabilityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileCollection) DeepCopy() *LoadBalancerProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileCollection) Equal(other *LoadBalancerProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFailsafePolicyActions) DeepCopy() *LoadBalancerProfileFailsafePolicyActions {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFailsafePolicyActions) Equal(other *LoadBalancerProfileFailsafePolicyActions, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFailsafePolicyActionsDependent) DeepCopy() *LoadBalancerProfileFailsafePolicyActionsDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFailsafePolicyActionsDependent) Equal(other *LoadBalancerProfileFailsafePolicyActionsDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFailsafePolicyActionsEnum) DeepCopy() *LoadBalancerProfileFailsafePolicyActionsEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFailsafePolicyActionsEnum) Equal(other *LoadBalancerProfileFailsafePolicyActionsEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFqdnSupported) DeepCopy() *LoadBalancerProfileFqdnSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFqdnSupported) Equal(other *LoadBalancerProfileFqdnSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFqdnSupportedDependent) DeepCopy() *LoadBalancerProfileFqdnSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFqdnSupportedDependent) Equal(other *LoadBalancerProfileFqdnSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileFqdnSupportedFixed) DeepCopy() *LoadBalancerProfileFqdnSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileFqdnSupportedFixed) Equal(other *LoadBalancerProfileFqdnSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileIdentity) DeepCopy() *LoadBalancerProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileIdentity) Equal(other *LoadBalancerProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileIdentityByHref) DeepCopy() *LoadBalancerProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileIdentityByHref) Equal(other *LoadBalancerProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileIdentityByName) DeepCopy() *LoadBalancerProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileIdentityByName) Equal(other *LoadBalancerProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileInstanceGroupsSupported) DeepCopy() *LoadBalancerProfileInstanceGroupsSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileInstanceGroupsSupported) Equal(other *LoadBalancerProfileInstanceGroupsSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileInstanceGroupsSupportedDependent) DeepCopy() *LoadBalancerProfileInstanceGroupsSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileInstanceGroupsSupportedDependent) Equal(other *LoadBalancerProfileInstanceGroupsSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileInstanceGroupsSupportedFixed) DeepCopy() *LoadBalancerProfileInstanceGroupsSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileInstanceGroupsSupportedFixed) Equal(other *LoadBalancerProfileInstanceGroupsSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileLoggingSupported) DeepCopy() *LoadBalancerProfileLoggingSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileLoggingSupported) Equal(other *LoadBalancerProfileLoggingSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileMtlsSupported) DeepCopy() *LoadBalancerProfileMtlsSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileMtlsSupported) Equal(other *LoadBalancerProfileMtlsSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileMtlsSupportedDependent) DeepCopy() *LoadBalancerProfileMtlsSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileMtlsSupportedDependent) Equal(other *LoadBalancerProfileMtlsSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileMtlsSupportedFixed) DeepCopy() *LoadBalancerProfileMtlsSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileMtlsSupportedFixed) Equal(other *LoadBalancerProfileMtlsSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileReference) DeepCopy() *LoadBalancerProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileReference) Equal(other *LoadBalancerProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileRouteModeSupported) DeepCopy() *LoadBalancerProfileRouteModeSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileRouteModeSupported) Equal(other *LoadBalancerProfileRouteModeSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileRouteModeSupportedDependent) DeepCopy() *LoadBalancerProfileRouteModeSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileRouteModeSupportedDependent) Equal(other *LoadBalancerProfileRouteModeSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileRouteModeSupportedFixed) DeepCopy() *LoadBalancerProfileRouteModeSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileRouteModeSupportedFixed) Equal(other *LoadBalancerProfileRouteModeSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSecurityGroupsSupported) DeepCopy() *LoadBalancerProfileSecurityGroupsSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSecurityGroupsSupported) Equal(other *LoadBalancerProfileSecurityGroupsSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSecurityGroupsSupportedDependent) DeepCopy() *LoadBalancerProfileSecurityGroupsSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSecurityGroupsSupportedDependent) Equal(other *LoadBalancerProfileSecurityGroupsSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSecurityGroupsSupportedFixed) DeepCopy() *LoadBalancerProfileSecurityGroupsSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSecurityGroupsSupportedFixed) Equal(other *LoadBalancerProfileSecurityGroupsSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupported) DeepCopy() *LoadBalancerProfileSourceIPSessionPersistenceSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupported) Equal(other *LoadBalancerProfileSourceIPSessionPersistenceSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupportedDependent) DeepCopy() *LoadBalancerProfileSourceIPSessionPersistenceSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupportedDependent) Equal(other *LoadBalancerProfileSourceIPSessionPersistenceSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupportedFixed) DeepCopy() *LoadBalancerProfileSourceIPSessionPersistenceSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileSourceIPSessionPersistenceSupportedFixed) Equal(other *LoadBalancerProfileSourceIPSessionPersistenceSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileTargetableResourceTypes) DeepCopy() *LoadBalancerProfileTargetableResourceTypes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileTargetableResourceTypes) Equal(other *LoadBalancerProfileTargetableResourceTypes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileUDPSupported) DeepCopy() *LoadBalancerProfileUDPSupported {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileUDPSupported) Equal(other *LoadBalancerProfileUDPSupported, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileUDPSupportedDependent) DeepCopy() *LoadBalancerProfileUDPSupportedDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileUDPSupportedDependent) Equal(other *LoadBalancerProfileUDPSupportedDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerProfileUDPSupportedFixed) DeepCopy() *LoadBalancerProfileUDPSupportedFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerProfileUDPSupportedFixed) Equal(other *LoadBalancerProfileUDPSupportedFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerReference) DeepCopy() *LoadBalancerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerReference) Equal(other *LoadBalancerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *LoadBalancerStatistics) DeepCopy() *LoadBalancerStatistics {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *LoadBalancerStatistics) Equal(other *LoadBalancerStatistics, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACL) DeepCopy() *NetworkACL {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACL) Equal(other *NetworkACL, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLCollection) DeepCopy() *NetworkACLCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLCollection) Equal(other *NetworkACLCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLIdentity) DeepCopy() *NetworkACLIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLIdentity) Equal(other *NetworkACLIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLIdentityByCRN) DeepCopy() *NetworkACLIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLIdentityByCRN) Equal(other *NetworkACLIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLIdentityByHref) DeepCopy() *NetworkACLIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLIdentityByHref) Equal(other *NetworkACLIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLIdentityByID) DeepCopy() *NetworkACLIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLIdentityByID) Equal(other *NetworkACLIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLPatch) DeepCopy() *NetworkACLPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLPatch) Equal(other *NetworkACLPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLPrototype) DeepCopy() *NetworkACLPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLPrototype) Equal(other *NetworkACLPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLPrototypeNetworkACLByRules) DeepCopy() *NetworkACLPrototypeNetworkACLByRules {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLPrototypeNetworkACLByRules) Equal(other *NetworkACLPrototypeNetworkACLByRules, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLPrototypeNetworkACLBySourceNetworkACL) DeepCopy() *NetworkACLPrototypeNetworkACLBySourceNetworkACL {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLPrototypeNetworkACLBySourceNetworkACL) Equal(other *NetworkACLPrototypeNetworkACLBySourceNetworkACL, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLReference) DeepCopy() *NetworkACLReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLReference) Equal(other *NetworkACLReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRule) Equal(other *NetworkACLRule, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePatch) DeepCopy() *NetworkACLRuleBeforePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePatch) Equal(other *NetworkACLRuleBeforePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByHref) DeepCopy() *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByHref) Equal(other *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID) DeepCopy() *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID) Equal(other *NetworkACLRuleBeforePatchNetworkACLRuleIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePrototype) DeepCopy() *NetworkACLRuleBeforePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePrototype) Equal(other *NetworkACLRuleBeforePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByHref) DeepCopy() *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByHref) Equal(other *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByID) DeepCopy() *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByID) Equal(other *NetworkACLRuleBeforePrototypeNetworkACLRuleIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleCollection) DeepCopy() *NetworkACLRuleCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleCollection) Equal(other *NetworkACLRuleCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItem) DeepCopy() *NetworkACLRuleItem {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItem) Equal(other *NetworkACLRuleItem, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolAny) DeepCopy() *NetworkACLRuleItemNetworkACLRuleProtocolAny {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolAny) Equal(other *NetworkACLRuleItemNetworkACLRuleProtocolAny, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIcmp) DeepCopy() *NetworkACLRuleItemNetworkACLRuleProtocolIcmp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIcmp) Equal(other *NetworkACLRuleItemNetworkACLRuleProtocolIcmp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp) DeepCopy() *NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp) Equal(other *NetworkACLRuleItemNetworkACLRuleProtocolIcmptcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIndividual) DeepCopy() *NetworkACLRuleItemNetworkACLRuleProtocolIndividual {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolIndividual) Equal(other *NetworkACLRuleItemNetworkACLRuleProtocolIndividual, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolTcpudp) DeepCopy() *NetworkACLRuleItemNetworkACLRuleProtocolTcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleItemNetworkACLRuleProtocolTcpudp) Equal(other *NetworkACLRuleItemNetworkACLRuleProtocolTcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleNetworkACLRuleProtocolAny) DeepCopy() *NetworkACLRuleNetworkACLRuleProtocolAny {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleNetworkACLRuleProtocolAny) Equal(other *NetworkACLRuleNetworkACLRuleProtocolAny, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleNetworkACLRuleProtocolIcmp) DeepCopy() *NetworkACLRuleNetworkACLRuleProtocolIcmp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleNetworkACLRuleProtocolIcmp) Equal(other *NetworkACLRuleNetworkACLRuleProtocolIcmp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleNetworkACLRuleProtocolIcmptcpudp) DeepCopy() *NetworkACLRuleNetworkACLRuleProtocolIcmptcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleNetworkACLRuleProtocolIcmptcpudp) Equal(other *NetworkACLRuleNetworkACLRuleProtocolIcmptcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleNetworkACLRuleProtocolIndividual) DeepCopy() *NetworkACLRuleNetworkACLRuleProtocolIndividual {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleNetworkACLRuleProtocolIndividual) Equal(other *NetworkACLRuleNetworkACLRuleProtocolIndividual, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleNetworkACLRuleProtocolTcpudp) DeepCopy() *NetworkACLRuleNetworkACLRuleProtocolTcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleNetworkACLRuleProtocolTcpudp) Equal(other *NetworkACLRuleNetworkACLRuleProtocolTcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePatch) DeepCopy() *NetworkACLRulePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePatch) Equal(other *NetworkACLRulePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototype) DeepCopy() *NetworkACLRulePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototype) Equal(other *NetworkACLRulePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContext) DeepCopy() *NetworkACLRulePrototypeNetworkACLContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContext) Equal(other *NetworkACLRulePrototypeNetworkACLContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolAnyPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolAnyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolAnyPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolAnyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmptcpudpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmptcpudpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmptcpudpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIcmptcpudpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIndividualPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIndividualPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIndividualPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolIndividualPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolTcpudpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolTcpudpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolTcpudpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLContextNetworkACLRuleProtocolTcpudpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolAnyPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLRuleProtocolAnyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolAnyPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLRuleProtocolAnyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmptcpudpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmptcpudpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmptcpudpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLRuleProtocolIcmptcpudpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIndividualPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLRuleProtocolIndividualPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolIndividualPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLRuleProtocolIndividualPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolTcpudpPrototype) DeepCopy() *NetworkACLRulePrototypeNetworkACLRuleProtocolTcpudpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRulePrototypeNetworkACLRuleProtocolTcpudpPrototype) Equal(other *NetworkACLRulePrototypeNetworkACLRuleProtocolTcpudpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkACLRuleReference) DeepCopy() *NetworkACLRuleReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkACLRuleReference) Equal(other *NetworkACLRuleReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterface) DeepCopy() *NetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterface) Equal(other *NetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceBareMetalServerContextReference) DeepCopy() *NetworkInterfaceBareMetalServerContextReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceBareMetalServerContextReference) Equal(other *NetworkInterfaceBareMetalServerContextReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceIPPrototype) DeepCopy() *NetworkInterfaceIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceIPPrototype) Equal(other *NetworkInterfaceIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentity) DeepCopy() *NetworkInterfaceIPPrototypeReservedIPIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentity) Equal(other *NetworkInterfaceIPPrototypeReservedIPIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentityByHref) DeepCopy() *NetworkInterfaceIPPrototypeReservedIPIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentityByHref) Equal(other *NetworkInterfaceIPPrototypeReservedIPIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentityByID) DeepCopy() *NetworkInterfaceIPPrototypeReservedIPIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceIPPrototypeReservedIPIdentityByID) Equal(other *NetworkInterfaceIPPrototypeReservedIPIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext) DeepCopy() *NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext) Equal(other *NetworkInterfaceIPPrototypeReservedIPPrototypeNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceInstanceContextReference) DeepCopy() *NetworkInterfaceInstanceContextReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceInstanceContextReference) Equal(other *NetworkInterfaceInstanceContextReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfacePatch) DeepCopy() *NetworkInterfacePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfacePatch) Equal(other *NetworkInterfacePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfacePrototype) DeepCopy() *NetworkInterfacePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfacePrototype) Equal(other *NetworkInterfacePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *NetworkInterfaceUnpaginatedCollection) DeepCopy() *NetworkInterfaceUnpaginatedCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *NetworkInterfaceUnpaginatedCollection) Equal(other *NetworkInterfaceUnpaginatedCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *OperatingSystem) DeepCopy() *OperatingSystem {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *OperatingSystem) Equal(other *OperatingSystem, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *OperatingSystemCollection) DeepCopy() *OperatingSystemCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *OperatingSystemCollection) Equal(other *OperatingSystemCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *OperatingSystemIdentity) DeepCopy() *OperatingSystemIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *OperatingSystemIdentity) Equal(other *OperatingSystemIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *OperatingSystemIdentityByHref) DeepCopy() *OperatingSystemIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *OperatingSystemIdentityByHref) Equal(other *OperatingSystemIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *OperatingSystemIdentityByName) DeepCopy() *OperatingSystemIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *OperatingSystemIdentityByName) Equal(other *OperatingSystemIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PageLink) DeepCopy() *PageLink {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PageLink) Equal(other *PageLink, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PlacementGroup) DeepCopy() *PlacementGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PlacementGroup) Equal(other *PlacementGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PlacementGroupCollection) DeepCopy() *PlacementGroupCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PlacementGroupCollection) Equal(other *PlacementGroupCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PlacementGroupPatch) DeepCopy() *PlacementGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PlacementGroupPatch) Equal(other *PlacementGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGateway) DeepCopy() *PrivatePathServiceGateway {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGateway) Equal(other *PrivatePathServiceGateway, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayAccountPolicy) DeepCopy() *PrivatePathServiceGatewayAccountPolicy {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayAccountPolicy) Equal(other *PrivatePathServiceGatewayAccountPolicy, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayAccountPolicyCollection) DeepCopy() *PrivatePathServiceGatewayAccountPolicyCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayAccountPolicyCollection) Equal(other *PrivatePathServiceGatewayAccountPolicyCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayAccountPolicyPatch) DeepCopy() *PrivatePathServiceGatewayAccountPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayAccountPolicyPatch) Equal(other *PrivatePathServiceGatewayAccountPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayCollection) DeepCopy() *PrivatePathServiceGatewayCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayCollection) Equal(other *PrivatePathServiceGatewayCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayEndpointGatewayBinding) DeepCopy() *PrivatePathServiceGatewayEndpointGatewayBinding {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayEndpointGatewayBinding) Equal(other *PrivatePathServiceGatewayEndpointGatewayBinding, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayEndpointGatewayBindingCollection) DeepCopy() *PrivatePathServiceGatewayEndpointGatewayBindingCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayEndpointGatewayBindingCollection) Equal(other *PrivatePathServiceGatewayEndpointGatewayBindingCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayPatch) DeepCopy() *PrivatePathServiceGatewayPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayPatch) Equal(other *PrivatePathServiceGatewayPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PrivatePathServiceGatewayRemote) DeepCopy() *PrivatePathServiceGatewayRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PrivatePathServiceGatewayRemote) Equal(other *PrivatePathServiceGatewayRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRange) DeepCopy() *PublicAddressRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRange) Equal(other *PublicAddressRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangeCollection) DeepCopy() *PublicAddressRangeCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangeCollection) Equal(other *PublicAddressRangeCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangePatch) DeepCopy() *PublicAddressRangePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangePatch) Equal(other *PublicAddressRangePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangeReference) DeepCopy() *PublicAddressRangeReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangeReference) Equal(other *PublicAddressRangeReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangeTarget) DeepCopy() *PublicAddressRangeTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangeTarget) Equal(other *PublicAddressRangeTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangeTargetPatch) DeepCopy() *PublicAddressRangeTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangeTargetPatch) Equal(other *PublicAddressRangeTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicAddressRangeTargetPrototype) DeepCopy() *PublicAddressRangeTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicAddressRangeTargetPrototype) Equal(other *PublicAddressRangeTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGateway) DeepCopy() *PublicGateway {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGateway) Equal(other *PublicGateway, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayCollection) DeepCopy() *PublicGatewayCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayCollection) Equal(other *PublicGatewayCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIP) DeepCopy() *PublicGatewayFloatingIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIP) Equal(other *PublicGatewayFloatingIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototype) DeepCopy() *PublicGatewayFloatingIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototype) Equal(other *PublicGatewayFloatingIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentity) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentity) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByAddress) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByAddress) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByCRN) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByCRN) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByHref) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByHref) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByID) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByID) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPIdentityFloatingIPIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPPrototypeTargetContext) DeepCopy() *PublicGatewayFloatingIPPrototypeFloatingIPPrototypeTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayFloatingIPPrototypeFloatingIPPrototypeTargetContext) Equal(other *PublicGatewayFloatingIPPrototypeFloatingIPPrototypeTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayIdentity) DeepCopy() *PublicGatewayIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayIdentity) Equal(other *PublicGatewayIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayIdentityPublicGatewayIdentityByCRN) DeepCopy() *PublicGatewayIdentityPublicGatewayIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayIdentityPublicGatewayIdentityByCRN) Equal(other *PublicGatewayIdentityPublicGatewayIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayIdentityPublicGatewayIdentityByHref) DeepCopy() *PublicGatewayIdentityPublicGatewayIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayIdentityPublicGatewayIdentityByHref) Equal(other *PublicGatewayIdentityPublicGatewayIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayIdentityPublicGatewayIdentityByID) DeepCopy() *PublicGatewayIdentityPublicGatewayIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayIdentityPublicGatewayIdentityByID) Equal(other *PublicGatewayIdentityPublicGatewayIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayPatch) DeepCopy() *PublicGatewayPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayPatch) Equal(other *PublicGatewayPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *PublicGatewayReference) DeepCopy() *PublicGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *PublicGatewayReference) Equal(other *PublicGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Region) DeepCopy() *Region {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Region) Equal(other *Region, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RegionCollection) DeepCopy() *RegionCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RegionCollection) Equal(other *RegionCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RegionIdentity) DeepCopy() *RegionIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RegionIdentity) Equal(other *RegionIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RegionIdentityByHref) DeepCopy() *RegionIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RegionIdentityByHref) Equal(other *RegionIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RegionIdentityByName) DeepCopy() *RegionIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RegionIdentityByName) Equal(other *RegionIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RegionReference) DeepCopy() *RegionReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RegionReference) Equal(other *RegionReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Reservation) DeepCopy() *Reservation {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Reservation) Equal(other *Reservation, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCapacity) DeepCopy() *ReservationCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCapacity) Equal(other *ReservationCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCapacityPatch) DeepCopy() *ReservationCapacityPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCapacityPatch) Equal(other *ReservationCapacityPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCapacityPrototype) DeepCopy() *ReservationCapacityPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCapacityPrototype) Equal(other *ReservationCapacityPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCollection) DeepCopy() *ReservationCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCollection) Equal(other *ReservationCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCommittedUse) DeepCopy() *ReservationCommittedUse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCommittedUse) Equal(other *ReservationCommittedUse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCommittedUsePatch) DeepCopy() *ReservationCommittedUsePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCommittedUsePatch) Equal(other *ReservationCommittedUsePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationCommittedUsePrototype) DeepCopy() *ReservationCommittedUsePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationCommittedUsePrototype) Equal(other *ReservationCommittedUsePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationIdentity) DeepCopy() *ReservationIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationIdentity) Equal(other *ReservationIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationIdentityByCRN) DeepCopy() *ReservationIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationIdentityByCRN) Equal(other *ReservationIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationIdentityByHref) DeepCopy() *ReservationIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationIdentityByHref) Equal(other *ReservationIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationIdentityByID) DeepCopy() *ReservationIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationIdentityByID) Equal(other *ReservationIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationPatch) DeepCopy() *ReservationPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationPatch) Equal(other *ReservationPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationProfile) DeepCopy() *ReservationProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationProfile) Equal(other *ReservationProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationProfileBareMetalServerProfileReference) DeepCopy() *ReservationProfileBareMetalServerProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationProfileBareMetalServerProfileReference) Equal(other *ReservationProfileBareMetalServerProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationProfileInstanceProfileReference) DeepCopy() *ReservationProfileInstanceProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationProfileInstanceProfileReference) Equal(other *ReservationProfileInstanceProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationProfilePatch) DeepCopy() *ReservationProfilePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationProfilePatch) Equal(other *ReservationProfilePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationProfilePrototype) DeepCopy() *ReservationProfilePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationProfilePrototype) Equal(other *ReservationProfilePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationReference) DeepCopy() *ReservationReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationReference) Equal(other *ReservationReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservationStatusReason) DeepCopy() *ReservationStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservationStatusReason) Equal(other *ReservationStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIP) DeepCopy() *ReservedIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIP) Equal(other *ReservedIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPCollection) DeepCopy() *ReservedIPCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPCollection) Equal(other *ReservedIPCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPCollectionBareMetalServerNetworkInterfaceContext) DeepCopy() *ReservedIPCollectionBareMetalServerNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPCollectionBareMetalServerNetworkInterfaceContext) Equal(other *ReservedIPCollectionBareMetalServerNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPCollectionEndpointGatewayContext) DeepCopy() *ReservedIPCollectionEndpointGatewayContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPCollectionEndpointGatewayContext) Equal(other *ReservedIPCollectionEndpointGatewayContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPCollectionInstanceNetworkInterfaceContext) DeepCopy() *ReservedIPCollectionInstanceNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPCollectionInstanceNetworkInterfaceContext) Equal(other *ReservedIPCollectionInstanceNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPCollectionVirtualNetworkInterfaceContext) DeepCopy() *ReservedIPCollectionVirtualNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPCollectionVirtualNetworkInterfaceContext) Equal(other *ReservedIPCollectionVirtualNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPPatch) DeepCopy() *ReservedIPPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPPatch) Equal(other *ReservedIPPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPReference) DeepCopy() *ReservedIPReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPReference) Equal(other *ReservedIPReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTarget) DeepCopy() *ReservedIPTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTarget) Equal(other *ReservedIPTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetBareMetalServerNetworkInterfaceReferenceTargetContext) DeepCopy() *ReservedIPTargetBareMetalServerNetworkInterfaceReferenceTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetBareMetalServerNetworkInterfaceReferenceTargetContext) Equal(other *ReservedIPTargetBareMetalServerNetworkInterfaceReferenceTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetEndpointGatewayReference) DeepCopy() *ReservedIPTargetEndpointGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetEndpointGatewayReference) Equal(other *ReservedIPTargetEndpointGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetGenericResourceReference) DeepCopy() *ReservedIPTargetGenericResourceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetGenericResourceReference) Equal(other *ReservedIPTargetGenericResourceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetLoadBalancerReference) DeepCopy() *ReservedIPTargetLoadBalancerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetLoadBalancerReference) Equal(other *ReservedIPTargetLoadBalancerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetNetworkInterfaceReferenceTargetContext) DeepCopy() *ReservedIPTargetNetworkInterfaceReferenceTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetNetworkInterfaceReferenceTargetContext) Equal(other *ReservedIPTargetNetworkInterfaceReferenceTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototype) DeepCopy() *ReservedIPTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototype) Equal(other *ReservedIPTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentity) DeepCopy() *ReservedIPTargetPrototypeEndpointGatewayIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentity) Equal(other *ReservedIPTargetPrototypeEndpointGatewayIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByCRN) DeepCopy() *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByCRN) Equal(other *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByHref) DeepCopy() *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByHref) Equal(other *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByID) DeepCopy() *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByID) Equal(other *ReservedIPTargetPrototypeEndpointGatewayIdentityEndpointGatewayIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentity) DeepCopy() *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentity) Equal(other *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *ReservedIPTargetPrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetVPNGatewayReference) DeepCopy() *ReservedIPTargetVPNGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetVPNGatewayReference) Equal(other *ReservedIPTargetVPNGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetVPNServerReference) DeepCopy() *ReservedIPTargetVPNServerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetVPNServerReference) Equal(other *ReservedIPTargetVPNServerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ReservedIPTargetVirtualNetworkInterfaceReferenceReservedIPTargetContext) DeepCopy() *ReservedIPTargetVirtualNetworkInterfaceReferenceReservedIPTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ReservedIPTargetVirtualNetworkInterfaceReferenceReservedIPTargetContext) Equal(other *ReservedIPTargetVirtualNetworkInterfaceReferenceReservedIPTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ResourceFilter) DeepCopy() *ResourceFilter {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ResourceFilter) Equal(other *ResourceFilter, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ResourceGroupIdentity) DeepCopy() *ResourceGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ResourceGroupIdentity) Equal(other *ResourceGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ResourceGroupIdentityByID) DeepCopy() *ResourceGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ResourceGroupIdentityByID) Equal(other *ResourceGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ResourceGroupReference) DeepCopy() *ResourceGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ResourceGroupReference) Equal(other *ResourceGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Route) DeepCopy() *Route {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Route) Equal(other *Route, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteCollection) DeepCopy() *RouteCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteCollection) Equal(other *RouteCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteCollectionVPCContext) DeepCopy() *RouteCollectionVPCContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteCollectionVPCContext) Equal(other *RouteCollectionVPCContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteCreator) DeepCopy() *RouteCreator {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteCreator) Equal(other *RouteCreator, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteCreatorVPNGatewayReference) DeepCopy() *RouteCreatorVPNGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteCreatorVPNGatewayReference) Equal(other *RouteCreatorVPNGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteCreatorVPNServerReference) DeepCopy() *RouteCreatorVPNServerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteCreatorVPNServerReference) Equal(other *RouteCreatorVPNServerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHop) DeepCopy() *RouteNextHop {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHop) Equal(other *RouteNextHop, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopIP) DeepCopy() *RouteNextHopIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopIP) Equal(other *RouteNextHopIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatch) DeepCopy() *RouteNextHopPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatch) Equal(other *RouteNextHopPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchRouteNextHopIP) DeepCopy() *RouteNextHopPatchRouteNextHopIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchRouteNextHopIP) Equal(other *RouteNextHopPatchRouteNextHopIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchRouteNextHopIPRouteNextHopIPSentinelIP) DeepCopy() *RouteNextHopPatchRouteNextHopIPRouteNextHopIPSentinelIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchRouteNextHopIPRouteNextHopIPSentinelIP) Equal(other *RouteNextHopPatchRouteNextHopIPRouteNextHopIPSentinelIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchRouteNextHopIPRouteNextHopIPUnicastIP) DeepCopy() *RouteNextHopPatchRouteNextHopIPRouteNextHopIPUnicastIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchRouteNextHopIPRouteNextHopIPUnicastIP) Equal(other *RouteNextHopPatchRouteNextHopIPRouteNextHopIPUnicastIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentity) DeepCopy() *RouteNextHopPatchVPNGatewayConnectionIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentity) Equal(other *RouteNextHopPatchVPNGatewayConnectionIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref) DeepCopy() *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref) Equal(other *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID) DeepCopy() *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID) Equal(other *RouteNextHopPatchVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototype) DeepCopy() *RouteNextHopPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototype) Equal(other *RouteNextHopPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeRouteNextHopIP) DeepCopy() *RouteNextHopPrototypeRouteNextHopIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeRouteNextHopIP) Equal(other *RouteNextHopPrototypeRouteNextHopIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPSentinelIP) DeepCopy() *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPSentinelIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPSentinelIP) Equal(other *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPSentinelIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPUnicastIP) DeepCopy() *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPUnicastIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPUnicastIP) Equal(other *RouteNextHopPrototypeRouteNextHopIPRouteNextHopIPUnicastIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentity) DeepCopy() *RouteNextHopPrototypeVPNGatewayConnectionIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentity) Equal(other *RouteNextHopPrototypeVPNGatewayConnectionIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref) DeepCopy() *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref) Equal(other *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID) DeepCopy() *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID) Equal(other *RouteNextHopPrototypeVPNGatewayConnectionIdentityVPNGatewayConnectionIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteNextHopVPNGatewayConnectionReference) DeepCopy() *RouteNextHopVPNGatewayConnectionReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteNextHopVPNGatewayConnectionReference) Equal(other *RouteNextHopVPNGatewayConnectionReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutePatch) DeepCopy() *RoutePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutePatch) Equal(other *RoutePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutePrototype) DeepCopy() *RoutePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutePrototype) Equal(other *RoutePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RouteReference) DeepCopy() *RouteReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RouteReference) Equal(other *RouteReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTable) DeepCopy() *RoutingTable {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTable) Equal(other *RoutingTable, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableCollection) DeepCopy() *RoutingTableCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableCollection) Equal(other *RoutingTableCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableIdentity) DeepCopy() *RoutingTableIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableIdentity) Equal(other *RoutingTableIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableIdentityByCRN) DeepCopy() *RoutingTableIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableIdentityByCRN) Equal(other *RoutingTableIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableIdentityByHref) DeepCopy() *RoutingTableIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableIdentityByHref) Equal(other *RoutingTableIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableIdentityByID) DeepCopy() *RoutingTableIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableIdentityByID) Equal(other *RoutingTableIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTablePatch) DeepCopy() *RoutingTablePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTablePatch) Equal(other *RoutingTablePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *RoutingTableReference) DeepCopy() *RoutingTableReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *RoutingTableReference) Equal(other *RoutingTableReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroup) DeepCopy() *SecurityGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroup) Equal(other *SecurityGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupCollection) DeepCopy() *SecurityGroupCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupCollection) Equal(other *SecurityGroupCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupIdentity) DeepCopy() *SecurityGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupIdentity) Equal(other *SecurityGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupIdentityByCRN) DeepCopy() *SecurityGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupIdentityByCRN) Equal(other *SecurityGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupIdentityByHref) DeepCopy() *SecurityGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupIdentityByHref) Equal(other *SecurityGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupIdentityByID) DeepCopy() *SecurityGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupIdentityByID) Equal(other *SecurityGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupPatch) DeepCopy() *SecurityGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupPatch) Equal(other *SecurityGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupReference) DeepCopy() *SecurityGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupReference) Equal(other *SecurityGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRule) Equal(other *SecurityGroupRule, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleCollection) DeepCopy() *SecurityGroupRuleCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleCollection) Equal(other *SecurityGroupRuleCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocal) DeepCopy() *SecurityGroupRuleLocal {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocal) Equal(other *SecurityGroupRuleLocal, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPatch) DeepCopy() *SecurityGroupRuleLocalPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPatch) Equal(other *SecurityGroupRuleLocalPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPatchSecurityGroupRuleCIDRPrototype) DeepCopy() *SecurityGroupRuleLocalPatchSecurityGroupRuleCIDRPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPatchSecurityGroupRuleCIDRPrototype) Equal(other *SecurityGroupRuleLocalPatchSecurityGroupRuleCIDRPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPatchSecurityGroupRuleIPPrototype) DeepCopy() *SecurityGroupRuleLocalPatchSecurityGroupRuleIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPatchSecurityGroupRuleIPPrototype) Equal(other *SecurityGroupRuleLocalPatchSecurityGroupRuleIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPrototype) DeepCopy() *SecurityGroupRuleLocalPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPrototype) Equal(other *SecurityGroupRuleLocalPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPrototypeSecurityGroupRuleCIDRPrototype) DeepCopy() *SecurityGroupRuleLocalPrototypeSecurityGroupRuleCIDRPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPrototypeSecurityGroupRuleCIDRPrototype) Equal(other *SecurityGroupRuleLocalPrototypeSecurityGroupRuleCIDRPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalPrototypeSecurityGroupRuleIPPrototype) DeepCopy() *SecurityGroupRuleLocalPrototypeSecurityGroupRuleIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalPrototypeSecurityGroupRuleIPPrototype) Equal(other *SecurityGroupRuleLocalPrototypeSecurityGroupRuleIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalSecurityGroupRuleCIDR) DeepCopy() *SecurityGroupRuleLocalSecurityGroupRuleCIDR {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalSecurityGroupRuleCIDR) Equal(other *SecurityGroupRuleLocalSecurityGroupRuleCIDR, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleLocalSecurityGroupRuleIP) DeepCopy() *SecurityGroupRuleLocalSecurityGroupRuleIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleLocalSecurityGroupRuleIP) Equal(other *SecurityGroupRuleLocalSecurityGroupRuleIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePatch) DeepCopy() *SecurityGroupRulePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePatch) Equal(other *SecurityGroupRulePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleProtocolAny) DeepCopy() *SecurityGroupRuleProtocolAny {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleProtocolAny) Equal(other *SecurityGroupRuleProtocolAny, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleProtocolIcmptcpudp) DeepCopy() *SecurityGroupRuleProtocolIcmptcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleProtocolIcmptcpudp) Equal(other *SecurityGroupRuleProtocolIcmptcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleProtocolIndividual) DeepCopy() *SecurityGroupRuleProtocolIndividual {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleProtocolIndividual) Equal(other *SecurityGroupRuleProtocolIndividual, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototype) DeepCopy() *SecurityGroupRulePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototype) Equal(other *SecurityGroupRulePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolAnyPrototype) DeepCopy() *SecurityGroupRulePrototypeSecurityGroupRuleProtocolAnyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolAnyPrototype) Equal(other *SecurityGroupRulePrototypeSecurityGroupRuleProtocolAnyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmp) DeepCopy() *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmp) Equal(other *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmptcpudpPrototype) DeepCopy() *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmptcpudpPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmptcpudpPrototype) Equal(other *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIcmptcpudpPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIndividualPrototype) DeepCopy() *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIndividualPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIndividualPrototype) Equal(other *SecurityGroupRulePrototypeSecurityGroupRuleProtocolIndividualPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolTcpudp) DeepCopy() *SecurityGroupRulePrototypeSecurityGroupRuleProtocolTcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRulePrototypeSecurityGroupRuleProtocolTcpudp) Equal(other *SecurityGroupRulePrototypeSecurityGroupRuleProtocolTcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemote) DeepCopy() *SecurityGroupRuleRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemote) Equal(other *SecurityGroupRuleRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatch) DeepCopy() *SecurityGroupRuleRemotePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatch) Equal(other *SecurityGroupRuleRemotePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentity) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentity) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByCRN) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByCRN) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByHref) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByHref) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByID) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByID) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupIdentitySecurityGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupRuleCIDRPrototype) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupRuleCIDRPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupRuleCIDRPrototype) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupRuleCIDRPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePatchSecurityGroupRuleIPPrototype) DeepCopy() *SecurityGroupRuleRemotePatchSecurityGroupRuleIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePatchSecurityGroupRuleIPPrototype) Equal(other *SecurityGroupRuleRemotePatchSecurityGroupRuleIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototype) DeepCopy() *SecurityGroupRuleRemotePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototype) Equal(other *SecurityGroupRuleRemotePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentity) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentity) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByCRN) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByCRN) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByHref) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByHref) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByID) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByID) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupIdentitySecurityGroupIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupRuleCIDRPrototype) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupRuleCIDRPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupRuleCIDRPrototype) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupRuleCIDRPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupRuleIPPrototype) DeepCopy() *SecurityGroupRuleRemotePrototypeSecurityGroupRuleIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemotePrototypeSecurityGroupRuleIPPrototype) Equal(other *SecurityGroupRuleRemotePrototypeSecurityGroupRuleIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemoteSecurityGroupReference) DeepCopy() *SecurityGroupRuleRemoteSecurityGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemoteSecurityGroupReference) Equal(other *SecurityGroupRuleRemoteSecurityGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemoteSecurityGroupRuleCIDR) DeepCopy() *SecurityGroupRuleRemoteSecurityGroupRuleCIDR {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemoteSecurityGroupRuleCIDR) Equal(other *SecurityGroupRuleRemoteSecurityGroupRuleCIDR, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleRemoteSecurityGroupRuleIP) DeepCopy() *SecurityGroupRuleRemoteSecurityGroupRuleIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleRemoteSecurityGroupRuleIP) Equal(other *SecurityGroupRuleRemoteSecurityGroupRuleIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleSecurityGroupRuleProtocolIcmp) DeepCopy() *SecurityGroupRuleSecurityGroupRuleProtocolIcmp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleSecurityGroupRuleProtocolIcmp) Equal(other *SecurityGroupRuleSecurityGroupRuleProtocolIcmp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupRuleSecurityGroupRuleProtocolTcpudp) DeepCopy() *SecurityGroupRuleSecurityGroupRuleProtocolTcpudp {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupRuleSecurityGroupRuleProtocolTcpudp) Equal(other *SecurityGroupRuleSecurityGroupRuleProtocolTcpudp, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetCollection) DeepCopy() *SecurityGroupTargetCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetCollection) Equal(other *SecurityGroupTargetCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReference) DeepCopy() *SecurityGroupTargetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReference) Equal(other *SecurityGroupTargetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceBareMetalServerNetworkInterfaceReferenceTargetContext) DeepCopy() *SecurityGroupTargetReferenceBareMetalServerNetworkInterfaceReferenceTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceBareMetalServerNetworkInterfaceReferenceTargetContext) Equal(other *SecurityGroupTargetReferenceBareMetalServerNetworkInterfaceReferenceTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceEndpointGatewayReference) DeepCopy() *SecurityGroupTargetReferenceEndpointGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceEndpointGatewayReference) Equal(other *SecurityGroupTargetReferenceEndpointGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceLoadBalancerReference) DeepCopy() *SecurityGroupTargetReferenceLoadBalancerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceLoadBalancerReference) Equal(other *SecurityGroupTargetReferenceLoadBalancerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceNetworkInterfaceReferenceTargetContext) DeepCopy() *SecurityGroupTargetReferenceNetworkInterfaceReferenceTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceNetworkInterfaceReferenceTargetContext) Equal(other *SecurityGroupTargetReferenceNetworkInterfaceReferenceTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceVPNServerReference) DeepCopy() *SecurityGroupTargetReferenceVPNServerReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceVPNServerReference) Equal(other *SecurityGroupTargetReferenceVPNServerReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SecurityGroupTargetReferenceVirtualNetworkInterfaceReference) DeepCopy() *SecurityGroupTargetReferenceVirtualNetworkInterfaceReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SecurityGroupTargetReferenceVirtualNetworkInterfaceReference) Equal(other *SecurityGroupTargetReferenceVirtualNetworkInterfaceReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Share) DeepCopy() *Share {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Share) Equal(other *Share, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBinding) DeepCopy() *ShareAccessorBinding {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBinding) Equal(other *ShareAccessorBinding, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBindingAccessor) DeepCopy() *ShareAccessorBindingAccessor {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBindingAccessor) Equal(other *ShareAccessorBindingAccessor, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBindingAccessorShareReference) DeepCopy() *ShareAccessorBindingAccessorShareReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBindingAccessorShareReference) Equal(other *ShareAccessorBindingAccessorShareReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBindingAccessorWatsonxMachineLearningReference) DeepCopy() *ShareAccessorBindingAccessorWatsonxMachineLearningReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBindingAccessorWatsonxMachineLearningReference) Equal(other *ShareAccessorBindingAccessorWatsonxMachineLearningReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBindingCollection) DeepCopy() *ShareAccessorBindingCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBindingCollection) Equal(other *ShareAccessorBindingCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareAccessorBindingReference) DeepCopy() *ShareAccessorBindingReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareAccessorBindingReference) Equal(other *ShareAccessorBindingReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareCollection) DeepCopy() *ShareCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareCollection) Equal(other *ShareCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareIdentity) DeepCopy() *ShareIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareIdentity) Equal(other *ShareIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareIdentityByCRN) DeepCopy() *ShareIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareIdentityByCRN) Equal(other *ShareIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareIdentityByHref) DeepCopy() *ShareIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareIdentityByHref) Equal(other *ShareIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareIdentityByID) DeepCopy() *ShareIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareIdentityByID) Equal(other *ShareIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareInitialOwner) DeepCopy() *ShareInitialOwner {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareInitialOwner) Equal(other *ShareInitialOwner, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareJob) DeepCopy() *ShareJob {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareJob) Equal(other *ShareJob, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareJobStatusReason) DeepCopy() *ShareJobStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareJobStatusReason) Equal(other *ShareJobStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareLatestSync) DeepCopy() *ShareLatestSync {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareLatestSync) Equal(other *ShareLatestSync, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareLifecycleReason) DeepCopy() *ShareLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareLifecycleReason) Equal(other *ShareLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTarget) DeepCopy() *ShareMountTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTarget) Equal(other *ShareMountTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetCollection) DeepCopy() *ShareMountTargetCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetCollection) Equal(other *ShareMountTargetCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetPatch) DeepCopy() *ShareMountTargetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetPatch) Equal(other *ShareMountTargetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetPrototype) DeepCopy() *ShareMountTargetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetPrototype) Equal(other *ShareMountTargetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup) DeepCopy() *ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup) Equal(other *ShareMountTargetPrototypeShareMountTargetByAccessControlModeSecurityGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC) DeepCopy() *ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC) Equal(other *ShareMountTargetPrototypeShareMountTargetByAccessControlModeVPC, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetReference) DeepCopy() *ShareMountTargetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetReference) Equal(other *ShareMountTargetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototype) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototype) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentity) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentity) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfaceIdentityVirtualNetworkInterfaceIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext) DeepCopy() *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext) Equal(other *ShareMountTargetVirtualNetworkInterfacePrototypeVirtualNetworkInterfacePrototypeShareMountTargetContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePatch) DeepCopy() *SharePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePatch) Equal(other *SharePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfile) DeepCopy() *ShareProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfile) Equal(other *ShareProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAllowedAccessProtocols) DeepCopy() *ShareProfileAllowedAccessProtocols {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAllowedAccessProtocols) Equal(other *ShareProfileAllowedAccessProtocols, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAllowedAccessProtocolsSubset) DeepCopy() *ShareProfileAllowedAccessProtocolsSubset {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAllowedAccessProtocolsSubset) Equal(other *ShareProfileAllowedAccessProtocolsSubset, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAllowedTransitEncryptionModes) DeepCopy() *ShareProfileAllowedTransitEncryptionModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAllowedTransitEncryptionModes) Equal(other *ShareProfileAllowedTransitEncryptionModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAllowedTransitEncryptionModesSubset) DeepCopy() *ShareProfileAllowedTransitEncryptionModesSubset {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAllowedTransitEncryptionModesSubset) Equal(other *ShareProfileAllowedTransitEncryptionModesSubset, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAvailabilityModes) DeepCopy() *ShareProfileAvailabilityModes {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAvailabilityModes) Equal(other *ShareProfileAvailabilityModes, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAvailabilityModesEnum) DeepCopy() *ShareProfileAvailabilityModesEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAvailabilityModesEnum) Equal(other *ShareProfileAvailabilityModesEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileAvailabilityModesFixed) DeepCopy() *ShareProfileAvailabilityModesFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileAvailabilityModesFixed) Equal(other *ShareProfileAvailabilityModesFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidth) DeepCopy() *ShareProfileBandwidth {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidth) Equal(other *ShareProfileBandwidth, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidthDependent) DeepCopy() *ShareProfileBandwidthDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidthDependent) Equal(other *ShareProfileBandwidthDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidthDependentRange) DeepCopy() *ShareProfileBandwidthDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidthDependentRange) Equal(other *ShareProfileBandwidthDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidthEnum) DeepCopy() *ShareProfileBandwidthEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidthEnum) Equal(other *ShareProfileBandwidthEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidthFixed) DeepCopy() *ShareProfileBandwidthFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidthFixed) Equal(other *ShareProfileBandwidthFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileBandwidthRange) DeepCopy() *ShareProfileBandwidthRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileBandwidthRange) Equal(other *ShareProfileBandwidthRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCapacity) DeepCopy() *ShareProfileCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCapacity) Equal(other *ShareProfileCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCapacityDependentRange) DeepCopy() *ShareProfileCapacityDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCapacityDependentRange) Equal(other *ShareProfileCapacityDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCapacityEnum) DeepCopy() *ShareProfileCapacityEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCapacityEnum) Equal(other *ShareProfileCapacityEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCapacityFixed) DeepCopy() *ShareProfileCapacityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCapacityFixed) Equal(other *ShareProfileCapacityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCapacityRange) DeepCopy() *ShareProfileCapacityRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCapacityRange) Equal(other *ShareProfileCapacityRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileCollection) DeepCopy() *ShareProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileCollection) Equal(other *ShareProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIdentity) DeepCopy() *ShareProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIdentity) Equal(other *ShareProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIdentityByHref) DeepCopy() *ShareProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIdentityByHref) Equal(other *ShareProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIdentityByName) DeepCopy() *ShareProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIdentityByName) Equal(other *ShareProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIops) DeepCopy() *ShareProfileIops {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIops) Equal(other *ShareProfileIops, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIopsDependent) DeepCopy() *ShareProfileIopsDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIopsDependent) Equal(other *ShareProfileIopsDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIopsDependentRange) DeepCopy() *ShareProfileIopsDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIopsDependentRange) Equal(other *ShareProfileIopsDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIopsEnum) DeepCopy() *ShareProfileIopsEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIopsEnum) Equal(other *ShareProfileIopsEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIopsFixed) DeepCopy() *ShareProfileIopsFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIopsFixed) Equal(other *ShareProfileIopsFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileIopsRange) DeepCopy() *ShareProfileIopsRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileIopsRange) Equal(other *ShareProfileIopsRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileReference) DeepCopy() *ShareProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileReference) Equal(other *ShareProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileStorageGeneration) DeepCopy() *ShareProfileStorageGeneration {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileStorageGeneration) Equal(other *ShareProfileStorageGeneration, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareProfileStorageGenerationFixed) DeepCopy() *ShareProfileStorageGenerationFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareProfileStorageGenerationFixed) Equal(other *ShareProfileStorageGenerationFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototype) DeepCopy() *SharePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototype) Equal(other *SharePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototypeShareByOriginShare) DeepCopy() *SharePrototypeShareByOriginShare {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototypeShareByOriginShare) Equal(other *SharePrototypeShareByOriginShare, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototypeShareBySize) DeepCopy() *SharePrototypeShareBySize {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototypeShareBySize) Equal(other *SharePrototypeShareBySize, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototypeShareBySourceShare) DeepCopy() *SharePrototypeShareBySourceShare {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototypeShareBySourceShare) Equal(other *SharePrototypeShareBySourceShare, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototypeShareBySourceSnapshot) DeepCopy() *SharePrototypeShareBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototypeShareBySourceSnapshot) Equal(other *SharePrototypeShareBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SharePrototypeShareContext) DeepCopy() *SharePrototypeShareContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SharePrototypeShareContext) Equal(other *SharePrototypeShareContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareReference) DeepCopy() *ShareReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareReference) Equal(other *ShareReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareRemote) DeepCopy() *ShareRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareRemote) Equal(other *ShareRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareReplicationStatusReason) DeepCopy() *ShareReplicationStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareReplicationStatusReason) Equal(other *ShareReplicationStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSnapshot) DeepCopy() *ShareSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSnapshot) Equal(other *ShareSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSnapshotCollection) DeepCopy() *ShareSnapshotCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSnapshotCollection) Equal(other *ShareSnapshotCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSnapshotLifecycleReason) DeepCopy() *ShareSnapshotLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSnapshotLifecycleReason) Equal(other *ShareSnapshotLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSnapshotPatch) DeepCopy() *ShareSnapshotPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSnapshotPatch) Equal(other *ShareSnapshotPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSnapshotStatusReason) DeepCopy() *ShareSnapshotStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSnapshotStatusReason) Equal(other *ShareSnapshotStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshot) DeepCopy() *ShareSourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshot) Equal(other *ShareSourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotPrototype) DeepCopy() *ShareSourceSnapshotPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotPrototype) Equal(other *ShareSourceSnapshotPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentity) DeepCopy() *ShareSourceSnapshotPrototypeShareSnapshotIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentity) Equal(other *ShareSourceSnapshotPrototypeShareSnapshotIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByCRN) DeepCopy() *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByCRN) Equal(other *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByHref) DeepCopy() *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByHref) Equal(other *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByID) DeepCopy() *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByID) Equal(other *ShareSourceSnapshotPrototypeShareSnapshotIdentityShareSnapshotIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ShareSourceSnapshotShareSnapshotReference) DeepCopy() *ShareSourceSnapshotShareSnapshotReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ShareSourceSnapshotShareSnapshotReference) Equal(other *ShareSourceSnapshotShareSnapshotReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Snapshot) DeepCopy() *Snapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Snapshot) Equal(other *Snapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotAllowedUse) DeepCopy() *SnapshotAllowedUse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotAllowedUse) Equal(other *SnapshotAllowedUse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotAllowedUsePatch) DeepCopy() *SnapshotAllowedUsePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotAllowedUsePatch) Equal(other *SnapshotAllowedUsePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotAllowedUsePrototype) DeepCopy() *SnapshotAllowedUsePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotAllowedUsePrototype) Equal(other *SnapshotAllowedUsePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotCatalogOffering) DeepCopy() *SnapshotCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotCatalogOffering) Equal(other *SnapshotCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotClone) DeepCopy() *SnapshotClone {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotClone) Equal(other *SnapshotClone, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotCloneCollection) DeepCopy() *SnapshotCloneCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotCloneCollection) Equal(other *SnapshotCloneCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotClonePrototype) DeepCopy() *SnapshotClonePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotClonePrototype) Equal(other *SnapshotClonePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotCollection) DeepCopy() *SnapshotCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotCollection) Equal(other *SnapshotCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroup) DeepCopy() *SnapshotConsistencyGroup {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroup) Equal(other *SnapshotConsistencyGroup, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroupCollection) DeepCopy() *SnapshotConsistencyGroupCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroupCollection) Equal(other *SnapshotConsistencyGroupCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroupPatch) DeepCopy() *SnapshotConsistencyGroupPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroupPatch) Equal(other *SnapshotConsistencyGroupPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroupPrototype) DeepCopy() *SnapshotConsistencyGroupPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroupPrototype) Equal(other *SnapshotConsistencyGroupPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroupPrototypeSnapshotConsistencyGroupBySnapshots) DeepCopy() *SnapshotConsistencyGroupPrototypeSnapshotConsistencyGroupBySnapshots {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroupPrototypeSnapshotConsistencyGroupBySnapshots) Equal(other *SnapshotConsistencyGroupPrototypeSnapshotConsistencyGroupBySnapshots, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotConsistencyGroupReference) DeepCopy() *SnapshotConsistencyGroupReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotConsistencyGroupReference) Equal(other *SnapshotConsistencyGroupReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotCopiesItem) DeepCopy() *SnapshotCopiesItem {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotCopiesItem) Equal(other *SnapshotCopiesItem, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotIdentity) DeepCopy() *SnapshotIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotIdentity) Equal(other *SnapshotIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotIdentityByCRN) DeepCopy() *SnapshotIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotIdentityByCRN) Equal(other *SnapshotIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotIdentityByHref) DeepCopy() *SnapshotIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotIdentityByHref) Equal(other *SnapshotIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotIdentityByID) DeepCopy() *SnapshotIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotIdentityByID) Equal(other *SnapshotIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotInstanceProfileCollection) DeepCopy() *SnapshotInstanceProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotInstanceProfileCollection) Equal(other *SnapshotInstanceProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotPatch) DeepCopy() *SnapshotPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotPatch) Equal(other *SnapshotPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotPrototype) DeepCopy() *SnapshotPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotPrototype) Equal(other *SnapshotPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotPrototypeSnapshotBySourceSnapshot) DeepCopy() *SnapshotPrototypeSnapshotBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotPrototypeSnapshotBySourceSnapshot) Equal(other *SnapshotPrototypeSnapshotBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotPrototypeSnapshotBySourceVolume) DeepCopy() *SnapshotPrototypeSnapshotBySourceVolume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotPrototypeSnapshotBySourceVolume) Equal(other *SnapshotPrototypeSnapshotBySourceVolume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotPrototypeSnapshotConsistencyGroupContext) DeepCopy() *SnapshotPrototypeSnapshotConsistencyGroupContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotPrototypeSnapshotConsistencyGroupContext) Equal(other *SnapshotPrototypeSnapshotConsistencyGroupContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotReference) DeepCopy() *SnapshotReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotReference) Equal(other *SnapshotReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotRemote) DeepCopy() *SnapshotRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotRemote) Equal(other *SnapshotRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SnapshotSourceSnapshot) DeepCopy() *SnapshotSourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SnapshotSourceSnapshot) Equal(other *SnapshotSourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Subnet) DeepCopy() *Subnet {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Subnet) Equal(other *Subnet, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetCollection) DeepCopy() *SubnetCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetCollection) Equal(other *SubnetCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetIdentity) DeepCopy() *SubnetIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetIdentity) Equal(other *SubnetIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetIdentityByCRN) DeepCopy() *SubnetIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetIdentityByCRN) Equal(other *SubnetIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetIdentityByHref) DeepCopy() *SubnetIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetIdentityByHref) Equal(other *SubnetIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetIdentityByID) DeepCopy() *SubnetIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetIdentityByID) Equal(other *SubnetIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPatch) DeepCopy() *SubnetPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPatch) Equal(other *SubnetPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPrototype) DeepCopy() *SubnetPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPrototype) Equal(other *SubnetPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPrototypeSubnetByCIDR) DeepCopy() *SubnetPrototypeSubnetByCIDR {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPrototypeSubnetByCIDR) Equal(other *SubnetPrototypeSubnetByCIDR, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPrototypeSubnetByTotalCount) DeepCopy() *SubnetPrototypeSubnetByTotalCount {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPrototypeSubnetByTotalCount) Equal(other *SubnetPrototypeSubnetByTotalCount, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPublicGatewayPatch) DeepCopy() *SubnetPublicGatewayPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPublicGatewayPatch) Equal(other *SubnetPublicGatewayPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByCRN) DeepCopy() *SubnetPublicGatewayPatchPublicGatewayIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByCRN) Equal(other *SubnetPublicGatewayPatchPublicGatewayIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByHref) DeepCopy() *SubnetPublicGatewayPatchPublicGatewayIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByHref) Equal(other *SubnetPublicGatewayPatchPublicGatewayIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByID) DeepCopy() *SubnetPublicGatewayPatchPublicGatewayIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetPublicGatewayPatchPublicGatewayIdentityByID) Equal(other *SubnetPublicGatewayPatchPublicGatewayIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *SubnetReference) DeepCopy() *SubnetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *SubnetReference) Equal(other *SubnetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *TrustedProfileIdentity) DeepCopy() *TrustedProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *TrustedProfileIdentity) Equal(other *TrustedProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *TrustedProfileIdentityByCRN) DeepCopy() *TrustedProfileIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *TrustedProfileIdentityByCRN) Equal(other *TrustedProfileIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *TrustedProfileIdentityByID) DeepCopy() *TrustedProfileIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *TrustedProfileIdentityByID) Equal(other *TrustedProfileIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *TrustedProfileReference) DeepCopy() *TrustedProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *TrustedProfileReference) Equal(other *TrustedProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPC) DeepCopy() *VPC {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPC) Equal(other *VPC, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCCollection) DeepCopy() *VPCCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCCollection) Equal(other *VPCCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCHealthReason) DeepCopy() *VPCHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCHealthReason) Equal(other *VPCHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCIdentity) DeepCopy() *VPCIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCIdentity) Equal(other *VPCIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCIdentityByCRN) DeepCopy() *VPCIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCIdentityByCRN) Equal(other *VPCIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCIdentityByHref) DeepCopy() *VPCIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCIdentityByHref) Equal(other *VPCIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCIdentityByID) DeepCopy() *VPCIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCIdentityByID) Equal(other *VPCIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCPatch) DeepCopy() *VPCPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCPatch) Equal(other *VPCPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCReference) DeepCopy() *VPCReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCReference) Equal(other *VPCReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCReferenceDnsResolverContext) DeepCopy() *VPCReferenceDnsResolverContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCReferenceDnsResolverContext) Equal(other *VPCReferenceDnsResolverContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCReferenceRemote) DeepCopy() *VPCReferenceRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCReferenceRemote) Equal(other *VPCReferenceRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPCRemote) DeepCopy() *VPCRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPCRemote) Equal(other *VPCRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGateway) DeepCopy() *VPNGateway {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGateway) Equal(other *VPNGateway, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayAdvertisedCIDRCollection) DeepCopy() *VPNGatewayAdvertisedCIDRCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayAdvertisedCIDRCollection) Equal(other *VPNGatewayAdvertisedCIDRCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayCollection) DeepCopy() *VPNGatewayCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayCollection) Equal(other *VPNGatewayCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnection) DeepCopy() *VPNGatewayConnection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnection) Equal(other *VPNGatewayConnection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionCIDRs) DeepCopy() *VPNGatewayConnectionCIDRs {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionCIDRs) Equal(other *VPNGatewayConnectionCIDRs, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionCollection) DeepCopy() *VPNGatewayConnectionCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionCollection) Equal(other *VPNGatewayConnectionCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDpd) DeepCopy() *VPNGatewayConnectionDpd {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDpd) Equal(other *VPNGatewayConnectionDpd, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDpdPatch) DeepCopy() *VPNGatewayConnectionDpdPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDpdPatch) Equal(other *VPNGatewayConnectionDpdPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDpdPrototype) DeepCopy() *VPNGatewayConnectionDpdPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDpdPrototype) Equal(other *VPNGatewayConnectionDpdPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModeLocal) DeepCopy() *VPNGatewayConnectionDynamicRouteModeLocal {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModeLocal) Equal(other *VPNGatewayConnectionDynamicRouteModeLocal, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModeLocalPrototype) DeepCopy() *VPNGatewayConnectionDynamicRouteModeLocalPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModeLocalPrototype) Equal(other *VPNGatewayConnectionDynamicRouteModeLocalPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeer) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeer) Equal(other *VPNGatewayConnectionDynamicRouteModePeer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototype) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototype) Equal(other *VPNGatewayConnectionDynamicRouteModePeerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionDynamicRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionDynamicRouteModeTunnel) DeepCopy() *VPNGatewayConnectionDynamicRouteModeTunnel {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionDynamicRouteModeTunnel) Equal(other *VPNGatewayConnectionDynamicRouteModeTunnel, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPatch) DeepCopy() *VPNGatewayConnectionIPsecPolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPatch) Equal(other *VPNGatewayConnectionIPsecPolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByHref) DeepCopy() *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByHref) Equal(other *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByID) DeepCopy() *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByID) Equal(other *VPNGatewayConnectionIPsecPolicyPatchIPsecPolicyIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPrototype) DeepCopy() *VPNGatewayConnectionIPsecPolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPrototype) Equal(other *VPNGatewayConnectionIPsecPolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByHref) DeepCopy() *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByHref) Equal(other *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByID) DeepCopy() *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByID) Equal(other *VPNGatewayConnectionIPsecPolicyPrototypeIPsecPolicyIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentity) DeepCopy() *VPNGatewayConnectionIkeIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentity) Equal(other *VPNGatewayConnectionIkeIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityPrototype) DeepCopy() *VPNGatewayConnectionIkeIdentityPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityPrototype) Equal(other *VPNGatewayConnectionIkeIdentityPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityFqdn) DeepCopy() *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityFqdn) Equal(other *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityHostname) DeepCopy() *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityHostname {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityHostname) Equal(other *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityHostname, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityIPv4) DeepCopy() *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityIPv4 {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityIPv4) Equal(other *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityIPv4, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityKeyID) DeepCopy() *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityKeyID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityKeyID) Equal(other *VPNGatewayConnectionIkeIdentityPrototypeVPNGatewayConnectionIkeIdentityKeyID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityFqdn) DeepCopy() *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityFqdn) Equal(other *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityHostname) DeepCopy() *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityHostname {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityHostname) Equal(other *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityHostname, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityIPv4) DeepCopy() *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityIPv4 {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityIPv4) Equal(other *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityIPv4, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityKeyID) DeepCopy() *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityKeyID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityKeyID) Equal(other *VPNGatewayConnectionIkeIdentityVPNGatewayConnectionIkeIdentityKeyID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPatch) DeepCopy() *VPNGatewayConnectionIkePolicyPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPatch) Equal(other *VPNGatewayConnectionIkePolicyPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByHref) DeepCopy() *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByHref) Equal(other *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByID) DeepCopy() *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByID) Equal(other *VPNGatewayConnectionIkePolicyPatchIkePolicyIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPrototype) DeepCopy() *VPNGatewayConnectionIkePolicyPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPrototype) Equal(other *VPNGatewayConnectionIkePolicyPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByHref) DeepCopy() *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByHref) Equal(other *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByID) DeepCopy() *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByID) Equal(other *VPNGatewayConnectionIkePolicyPrototypeIkePolicyIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPatch) DeepCopy() *VPNGatewayConnectionPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPatch) Equal(other *VPNGatewayConnectionPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatch) DeepCopy() *VPNGatewayConnectionPeerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatch) Equal(other *VPNGatewayConnectionPeerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerAddressPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerAddressPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerAddressPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerAddressPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerFqdnPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerFqdnPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerFqdnPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionDynamicRouteModePeerPatchVPNGatewayConnectionPeerFqdnPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerAddressPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerAddressPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerAddressPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerAddressPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerFqdnPatch) DeepCopy() *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerFqdnPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerFqdnPatch) Equal(other *VPNGatewayConnectionPeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPolicyModePeerPatchVPNGatewayConnectionPeerFqdnPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyMode) DeepCopy() *VPNGatewayConnectionPolicyMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyMode) Equal(other *VPNGatewayConnectionPolicyMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModeLocal) DeepCopy() *VPNGatewayConnectionPolicyModeLocal {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModeLocal) Equal(other *VPNGatewayConnectionPolicyModeLocal, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModeLocalPrototype) DeepCopy() *VPNGatewayConnectionPolicyModeLocalPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModeLocalPrototype) Equal(other *VPNGatewayConnectionPolicyModeLocalPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeer) DeepCopy() *VPNGatewayConnectionPolicyModePeer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeer) Equal(other *VPNGatewayConnectionPolicyModePeer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeerPrototype) DeepCopy() *VPNGatewayConnectionPolicyModePeerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeerPrototype) Equal(other *VPNGatewayConnectionPolicyModePeerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionPolicyModePeerPrototypeVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPrototype) DeepCopy() *VPNGatewayConnectionPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPrototype) Equal(other *VPNGatewayConnectionPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionDynamicRouteModePrototype) DeepCopy() *VPNGatewayConnectionPrototypeVPNGatewayConnectionDynamicRouteModePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionDynamicRouteModePrototype) Equal(other *VPNGatewayConnectionPrototypeVPNGatewayConnectionDynamicRouteModePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionPolicyModePrototype) DeepCopy() *VPNGatewayConnectionPrototypeVPNGatewayConnectionPolicyModePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionPolicyModePrototype) Equal(other *VPNGatewayConnectionPrototypeVPNGatewayConnectionPolicyModePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionStaticRouteModePrototype) DeepCopy() *VPNGatewayConnectionPrototypeVPNGatewayConnectionStaticRouteModePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionPrototypeVPNGatewayConnectionStaticRouteModePrototype) Equal(other *VPNGatewayConnectionPrototypeVPNGatewayConnectionStaticRouteModePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionReference) DeepCopy() *VPNGatewayConnectionReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionReference) Equal(other *VPNGatewayConnectionReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionRouteMode) DeepCopy() *VPNGatewayConnectionRouteMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionRouteMode) Equal(other *VPNGatewayConnectionRouteMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionRouteModeVPNGatewayConnectionDynamicRouteMode) DeepCopy() *VPNGatewayConnectionRouteModeVPNGatewayConnectionDynamicRouteMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionRouteModeVPNGatewayConnectionDynamicRouteMode) Equal(other *VPNGatewayConnectionRouteModeVPNGatewayConnectionDynamicRouteMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode) DeepCopy() *VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode) Equal(other *VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModeLocal) DeepCopy() *VPNGatewayConnectionStaticRouteModeLocal {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModeLocal) Equal(other *VPNGatewayConnectionStaticRouteModeLocal, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModeLocalPrototype) DeepCopy() *VPNGatewayConnectionStaticRouteModeLocalPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModeLocalPrototype) Equal(other *VPNGatewayConnectionStaticRouteModeLocalPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeer) DeepCopy() *VPNGatewayConnectionStaticRouteModePeer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeer) Equal(other *VPNGatewayConnectionStaticRouteModePeer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototype) DeepCopy() *VPNGatewayConnectionStaticRouteModePeerPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototype) Equal(other *VPNGatewayConnectionStaticRouteModePeerPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionStaticRouteModePeerPrototypeVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress) DeepCopy() *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress) Equal(other *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn) DeepCopy() *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn) Equal(other *VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStaticRouteModeTunnel) DeepCopy() *VPNGatewayConnectionStaticRouteModeTunnel {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStaticRouteModeTunnel) Equal(other *VPNGatewayConnectionStaticRouteModeTunnel, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionStatusReason) DeepCopy() *VPNGatewayConnectionStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionStatusReason) Equal(other *VPNGatewayConnectionStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionTunnel) DeepCopy() *VPNGatewayConnectionTunnel {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionTunnel) Equal(other *VPNGatewayConnectionTunnel, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionTunnelPrototype) DeepCopy() *VPNGatewayConnectionTunnelPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionTunnelPrototype) Equal(other *VPNGatewayConnectionTunnelPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayConnectionTunnelStatusReason) DeepCopy() *VPNGatewayConnectionTunnelStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayConnectionTunnelStatusReason) Equal(other *VPNGatewayConnectionTunnelStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayHealthReason) DeepCopy() *VPNGatewayHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayHealthReason) Equal(other *VPNGatewayHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayLifecycleReason) DeepCopy() *VPNGatewayLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayLifecycleReason) Equal(other *VPNGatewayLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayMember) DeepCopy() *VPNGatewayMember {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayMember) Equal(other *VPNGatewayMember, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayMemberHealthReason) DeepCopy() *VPNGatewayMemberHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayMemberHealthReason) Equal(other *VPNGatewayMemberHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayMemberLifecycleReason) DeepCopy() *VPNGatewayMemberLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayMemberLifecycleReason) Equal(other *VPNGatewayMemberLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayPatch) DeepCopy() *VPNGatewayPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayPatch) Equal(other *VPNGatewayPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayPolicyMode) DeepCopy() *VPNGatewayPolicyMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayPolicyMode) Equal(other *VPNGatewayPolicyMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayPrototype) DeepCopy() *VPNGatewayPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayPrototype) Equal(other *VPNGatewayPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayPrototypeVPNGatewayPolicyModePrototype) DeepCopy() *VPNGatewayPrototypeVPNGatewayPolicyModePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayPrototypeVPNGatewayPolicyModePrototype) Equal(other *VPNGatewayPrototypeVPNGatewayPolicyModePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayPrototypeVPNGatewayRouteModePrototype) DeepCopy() *VPNGatewayPrototypeVPNGatewayRouteModePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayPrototypeVPNGatewayRouteModePrototype) Equal(other *VPNGatewayPrototypeVPNGatewayRouteModePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayRouteMode) DeepCopy() *VPNGatewayRouteMode {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayRouteMode) Equal(other *VPNGatewayRouteMode, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnection) DeepCopy() *VPNGatewayServiceConnection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnection) Equal(other *VPNGatewayServiceConnection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnectionCollection) DeepCopy() *VPNGatewayServiceConnectionCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnectionCollection) Equal(other *VPNGatewayServiceConnectionCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnectionCreator) DeepCopy() *VPNGatewayServiceConnectionCreator {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnectionCreator) Equal(other *VPNGatewayServiceConnectionCreator, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnectionCreatorTransitGatewayReference) DeepCopy() *VPNGatewayServiceConnectionCreatorTransitGatewayReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnectionCreatorTransitGatewayReference) Equal(other *VPNGatewayServiceConnectionCreatorTransitGatewayReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnectionLifecycleReason) DeepCopy() *VPNGatewayServiceConnectionLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnectionLifecycleReason) Equal(other *VPNGatewayServiceConnectionLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNGatewayServiceConnectionStatusReason) DeepCopy() *VPNGatewayServiceConnectionStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNGatewayServiceConnectionStatusReason) Equal(other *VPNGatewayServiceConnectionStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServer) DeepCopy() *VPNServer {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServer) Equal(other *VPNServer, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthentication) DeepCopy() *VPNServerAuthentication {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthentication) Equal(other *VPNServerAuthentication, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationByCertificate) DeepCopy() *VPNServerAuthenticationByCertificate {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationByCertificate) Equal(other *VPNServerAuthenticationByCertificate, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationByUsername) DeepCopy() *VPNServerAuthenticationByUsername {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationByUsername) Equal(other *VPNServerAuthenticationByUsername, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationByUsernameIDProvider) DeepCopy() *VPNServerAuthenticationByUsernameIDProvider {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationByUsernameIDProvider) Equal(other *VPNServerAuthenticationByUsernameIDProvider, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationByUsernameIDProviderByIam) DeepCopy() *VPNServerAuthenticationByUsernameIDProviderByIam {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationByUsernameIDProviderByIam) Equal(other *VPNServerAuthenticationByUsernameIDProviderByIam, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationPrototype) DeepCopy() *VPNServerAuthenticationPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationPrototype) Equal(other *VPNServerAuthenticationPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype) DeepCopy() *VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype) Equal(other *VPNServerAuthenticationPrototypeVPNServerAuthenticationByCertificatePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype) DeepCopy() *VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype) Equal(other *VPNServerAuthenticationPrototypeVPNServerAuthenticationByUsernamePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerClient) DeepCopy() *VPNServerClient {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerClient) Equal(other *VPNServerClient, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerClientCollection) DeepCopy() *VPNServerClientCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerClientCollection) Equal(other *VPNServerClientCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerCollection) DeepCopy() *VPNServerCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerCollection) Equal(other *VPNServerCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerHealthReason) DeepCopy() *VPNServerHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerHealthReason) Equal(other *VPNServerHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerLifecycleReason) DeepCopy() *VPNServerLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerLifecycleReason) Equal(other *VPNServerLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerPatch) DeepCopy() *VPNServerPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerPatch) Equal(other *VPNServerPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerRoute) DeepCopy() *VPNServerRoute {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerRoute) Equal(other *VPNServerRoute, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerRouteCollection) DeepCopy() *VPNServerRouteCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerRouteCollection) Equal(other *VPNServerRouteCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerRouteHealthReason) DeepCopy() *VPNServerRouteHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerRouteHealthReason) Equal(other *VPNServerRouteHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerRouteLifecycleReason) DeepCopy() *VPNServerRouteLifecycleReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerRouteLifecycleReason) Equal(other *VPNServerRouteLifecycleReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VPNServerRoutePatch) DeepCopy() *VPNServerRoutePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VPNServerRoutePatch) Equal(other *VPNServerRoutePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Vcpu) DeepCopy() *Vcpu {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Vcpu) Equal(other *Vcpu, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterface) DeepCopy() *VirtualNetworkInterface {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterface) Equal(other *VirtualNetworkInterface, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceCollection) DeepCopy() *VirtualNetworkInterfaceCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceCollection) Equal(other *VirtualNetworkInterfaceCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceIPPrototype) DeepCopy() *VirtualNetworkInterfaceIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceIPPrototype) Equal(other *VirtualNetworkInterfaceIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContext) DeepCopy() *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContext) Equal(other *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByHref) DeepCopy() *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByHref) Equal(other *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByID) DeepCopy() *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByID) Equal(other *VirtualNetworkInterfaceIPPrototypeReservedIPIdentityVirtualNetworkInterfaceIPsContextByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPPrototypeVirtualNetworkInterfaceIPsContext) DeepCopy() *VirtualNetworkInterfaceIPPrototypeReservedIPPrototypeVirtualNetworkInterfaceIPsContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceIPPrototypeReservedIPPrototypeVirtualNetworkInterfaceIPsContext) Equal(other *VirtualNetworkInterfaceIPPrototypeReservedIPPrototypeVirtualNetworkInterfaceIPsContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePatch) DeepCopy() *VirtualNetworkInterfacePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePatch) Equal(other *VirtualNetworkInterfacePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePrimaryIPPrototype) DeepCopy() *VirtualNetworkInterfacePrimaryIPPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePrimaryIPPrototype) Equal(other *VirtualNetworkInterfacePrimaryIPPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContext) DeepCopy() *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContext) Equal(other *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByHref) DeepCopy() *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByHref) Equal(other *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID) DeepCopy() *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID) Equal(other *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPIdentityVirtualNetworkInterfacePrimaryIPContextByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPPrototypeVirtualNetworkInterfacePrimaryIPContext) DeepCopy() *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPPrototypeVirtualNetworkInterfacePrimaryIPContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPPrototypeVirtualNetworkInterfacePrimaryIPContext) Equal(other *VirtualNetworkInterfacePrimaryIPPrototypeReservedIPPrototypeVirtualNetworkInterfacePrimaryIPContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceReferenceAttachmentContext) DeepCopy() *VirtualNetworkInterfaceReferenceAttachmentContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceReferenceAttachmentContext) Equal(other *VirtualNetworkInterfaceReferenceAttachmentContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceTarget) DeepCopy() *VirtualNetworkInterfaceTarget {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceTarget) Equal(other *VirtualNetworkInterfaceTarget, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceTargetBareMetalServerNetworkAttachmentReferenceVirtualNetworkInterfaceContext) DeepCopy() *VirtualNetworkInterfaceTargetBareMetalServerNetworkAttachmentReferenceVirtualNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceTargetBareMetalServerNetworkAttachmentReferenceVirtualNetworkInterfaceContext) Equal(other *VirtualNetworkInterfaceTargetBareMetalServerNetworkAttachmentReferenceVirtualNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContext) DeepCopy() *VirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContext) Equal(other *VirtualNetworkInterfaceTargetInstanceNetworkAttachmentReferenceVirtualNetworkInterfaceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VirtualNetworkInterfaceTargetShareMountTargetReference) DeepCopy() *VirtualNetworkInterfaceTargetShareMountTargetReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VirtualNetworkInterfaceTargetShareMountTargetReference) Equal(other *VirtualNetworkInterfaceTargetShareMountTargetReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Volume) DeepCopy() *Volume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Volume) Equal(other *Volume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAllowedUse) DeepCopy() *VolumeAllowedUse {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAllowedUse) Equal(other *VolumeAllowedUse, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAllowedUsePatch) DeepCopy() *VolumeAllowedUsePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAllowedUsePatch) Equal(other *VolumeAllowedUsePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAllowedUsePrototype) DeepCopy() *VolumeAllowedUsePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAllowedUsePrototype) Equal(other *VolumeAllowedUsePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachment) DeepCopy() *VolumeAttachment {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachment) Equal(other *VolumeAttachment, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentCollection) DeepCopy() *VolumeAttachmentCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentCollection) Equal(other *VolumeAttachmentCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentDevice) DeepCopy() *VolumeAttachmentDevice {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentDevice) Equal(other *VolumeAttachmentDevice, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPatch) DeepCopy() *VolumeAttachmentPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPatch) Equal(other *VolumeAttachmentPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototype) DeepCopy() *VolumeAttachmentPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototype) Equal(other *VolumeAttachmentPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeInstanceByImageContext) DeepCopy() *VolumeAttachmentPrototypeInstanceByImageContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeInstanceByImageContext) Equal(other *VolumeAttachmentPrototypeInstanceByImageContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeInstanceBySourceSnapshotContext) DeepCopy() *VolumeAttachmentPrototypeInstanceBySourceSnapshotContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeInstanceBySourceSnapshotContext) Equal(other *VolumeAttachmentPrototypeInstanceBySourceSnapshotContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeInstanceByVolumeContext) DeepCopy() *VolumeAttachmentPrototypeInstanceByVolumeContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeInstanceByVolumeContext) Equal(other *VolumeAttachmentPrototypeInstanceByVolumeContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolume) DeepCopy() *VolumeAttachmentPrototypeVolume {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolume) Equal(other *VolumeAttachmentPrototypeVolume, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentity) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumeIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentity) Equal(other *VolumeAttachmentPrototypeVolumeVolumeIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByCRN) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByCRN) Equal(other *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByHref) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByHref) Equal(other *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByID) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByID) Equal(other *VolumeAttachmentPrototypeVolumeVolumeIdentityVolumeIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContext) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContext) Equal(other *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity) Equal(other *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeByCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeBySourceSnapshot) DeepCopy() *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeBySourceSnapshot) Equal(other *VolumeAttachmentPrototypeVolumeVolumePrototypeInstanceContextVolumePrototypeInstanceContextVolumeBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentReferenceInstanceContext) DeepCopy() *VolumeAttachmentReferenceInstanceContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentReferenceInstanceContext) Equal(other *VolumeAttachmentReferenceInstanceContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeAttachmentReferenceVolumeContext) DeepCopy() *VolumeAttachmentReferenceVolumeContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeAttachmentReferenceVolumeContext) Equal(other *VolumeAttachmentReferenceVolumeContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeCatalogOffering) DeepCopy() *VolumeCatalogOffering {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeCatalogOffering) Equal(other *VolumeCatalogOffering, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeCollection) DeepCopy() *VolumeCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeCollection) Equal(other *VolumeCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeHealthReason) DeepCopy() *VolumeHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeHealthReason) Equal(other *VolumeHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeIdentity) DeepCopy() *VolumeIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeIdentity) Equal(other *VolumeIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeIdentityByCRN) DeepCopy() *VolumeIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeIdentityByCRN) Equal(other *VolumeIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeIdentityByHref) DeepCopy() *VolumeIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeIdentityByHref) Equal(other *VolumeIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeIdentityByID) DeepCopy() *VolumeIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeIdentityByID) Equal(other *VolumeIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeInstanceProfileCollection) DeepCopy() *VolumeInstanceProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeInstanceProfileCollection) Equal(other *VolumeInstanceProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJob) DeepCopy() *VolumeJob {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJob) Equal(other *VolumeJob, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobCollection) DeepCopy() *VolumeJobCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobCollection) Equal(other *VolumeJobCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobPatch) DeepCopy() *VolumeJobPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobPatch) Equal(other *VolumeJobPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobPrototype) DeepCopy() *VolumeJobPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobPrototype) Equal(other *VolumeJobPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobPrototypeVolumeJobTypeMigratePrototype) DeepCopy() *VolumeJobPrototypeVolumeJobTypeMigratePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobPrototypeVolumeJobTypeMigratePrototype) Equal(other *VolumeJobPrototypeVolumeJobTypeMigratePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobStatusReason) DeepCopy() *VolumeJobStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobStatusReason) Equal(other *VolumeJobStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobTypeMigrate) DeepCopy() *VolumeJobTypeMigrate {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobTypeMigrate) Equal(other *VolumeJobTypeMigrate, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeJobTypeMigrateParameters) DeepCopy() *VolumeJobTypeMigrateParameters {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeJobTypeMigrateParameters) Equal(other *VolumeJobTypeMigrateParameters, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePatch) DeepCopy() *VolumePatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePatch) Equal(other *VolumePatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfile) DeepCopy() *VolumeProfile {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfile) Equal(other *VolumeProfile, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileAdjustableCapacityStates) DeepCopy() *VolumeProfileAdjustableCapacityStates {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileAdjustableCapacityStates) Equal(other *VolumeProfileAdjustableCapacityStates, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileAdjustableIopsStates) DeepCopy() *VolumeProfileAdjustableIopsStates {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileAdjustableIopsStates) Equal(other *VolumeProfileAdjustableIopsStates, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidth) DeepCopy() *VolumeProfileBandwidth {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidth) Equal(other *VolumeProfileBandwidth, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidthDependent) DeepCopy() *VolumeProfileBandwidthDependent {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidthDependent) Equal(other *VolumeProfileBandwidthDependent, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidthDependentRange) DeepCopy() *VolumeProfileBandwidthDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidthDependentRange) Equal(other *VolumeProfileBandwidthDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidthEnum) DeepCopy() *VolumeProfileBandwidthEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidthEnum) Equal(other *VolumeProfileBandwidthEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidthFixed) DeepCopy() *VolumeProfileBandwidthFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidthFixed) Equal(other *VolumeProfileBandwidthFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBandwidthRange) DeepCopy() *VolumeProfileBandwidthRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBandwidthRange) Equal(other *VolumeProfileBandwidthRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBootCapacity) DeepCopy() *VolumeProfileBootCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBootCapacity) Equal(other *VolumeProfileBootCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBootCapacityDependentRange) DeepCopy() *VolumeProfileBootCapacityDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBootCapacityDependentRange) Equal(other *VolumeProfileBootCapacityDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBootCapacityEnum) DeepCopy() *VolumeProfileBootCapacityEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBootCapacityEnum) Equal(other *VolumeProfileBootCapacityEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBootCapacityFixed) DeepCopy() *VolumeProfileBootCapacityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBootCapacityFixed) Equal(other *VolumeProfileBootCapacityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileBootCapacityRange) DeepCopy() *VolumeProfileBootCapacityRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileBootCapacityRange) Equal(other *VolumeProfileBootCapacityRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCapacity) DeepCopy() *VolumeProfileCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCapacity) Equal(other *VolumeProfileCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCapacityDependentRange) DeepCopy() *VolumeProfileCapacityDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCapacityDependentRange) Equal(other *VolumeProfileCapacityDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCapacityEnum) DeepCopy() *VolumeProfileCapacityEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCapacityEnum) Equal(other *VolumeProfileCapacityEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCapacityFixed) DeepCopy() *VolumeProfileCapacityFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCapacityFixed) Equal(other *VolumeProfileCapacityFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCapacityRange) DeepCopy() *VolumeProfileCapacityRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCapacityRange) Equal(other *VolumeProfileCapacityRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileCollection) DeepCopy() *VolumeProfileCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileCollection) Equal(other *VolumeProfileCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIdentity) DeepCopy() *VolumeProfileIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIdentity) Equal(other *VolumeProfileIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIdentityByHref) DeepCopy() *VolumeProfileIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIdentityByHref) Equal(other *VolumeProfileIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIdentityByName) DeepCopy() *VolumeProfileIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIdentityByName) Equal(other *VolumeProfileIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIops) DeepCopy() *VolumeProfileIops {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIops) Equal(other *VolumeProfileIops, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIopsDependentRange) DeepCopy() *VolumeProfileIopsDependentRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIopsDependentRange) Equal(other *VolumeProfileIopsDependentRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIopsEnum) DeepCopy() *VolumeProfileIopsEnum {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIopsEnum) Equal(other *VolumeProfileIopsEnum, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIopsFixed) DeepCopy() *VolumeProfileIopsFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIopsFixed) Equal(other *VolumeProfileIopsFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileIopsRange) DeepCopy() *VolumeProfileIopsRange {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileIopsRange) Equal(other *VolumeProfileIopsRange, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileReference) DeepCopy() *VolumeProfileReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileReference) Equal(other *VolumeProfileReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeProfileStorageGenerationFixed) DeepCopy() *VolumeProfileStorageGenerationFixed {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeProfileStorageGenerationFixed) Equal(other *VolumeProfileStorageGenerationFixed, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePrototype) DeepCopy() *VolumePrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePrototype) Equal(other *VolumePrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePrototypeInstanceByImageContext) DeepCopy() *VolumePrototypeInstanceByImageContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePrototypeInstanceByImageContext) Equal(other *VolumePrototypeInstanceByImageContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePrototypeInstanceBySourceSnapshotContext) DeepCopy() *VolumePrototypeInstanceBySourceSnapshotContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePrototypeInstanceBySourceSnapshotContext) Equal(other *VolumePrototypeInstanceBySourceSnapshotContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePrototypeVolumeByCapacity) DeepCopy() *VolumePrototypeVolumeByCapacity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePrototypeVolumeByCapacity) Equal(other *VolumePrototypeVolumeByCapacity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumePrototypeVolumeBySourceSnapshot) DeepCopy() *VolumePrototypeVolumeBySourceSnapshot {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumePrototypeVolumeBySourceSnapshot) Equal(other *VolumePrototypeVolumeBySourceSnapshot, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeReference) DeepCopy() *VolumeReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeReference) Equal(other *VolumeReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeReferenceVolumeAttachmentContext) DeepCopy() *VolumeReferenceVolumeAttachmentContext {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeReferenceVolumeAttachmentContext) Equal(other *VolumeReferenceVolumeAttachmentContext, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeRemote) DeepCopy() *VolumeRemote {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeRemote) Equal(other *VolumeRemote, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VolumeStatusReason) DeepCopy() *VolumeStatusReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VolumeStatusReason) Equal(other *VolumeStatusReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpccseSourceIP) DeepCopy() *VpccseSourceIP {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpccseSourceIP) Equal(other *VpccseSourceIP, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Vpcdns) DeepCopy() *Vpcdns {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Vpcdns) Equal(other *Vpcdns, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsPatch) DeepCopy() *VpcdnsPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsPatch) Equal(other *VpcdnsPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsPrototype) DeepCopy() *VpcdnsPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsPrototype) Equal(other *VpcdnsPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolutionBinding) DeepCopy() *VpcdnsResolutionBinding {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolutionBinding) Equal(other *VpcdnsResolutionBinding, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolutionBindingCollection) DeepCopy() *VpcdnsResolutionBindingCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolutionBindingCollection) Equal(other *VpcdnsResolutionBindingCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolutionBindingHealthReason) DeepCopy() *VpcdnsResolutionBindingHealthReason {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolutionBindingHealthReason) Equal(other *VpcdnsResolutionBindingHealthReason, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolutionBindingPatch) DeepCopy() *VpcdnsResolutionBindingPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolutionBindingPatch) Equal(other *VpcdnsResolutionBindingPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolver) DeepCopy() *VpcdnsResolver {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolver) Equal(other *VpcdnsResolver, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverPatch) DeepCopy() *VpcdnsResolverPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverPatch) Equal(other *VpcdnsResolverPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverPrototype) DeepCopy() *VpcdnsResolverPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverPrototype) Equal(other *VpcdnsResolverPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverPrototypeVpcdnsResolverTypeManualPrototype) DeepCopy() *VpcdnsResolverPrototypeVpcdnsResolverTypeManualPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverPrototypeVpcdnsResolverTypeManualPrototype) Equal(other *VpcdnsResolverPrototypeVpcdnsResolverTypeManualPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverPrototypeVpcdnsResolverTypeSystemPrototype) DeepCopy() *VpcdnsResolverPrototypeVpcdnsResolverTypeSystemPrototype {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverPrototypeVpcdnsResolverTypeSystemPrototype) Equal(other *VpcdnsResolverPrototypeVpcdnsResolverTypeSystemPrototype, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverTypeDelegated) DeepCopy() *VpcdnsResolverTypeDelegated {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverTypeDelegated) Equal(other *VpcdnsResolverTypeDelegated, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverTypeManual) DeepCopy() *VpcdnsResolverTypeManual {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverTypeManual) Equal(other *VpcdnsResolverTypeManual, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverTypeSystem) DeepCopy() *VpcdnsResolverTypeSystem {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverTypeSystem) Equal(other *VpcdnsResolverTypeSystem, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverVPCPatch) DeepCopy() *VpcdnsResolverVPCPatch {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverVPCPatch) Equal(other *VpcdnsResolverVPCPatch, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverVPCPatchVPCIdentityByCRN) DeepCopy() *VpcdnsResolverVPCPatchVPCIdentityByCRN {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverVPCPatchVPCIdentityByCRN) Equal(other *VpcdnsResolverVPCPatchVPCIdentityByCRN, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverVPCPatchVPCIdentityByHref) DeepCopy() *VpcdnsResolverVPCPatchVPCIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverVPCPatchVPCIdentityByHref) Equal(other *VpcdnsResolverVPCPatchVPCIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *VpcdnsResolverVPCPatchVPCIdentityByID) DeepCopy() *VpcdnsResolverVPCPatchVPCIdentityByID {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *VpcdnsResolverVPCPatchVPCIdentityByID) Equal(other *VpcdnsResolverVPCPatchVPCIdentityByID, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *Zone) DeepCopy() *Zone {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *Zone) Equal(other *Zone, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ZoneCollection) DeepCopy() *ZoneCollection {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ZoneCollection) Equal(other *ZoneCollection, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ZoneIdentity) DeepCopy() *ZoneIdentity {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ZoneIdentity) Equal(other *ZoneIdentity, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ZoneIdentityByHref) DeepCopy() *ZoneIdentityByHref {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ZoneIdentityByHref) Equal(other *ZoneIdentityByHref, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ZoneIdentityByName) DeepCopy() *ZoneIdentityByName {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ZoneIdentityByName) Equal(other *ZoneIdentityByName, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
+
+// DeepCopy : Return a deep copy of the model, sharing nothing with it
+func (model *ZoneReference) DeepCopy() *ZoneReference {
+	return deepCopy(model)
+}
+
+// Equal : Return true if the model is semantically equal to "other" (see ModelsEqual)
+func (model *ZoneReference) Equal(other *ZoneReference, options ...EqualOption) bool {
+	return modelsEqual(model, other, options)
+}
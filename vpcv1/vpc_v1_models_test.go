@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testRule and testRuleAll are a discriminated union and one of its variants, in the shape of the
+// generated ones.
+type testRule struct {
+	Protocol *string `json:"protocol"`
+}
+
+type testRuleAll struct {
+	Protocol *string  `json:"protocol"`
+	Ports    []int64  `json:"ports,omitempty"`
+	Remote   testRule `json:"remote"`
+}
+
+type testRuleIntf interface {
+	isaTestRule() bool
+}
+
+func (*testRule) isaTestRule() bool {
+	return true
+}
+
+func (*testRuleAll) isaTestRule() bool {
+	return true
+}
+
+var _ = Describe(`Model copies and comparisons`, func() {
+	var instance *vpcv1.Instance
+	BeforeEach(func() {
+		createdAt := strfmt.DateTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+		instance = &vpcv1.Instance{
+			CreatedAt:      &createdAt,
+			CRN:            core.StringPtr("crn:v1:instance-1"),
+			Href:           core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/instances/instance-1"),
+			ID:             core.StringPtr("instance-1"),
+			LifecycleState: core.StringPtr(vpcv1.InstanceLifecycleStateStableConst),
+			Name:           core.StringPtr("web-1"),
+			Zone:           &vpcv1.ZoneReference{Name: core.StringPtr("us-south-1")},
+		}
+	})
+
+	It(`Should copy a model without sharing anything`, func() {
+		copied := vpcv1.DeepCopyModel(instance)
+		Expect(copied).To(Equal(instance))
+		Expect(copied).ToNot(BeIdenticalTo(instance))
+		Expect(copied.Name).ToNot(BeIdenticalTo(instance.Name))
+		Expect(copied.Zone).ToNot(BeIdenticalTo(instance.Zone))
+
+		*copied.Zone.Name = "us-south-2"
+		Expect(*instance.Zone.Name).To(Equal("us-south-1"))
+		Expect(vpcv1.DeepCopyModel[*vpcv1.Instance](nil)).To(BeNil())
+	})
+	It(`Should copy union values and slices of them`, func() {
+		rules := []testRuleIntf{
+			&testRuleAll{Protocol: core.StringPtr("all"), Ports: []int64{22}, Remote: testRule{Protocol: core.StringPtr("tcp")}},
+			&testRule{Protocol: core.StringPtr("icmp")},
+			nil,
+		}
+		copied := vpcv1.DeepCopyModel(rules)
+		Expect(copied).To(Equal(rules))
+		copied[0].(*testRuleAll).Ports[0] = 80
+		*copied[0].(*testRuleAll).Remote.Protocol = "udp"
+		Expect(rules[0].(*testRuleAll).Ports).To(Equal([]int64{22}))
+		Expect(*rules[0].(*testRuleAll).Remote.Protocol).To(Equal("tcp"))
+
+		var rule testRuleIntf
+		Expect(vpcv1.DeepCopyModel(rule)).To(BeNil())
+	})
+	It(`Should compare the values of the models`, func() {
+		copied := vpcv1.DeepCopyModel(instance)
+		Expect(vpcv1.ModelsEqual(instance, copied)).To(BeTrue())
+
+		// Dates are compared as instants.
+		createdAt := strfmt.DateTime(time.Time(*instance.CreatedAt).In(time.FixedZone("CET", 3600)))
+		copied.CreatedAt = &createdAt
+		Expect(vpcv1.ModelsEqual(instance, copied)).To(BeTrue())
+
+		copied.Zone.Name = core.StringPtr("us-south-2")
+		Expect(vpcv1.ModelsEqual(instance, copied)).To(BeFalse())
+		copied.Zone = nil
+		Expect(vpcv1.ModelsEqual(instance, copied)).To(BeFalse())
+		Expect(vpcv1.ModelsEqual(instance, nil)).To(BeFalse())
+		Expect(vpcv1.ModelsEqual((*vpcv1.Instance)(nil), (*vpcv1.Instance)(nil))).To(BeTrue())
+		Expect(vpcv1.ModelsEqual(instance, &vpcv1.VPCReference{})).To(BeFalse())
+	})
+	It(`Should ignore the server-managed properties with an option`, func() {
+		desired := &vpcv1.Instance{
+			Name: core.StringPtr("web-1"),
+			Zone: &vpcv1.ZoneReference{Name: core.StringPtr("us-south-1")},
+		}
+		Expect(vpcv1.ModelsEqual(desired, instance)).To(BeFalse())
+		Expect(vpcv1.ModelsEqual(desired, instance, vpcv1.IgnoreServerManaged(), vpcv1.IgnoreProperties("id"))).To(BeTrue())
+
+		// The properties are ignored at any depth.
+		instance.Zone.Href = core.StringPtr("https://us-south.iaas.cloud.ibm.com/v1/regions/us-south/zones/us-south-1")
+		Expect(vpcv1.ModelsEqual(desired, instance, vpcv1.IgnoreServerManaged(), vpcv1.IgnoreProperties("id"))).To(BeTrue())
+		Expect(vpcv1.ModelsEqual(desired, instance, vpcv1.IgnoreProperties("created_at", "crn", "id", "lifecycle_state"))).To(BeFalse())
+	})
+	It(`Should compare the variants of union values`, func() {
+		a := []testRuleIntf{&testRuleAll{Protocol: core.StringPtr("all"), Ports: []int64{}}}
+		b := []testRuleIntf{&testRuleAll{Protocol: core.StringPtr("all")}}
+		Expect(vpcv1.ModelsEqual(a, b)).To(BeTrue())
+
+		b[0].(*testRuleAll).Ports = []int64{22}
+		Expect(vpcv1.ModelsEqual(a, b)).To(BeFalse())
+		Expect(vpcv1.ModelsEqual(a, []testRuleIntf{&testRule{Protocol: core.StringPtr("all")}})).To(BeFalse())
+	})
+})
@@ -0,0 +1,329 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation : A handle on an asynchronous create or delete request.
+// The VPC API accepts such requests before the resource reaches its final state. An Operation
+// remembers which resource the request was for and how to tell whether the request has finished,
+// so that callers can start many requests and then wait on each of them.
+//
+// For a create operation, Wait returns the resource in its final state. For a delete operation,
+// Wait returns the zero value of T once the resource no longer exists.
+//
+// An Operation is safe for concurrent use.
+type Operation[T any] struct {
+	// The unique identifier of the resource the operation applies to.
+	ID string
+
+	// The URL of the resource the operation applies to.
+	Href string
+
+	refresh       refreshFunc[T]
+	waiterOptions *WaiterOptions
+
+	mutex  sync.Mutex
+	done   bool
+	result T
+	err    error
+}
+
+// newOperation returns an Operation for resource "id" that is tracked with "refresh".
+func newOperation[T any](id string, href string, refresh refreshFunc[T]) *Operation[T] {
+	return &Operation[T]{
+		ID:      id,
+		Href:    href,
+		refresh: refresh,
+	}
+}
+
+// SetWaiterOptions : Allow user to set the WaiterOptions used by Wait
+func (operation *Operation[T]) SetWaiterOptions(waiterOptions *WaiterOptions) *Operation[T] {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	operation.waiterOptions = waiterOptions
+	return operation
+}
+
+// Poll : Check once whether the operation has finished
+// Retrieves the resource and returns its latest copy, and whether the operation has finished.
+// A non-nil error with done set to false means the check itself failed. The check may be tried
+// again if the failure is transient, as Wait does: a network problem, or an error response with
+// the status code 429 Too Many Requests or 5xx. Any other failure, such as a 403 Forbidden
+// response, is unlikely to go away. A non-nil error with done set to true means the operation has
+// finished unsuccessfully. Once the operation has finished, Poll returns the final outcome without
+// contacting the service again.
+func (operation *Operation[T]) Poll(ctx context.Context) (result T, done bool, err error) {
+	operation.mutex.Lock()
+	if operation.done {
+		defer operation.mutex.Unlock()
+		return operation.result, true, operation.err
+	}
+	operation.mutex.Unlock()
+
+	result, done, err = operation.refresh(ctx)
+
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	if done && !operation.done {
+		operation.done = true
+		operation.result = result
+		operation.err = err
+	}
+	return
+}
+
+// Done : Return true if the operation is known to have finished
+// Done does not contact the service; it reflects the outcome of the last Poll or Wait.
+func (operation *Operation[T]) Done() bool {
+	operation.mutex.Lock()
+	defer operation.mutex.Unlock()
+	return operation.done
+}
+
+// Wait : Wait for the operation to finish
+// Polls the resource according to the operation's WaiterOptions until the operation finishes, a
+// poll fails with an error that is not transient (see Poll), "ctx" is cancelled, or the timeout
+// expires. The polls failing with a transient error are tried again.
+func (operation *Operation[T]) Wait(ctx context.Context) (result T, err error) {
+	operation.mutex.Lock()
+	waiterOptions := operation.waiterOptions
+	operation.mutex.Unlock()
+
+	return waitFor(ctx, waiterOptions, operation.Poll)
+}
+
+// resourceHref returns the URL of the resource "id" within collection "path" on this service.
+func (vpc *VpcV1) resourceHref(path string, id string) string {
+	return strings.TrimSuffix(vpc.GetServiceURL(), "/") + path + "/" + url.PathEscape(id)
+}
+
+// CreateInstanceAsync : Create an instance and return a handle on the request
+// Like CreateInstance, but also returns an Operation that finishes when the instance's `status`
+// is `running`.
+func (vpc *VpcV1) CreateInstanceAsync(createInstanceOptions *CreateInstanceOptions) (operation *Operation[*Instance], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.CreateInstanceAsyncWithContext(context.Background(), createInstanceOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateInstanceAsyncWithContext is an alternate form of the CreateInstanceAsync method which supports a Context parameter
+func (vpc *VpcV1) CreateInstanceAsyncWithContext(ctx context.Context, createInstanceOptions *CreateInstanceOptions) (operation *Operation[*Instance], response *core.DetailedResponse, err error) {
+	instance, response, err := vpc.CreateInstanceWithContext(ctx, createInstanceOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := core.StringNilMapper(instance.ID)
+	operation = newOperation(id, core.StringNilMapper(instance.Href), vpc.instanceStatusRefresh(id, InstanceStatusRunningConst))
+	return
+}
+
+// DeleteInstanceAsync : Delete an instance and return a handle on the request
+// Like DeleteInstance, but also returns an Operation that finishes when the instance no longer exists.
+func (vpc *VpcV1) DeleteInstanceAsync(deleteInstanceOptions *DeleteInstanceOptions) (operation *Operation[*Instance], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.DeleteInstanceAsyncWithContext(context.Background(), deleteInstanceOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteInstanceAsyncWithContext is an alternate form of the DeleteInstanceAsync method which supports a Context parameter
+func (vpc *VpcV1) DeleteInstanceAsyncWithContext(ctx context.Context, deleteInstanceOptions *DeleteInstanceOptions) (operation *Operation[*Instance], response *core.DetailedResponse, err error) {
+	response, err = vpc.DeleteInstanceWithContext(ctx, deleteInstanceOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := *deleteInstanceOptions.ID
+	operation = newOperation(id, vpc.resourceHref("/instances", id), vpc.instanceDeletedRefresh(id))
+	return
+}
+
+// CreateVolumeAsync : Create a volume and return a handle on the request
+// Like CreateVolume, but also returns an Operation that finishes when the volume's `status` is
+// `available`.
+func (vpc *VpcV1) CreateVolumeAsync(createVolumeOptions *CreateVolumeOptions) (operation *Operation[*Volume], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.CreateVolumeAsyncWithContext(context.Background(), createVolumeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateVolumeAsyncWithContext is an alternate form of the CreateVolumeAsync method which supports a Context parameter
+func (vpc *VpcV1) CreateVolumeAsyncWithContext(ctx context.Context, createVolumeOptions *CreateVolumeOptions) (operation *Operation[*Volume], response *core.DetailedResponse, err error) {
+	volume, response, err := vpc.CreateVolumeWithContext(ctx, createVolumeOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := core.StringNilMapper(volume.ID)
+	operation = newOperation(id, core.StringNilMapper(volume.Href), vpc.volumeAvailableRefresh(id))
+	return
+}
+
+// DeleteVolumeAsync : Delete a volume and return a handle on the request
+// Like DeleteVolume, but also returns an Operation that finishes when the volume no longer exists.
+func (vpc *VpcV1) DeleteVolumeAsync(deleteVolumeOptions *DeleteVolumeOptions) (operation *Operation[*Volume], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.DeleteVolumeAsyncWithContext(context.Background(), deleteVolumeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteVolumeAsyncWithContext is an alternate form of the DeleteVolumeAsync method which supports a Context parameter
+func (vpc *VpcV1) DeleteVolumeAsyncWithContext(ctx context.Context, deleteVolumeOptions *DeleteVolumeOptions) (operation *Operation[*Volume], response *core.DetailedResponse, err error) {
+	response, err = vpc.DeleteVolumeWithContext(ctx, deleteVolumeOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := *deleteVolumeOptions.ID
+	operation = newOperation(id, vpc.resourceHref("/volumes", id), vpc.volumeDeletedRefresh(id))
+	return
+}
+
+// CreateLoadBalancerAsync : Create a load balancer and return a handle on the request
+// Like CreateLoadBalancer, but also returns an Operation that finishes when the load balancer's
+// `provisioning_status` is `active`.
+func (vpc *VpcV1) CreateLoadBalancerAsync(createLoadBalancerOptions *CreateLoadBalancerOptions) (operation *Operation[*LoadBalancer], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.CreateLoadBalancerAsyncWithContext(context.Background(), createLoadBalancerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateLoadBalancerAsyncWithContext is an alternate form of the CreateLoadBalancerAsync method which supports a Context parameter
+func (vpc *VpcV1) CreateLoadBalancerAsyncWithContext(ctx context.Context, createLoadBalancerOptions *CreateLoadBalancerOptions) (operation *Operation[*LoadBalancer], response *core.DetailedResponse, err error) {
+	loadBalancer, response, err := vpc.CreateLoadBalancerWithContext(ctx, createLoadBalancerOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := core.StringNilMapper(loadBalancer.ID)
+	operation = newOperation(id, core.StringNilMapper(loadBalancer.Href), vpc.loadBalancerActiveRefresh(id))
+	return
+}
+
+// DeleteLoadBalancerAsync : Delete a load balancer and return a handle on the request
+// Like DeleteLoadBalancer, but also returns an Operation that finishes when the load balancer no
+// longer exists.
+func (vpc *VpcV1) DeleteLoadBalancerAsync(deleteLoadBalancerOptions *DeleteLoadBalancerOptions) (operation *Operation[*LoadBalancer], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.DeleteLoadBalancerAsyncWithContext(context.Background(), deleteLoadBalancerOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteLoadBalancerAsyncWithContext is an alternate form of the DeleteLoadBalancerAsync method which supports a Context parameter
+func (vpc *VpcV1) DeleteLoadBalancerAsyncWithContext(ctx context.Context, deleteLoadBalancerOptions *DeleteLoadBalancerOptions) (operation *Operation[*LoadBalancer], response *core.DetailedResponse, err error) {
+	response, err = vpc.DeleteLoadBalancerWithContext(ctx, deleteLoadBalancerOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := *deleteLoadBalancerOptions.ID
+	operation = newOperation(id, vpc.resourceHref("/load_balancers", id), vpc.loadBalancerDeletedRefresh(id))
+	return
+}
+
+// CreateVPNGatewayAsync : Create a VPN gateway and return a handle on the request
+// Like CreateVPNGateway, but also returns an Operation that finishes when the VPN gateway's
+// `lifecycle_state` is `stable`.
+func (vpc *VpcV1) CreateVPNGatewayAsync(createVPNGatewayOptions *CreateVPNGatewayOptions) (operation *Operation[VPNGatewayIntf], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.CreateVPNGatewayAsyncWithContext(context.Background(), createVPNGatewayOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateVPNGatewayAsyncWithContext is an alternate form of the CreateVPNGatewayAsync method which supports a Context parameter
+func (vpc *VpcV1) CreateVPNGatewayAsyncWithContext(ctx context.Context, createVPNGatewayOptions *CreateVPNGatewayOptions) (operation *Operation[VPNGatewayIntf], response *core.DetailedResponse, err error) {
+	vpnGateway, response, err := vpc.CreateVPNGatewayWithContext(ctx, createVPNGatewayOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	gatewayID, gatewayHref, _, _ := vpnGatewayFields(vpnGateway)
+	id := core.StringNilMapper(gatewayID)
+	operation = newOperation(id, core.StringNilMapper(gatewayHref), vpc.vpnGatewayStableRefresh(id))
+	return
+}
+
+// DeleteVPNGatewayAsync : Delete a VPN gateway and return a handle on the request
+// Like DeleteVPNGateway, but also returns an Operation that finishes when the VPN gateway no longer
+// exists.
+func (vpc *VpcV1) DeleteVPNGatewayAsync(deleteVPNGatewayOptions *DeleteVPNGatewayOptions) (operation *Operation[VPNGatewayIntf], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.DeleteVPNGatewayAsyncWithContext(context.Background(), deleteVPNGatewayOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteVPNGatewayAsyncWithContext is an alternate form of the DeleteVPNGatewayAsync method which supports a Context parameter
+func (vpc *VpcV1) DeleteVPNGatewayAsyncWithContext(ctx context.Context, deleteVPNGatewayOptions *DeleteVPNGatewayOptions) (operation *Operation[VPNGatewayIntf], response *core.DetailedResponse, err error) {
+	response, err = vpc.DeleteVPNGatewayWithContext(ctx, deleteVPNGatewayOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := *deleteVPNGatewayOptions.ID
+	operation = newOperation(id, vpc.resourceHref("/vpn_gateways", id), vpc.vpnGatewayDeletedRefresh(id))
+	return
+}
+
+// CreateSnapshotAsync : Create a snapshot and return a handle on the request
+// Like CreateSnapshot, but also returns an Operation that finishes when the snapshot's
+// `lifecycle_state` is `stable`.
+func (vpc *VpcV1) CreateSnapshotAsync(createSnapshotOptions *CreateSnapshotOptions) (operation *Operation[*Snapshot], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.CreateSnapshotAsyncWithContext(context.Background(), createSnapshotOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateSnapshotAsyncWithContext is an alternate form of the CreateSnapshotAsync method which supports a Context parameter
+func (vpc *VpcV1) CreateSnapshotAsyncWithContext(ctx context.Context, createSnapshotOptions *CreateSnapshotOptions) (operation *Operation[*Snapshot], response *core.DetailedResponse, err error) {
+	snapshot, response, err := vpc.CreateSnapshotWithContext(ctx, createSnapshotOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := core.StringNilMapper(snapshot.ID)
+	operation = newOperation(id, core.StringNilMapper(snapshot.Href), vpc.snapshotStableRefresh(id))
+	return
+}
+
+// DeleteSnapshotAsync : Delete a snapshot and return a handle on the request
+// Like DeleteSnapshot, but also returns an Operation that finishes when the snapshot no longer exists.
+func (vpc *VpcV1) DeleteSnapshotAsync(deleteSnapshotOptions *DeleteSnapshotOptions) (operation *Operation[*Snapshot], response *core.DetailedResponse, err error) {
+	operation, response, err = vpc.DeleteSnapshotAsyncWithContext(context.Background(), deleteSnapshotOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// DeleteSnapshotAsyncWithContext is an alternate form of the DeleteSnapshotAsync method which supports a Context parameter
+func (vpc *VpcV1) DeleteSnapshotAsyncWithContext(ctx context.Context, deleteSnapshotOptions *DeleteSnapshotOptions) (operation *Operation[*Snapshot], response *core.DetailedResponse, err error) {
+	response, err = vpc.DeleteSnapshotWithContext(ctx, deleteSnapshotOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	id := *deleteSnapshotOptions.ID
+	operation = newOperation(id, vpc.resourceHref("/snapshots", id), vpc.snapshotDeletedRefresh(id))
+	return
+}
@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var testVolumePrototype = &vpcv1.VolumePrototypeVolumeByCapacity{
+	Capacity: core.Int64Ptr(100),
+	Profile:  &vpcv1.VolumeProfileIdentityByName{Name: core.StringPtr("general-purpose")},
+	Zone:     &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")},
+}
+
+var _ = Describe(`Operations`, func() {
+	Describe(`CreateVolumeAsync`, func() {
+		It(`Should return an operation that finishes when the volume is available`, func() {
+			var mutex sync.Mutex
+			polls := map[string]int{}
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == http.MethodPost {
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"id": "v-1", "href": "https://vpc/volumes/v-1", "status": "pending"}`)
+					return
+				}
+				mutex.Lock()
+				polls[req.URL.Path]++
+				n := polls[req.URL.Path]
+				mutex.Unlock()
+				status := "pending"
+				if n > 1 {
+					status = "available"
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "v-1", "status": "%s", "health_state": "ok"}`, status)
+			}))
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			operation, response, err := vpcService.CreateVolumeAsync(vpcService.NewCreateVolumeOptions(testVolumePrototype))
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(operation.ID).To(Equal("v-1"))
+			Expect(operation.Href).To(Equal("https://vpc/volumes/v-1"))
+			Expect(operation.Done()).To(BeFalse())
+
+			volume, done, err := operation.Poll(context.Background())
+			Expect(err).To(BeNil())
+			Expect(done).To(BeFalse())
+			Expect(*volume.Status).To(Equal("pending"))
+
+			volume, err = operation.SetWaiterOptions(fastWaiter).Wait(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*volume.Status).To(Equal("available"))
+			Expect(operation.Done()).To(BeTrue())
+
+			// A finished operation does not poll again.
+			_, _, _ = operation.Poll(context.Background())
+			Expect(polls["/volumes/v-1"]).To(Equal(2))
+		})
+		It(`Should try the polls failing with a transient error again`, func() {
+			var mutex sync.Mutex
+			polls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				if req.Method == http.MethodPost {
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"id": "v-1", "href": "https://vpc/volumes/v-1", "status": "pending"}`)
+					return
+				}
+				mutex.Lock()
+				polls++
+				n := polls
+				mutex.Unlock()
+				switch n {
+				case 1:
+					res.WriteHeader(503)
+					fmt.Fprint(res, `{"errors": [{"code": "service_unavailable", "message": "unavailable"}]}`)
+				case 2:
+					res.WriteHeader(403)
+					fmt.Fprint(res, `{"errors": [{"code": "forbidden", "message": "forbidden"}]}`)
+				default:
+					res.WriteHeader(200)
+					fmt.Fprint(res, `{"id": "v-1", "status": "available", "health_state": "ok"}`)
+				}
+			}))
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			operation, _, err := vpcService.CreateVolumeAsync(vpcService.NewCreateVolumeOptions(testVolumePrototype))
+			Expect(err).To(BeNil())
+
+			// The 503 response is tried again, the 403 response ends the wait.
+			_, err = operation.SetWaiterOptions(fastWaiter).Wait(context.Background())
+			apiErr, ok := vpcv1.ParseAPIError(err)
+			Expect(ok).To(BeTrue())
+			Expect(apiErr.StatusCode).To(Equal(403))
+			Expect(operation.Done()).To(BeFalse())
+
+			volume, err := operation.Wait(context.Background())
+			Expect(err).To(BeNil())
+			Expect(*volume.Status).To(Equal("available"))
+			Expect(polls).To(Equal(3))
+		})
+		It(`Should not return an operation when the request fails`, func() {
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				res.Header().Set("Content-type", "application/json")
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"errors": [{"code": "validation_invalid_argument", "message": "bad"}]}`)
+			}))
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			operation, response, err := vpcService.CreateVolumeAsync(vpcService.NewCreateVolumeOptions(testVolumePrototype))
+			Expect(err).ToNot(BeNil())
+			Expect(operation).To(BeNil())
+			Expect(response.StatusCode).To(Equal(400))
+		})
+	})
+	Describe(`DeleteInstanceAsync`, func() {
+		It(`Should return operations that can be joined`, func() {
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				if req.Method == http.MethodDelete {
+					res.WriteHeader(204)
+					return
+				}
+				res.WriteHeader(404)
+			}))
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			var operations []*vpcv1.Operation[*vpcv1.Instance]
+			for _, id := range []string{"i-1", "i-2", "i-3"} {
+				operation, _, err := vpcService.DeleteInstanceAsync(vpcService.NewDeleteInstanceOptions(id))
+				Expect(err).To(BeNil())
+				Expect(operation.Href).To(Equal(server.URL + "/instances/" + id))
+				operations = append(operations, operation)
+			}
+
+			var wg sync.WaitGroup
+			errs := make([]error, len(operations))
+			for i, operation := range operations {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, errs[i] = operation.SetWaiterOptions(fastWaiter).Wait(context.Background())
+				}()
+			}
+			wg.Wait()
+			Expect(errors.Join(errs...)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,250 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// PageFunc : Retrieve the page of a list operation starting at "start"
+// "start" is nil for the first page, and "limit" is nil for the default page size of the
+// operation. The function returns the items of the page and the start token of the next page, or
+// nil if it is the last page.
+type PageFunc[T any] func(ctx context.Context, start *string, limit *int64) (page []T, next *string, err error)
+
+// PageHook : A function called with each page retrieved by a Pager, before it is returned
+// An error is returned instead of the page, and the pager is not advanced: the next call retrieves
+// the page again.
+type PageHook[T any] func(ctx context.Context, page []T) error
+
+// Pager : Retrieve the pages of a list operation
+// A Pager retrieves the pages with a PageFunc, and adds the behavior shared by all list operations:
+// a page size, a maximum number of items, the retrieval of the next page in the background while
+// the current one is processed, and hooks called with each page. The pagers of the list operations
+// of VpcV1, such as InstancesPager, are aliases of Pager created by their constructor (for example
+// NewInstancesPager).
+//
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	// The number of items per page, or 0 for the default page size of the operation.
+	PageSize int64
+
+	// The maximum number of items returned, or 0 for no limit.
+	MaxItems int64
+
+	// Whether the next page is retrieved in the background when a page is returned.
+	Prefetch bool
+
+	// The hooks called with each page, in order.
+	PageHooks []PageHook[T]
+
+	// The type of the pager and the options of its list operation, for its checkpoints.
+	name    string
+	options interface{}
+
+	fetch    PageFunc[T]
+	hasNext  bool
+	start    *string
+	count    int64
+	prefetch chan pageResult[T]
+}
+
+// pageResult is the result of the retrieval of a page.
+type pageResult[T any] struct {
+	page []T
+	next *string
+	err  error
+}
+
+var _ PageSource[Instance] = (*Pager[Instance])(nil)
+
+// NewPager : Instantiate Pager retrieving the pages with "fetch"
+func NewPager[T any](fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{
+		fetch:   fetch,
+		hasNext: true,
+	}
+}
+
+// newListPager returns the Pager of type "name", such as "InstancesPager", over the list operation
+// with "options", retrieving the pages with "fetch". It is called by the generated constructors.
+func newListPager[T any](name string, options interface{}, fetch PageFunc[T]) *Pager[T] {
+	pager := NewPager(fetch)
+	pager.name = name
+	pager.options = options
+	return pager
+}
+
+// SetPageSize : Allow user to set PageSize
+func (pager *Pager[T]) SetPageSize(pageSize int64) *Pager[T] {
+	pager.PageSize = pageSize
+	return pager
+}
+
+// SetMaxItems : Allow user to set MaxItems
+func (pager *Pager[T]) SetMaxItems(maxItems int64) *Pager[T] {
+	pager.MaxItems = maxItems
+	return pager
+}
+
+// SetPrefetch : Allow user to set Prefetch
+// The next page is retrieved with the context of the call that returned the current page.
+func (pager *Pager[T]) SetPrefetch(prefetch bool) *Pager[T] {
+	pager.Prefetch = prefetch
+	return pager
+}
+
+// AddPageHooks : Call the specified hooks with each page, after the current ones
+func (pager *Pager[T]) AddPageHooks(hooks ...PageHook[T]) *Pager[T] {
+	pager.PageHooks = append(pager.PageHooks, hooks...)
+	return pager
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *Pager[T]) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results.
+func (pager *Pager[T]) GetNextWithContext(ctx context.Context) (page []T, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	var result pageResult[T]
+	if pager.prefetch != nil {
+		select {
+		case result = <-pager.prefetch:
+			pager.prefetch = nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// Retrieve the page again if the context of the prefetch was canceled since.
+		if result.err != nil && (errors.Is(result.err, context.Canceled) || errors.Is(result.err, context.DeadlineExceeded)) {
+			result = pager.fetchPage(ctx, pager.start, pager.limit())
+		}
+	} else {
+		result = pager.fetchPage(ctx, pager.start, pager.limit())
+	}
+	if result.err != nil {
+		err = core.RepurposeSDKProblem(result.err, "error-getting-next-page")
+		return
+	}
+
+	page = result.page
+	if pager.MaxItems > 0 && pager.count+int64(len(page)) >= pager.MaxItems {
+		page = page[:pager.MaxItems-pager.count]
+		result.next = nil
+	}
+
+	// The pager is advanced once the hooks accept the page, so that it can be retrieved again.
+	for _, hook := range pager.PageHooks {
+		if err = hook(ctx, page); err != nil {
+			err = core.SDKErrorf(err, "", "page-hook-error", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	pager.count += int64(len(page))
+	pager.start = result.next
+	pager.hasNext = result.next != nil
+
+	if pager.Prefetch && pager.hasNext {
+		prefetch := make(chan pageResult[T], 1)
+		start, limit := pager.start, pager.limit()
+		go func() {
+			prefetch <- pager.fetchPage(ctx, start, limit)
+		}()
+		pager.prefetch = prefetch
+	}
+	return
+}
+
+// limit returns the size of the next page, or nil for the default page size of the operation.
+func (pager *Pager[T]) limit() (limit *int64) {
+	if pager.PageSize > 0 {
+		limit = core.Int64Ptr(pager.PageSize)
+	}
+	if pager.MaxItems > 0 {
+		remaining := pager.MaxItems - pager.count
+		if limit == nil || *limit > remaining {
+			limit = core.Int64Ptr(remaining)
+		}
+	}
+	return
+}
+
+// fetchPage retrieves the page at "start". It does not use the state of the pager, so that it can
+// run in the background.
+func (pager *Pager[T]) fetchPage(ctx context.Context, start *string, limit *int64) (result pageResult[T]) {
+	result.page, result.next, result.err = pager.fetch(ctx, start, limit)
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *Pager[T]) GetAllWithContext(ctx context.Context) (allItems []T, err error) {
+	for pager.HasNext() {
+		var nextPage []T
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *Pager[T]) GetNext() (page []T, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *Pager[T]) GetAll() (allItems []T, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// All : Return an iterator over the items of the remaining pages
+// Pages are retrieved with "ctx" as the iteration progresses (see PagerItems).
+func (pager *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return PagerItems[T](ctx, pager)
+}
+
+// GetStartToken : Return the start token in the URL of the next page of a list operation, or nil
+// if there is no next page
+func GetStartToken(next *string) (start *string, err error) {
+	if next == nil {
+		return nil, nil
+	}
+	start, err = core.GetQueryParam(next, "start")
+	if err != nil {
+		errMsg := fmt.Sprintf("error retrieving 'start' query parameter from URL '%s': %s", *next, err.Error())
+		err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+	}
+	return
+}
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newInstancesPager returns the InstancesPager of "vpcService" over all instances.
+func newInstancesPager(vpcService *vpcv1.VpcV1) *vpcv1.InstancesPager {
+	pager, err := vpcService.NewInstancesPager(&vpcv1.ListInstancesOptions{})
+	Expect(err).To(BeNil())
+	return pager
+}
+
+// instanceIDs returns the IDs of "instances".
+func instanceIDs(instances []vpcv1.Instance) []string {
+	ids := []string{}
+	for _, instance := range instances {
+		ids = append(ids, *instance.ID)
+	}
+	return ids
+}
+
+var _ = Describe(`Pager`, func() {
+	It(`Should retrieve all pages`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+		defer server.Close()
+
+		pager := newInstancesPager(newTestVpcService(server.URL))
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-1", "i-2"}))
+		Expect(pager.HasNext()).To(BeTrue())
+
+		all, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(all)).To(Equal([]string{"i-3", "i-4", "i-5"}))
+		Expect(pager.HasNext()).To(BeFalse())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+
+		_, err = pager.GetNext()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Should request pages of the page size, up to the maximum number of items`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5", "i-6", "i-7")
+		defer server.Close()
+
+		pager := newInstancesPager(newTestVpcService(server.URL)).SetPageSize(3).SetMaxItems(5)
+		var pages [][]string
+		for pager.HasNext() {
+			page, err := pager.GetNext()
+			Expect(err).To(BeNil())
+			pages = append(pages, instanceIDs(page))
+		}
+		Expect(pages).To(Equal([][]string{{"i-1", "i-2", "i-3"}, {"i-4", "i-5"}}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+	})
+	It(`Should truncate the last page to the maximum number of items`, func() {
+		pager := vpcv1.NewPager(func(ctx context.Context, start *string, limit *int64) ([]string, *string, error) {
+			// Ignore the limit, as some list operations do.
+			return []string{"a", "b", "c"}, start, nil
+		}).SetMaxItems(2)
+		all, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(all).To(Equal([]string{"a", "b"}))
+	})
+	It(`Should retrieve the next page in the background`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3", "i-4", "i-5")
+		defer server.Close()
+
+		pager := newInstancesPager(newTestVpcService(server.URL)).SetPrefetch(true)
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-1", "i-2"}))
+		Eventually(func() int32 { return atomic.LoadInt32(count) }).Should(Equal(int32(2)))
+
+		all, err := pager.GetAll()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(all)).To(Equal([]string{"i-3", "i-4", "i-5"}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+	})
+	It(`Should retrieve a page again when the context of its prefetch was canceled`, func() {
+		server, count := newInstancePagesServer("i-1", "i-2", "i-3")
+		defer server.Close()
+
+		pager := newInstancesPager(newTestVpcService(server.URL)).SetPrefetch(true)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := pager.GetNextWithContext(ctx)
+		Expect(err).ToNot(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(0)))
+
+		pager = newInstancesPager(newTestVpcService(server.URL)).SetPrefetch(true)
+		ctx, cancel = context.WithCancel(context.Background())
+		_, err = pager.GetNextWithContext(ctx)
+		Expect(err).To(BeNil())
+		cancel()
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-3"}))
+	})
+	It(`Should call the page hooks with each page`, func() {
+		server, _ := newInstancePagesServer("i-1", "i-2", "i-3")
+		defer server.Close()
+
+		var hooked [][]string
+		hookErr := errors.New("stop")
+		failures := 1
+		pager := newInstancesPager(newTestVpcService(server.URL)).AddPageHooks(
+			func(ctx context.Context, page []vpcv1.Instance) error {
+				hooked = append(hooked, instanceIDs(page))
+				return nil
+			},
+			func(ctx context.Context, page []vpcv1.Instance) error {
+				if len(hooked) > 1 && failures > 0 {
+					failures--
+					return hookErr
+				}
+				return nil
+			},
+		)
+		page, err := pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-1", "i-2"}))
+
+		page, err = pager.GetNext()
+		Expect(page).To(BeNil())
+		Expect(errors.Is(err, hookErr)).To(BeTrue())
+		Expect(pager.HasNext()).To(BeTrue())
+
+		// The page rejected by a hook is retrieved again.
+		page, err = pager.GetNext()
+		Expect(err).To(BeNil())
+		Expect(instanceIDs(page)).To(Equal([]string{"i-3"}))
+		Expect(pager.HasNext()).To(BeFalse())
+		Expect(hooked).To(Equal([][]string{{"i-1", "i-2"}, {"i-3"}, {"i-3"}}))
+	})
+	It(`Should iterate over the items`, func() {
+		server, _ := newInstancePagesServer("i-1", "i-2", "i-3")
+		defer server.Close()
+
+		var ids []string
+		for instance, err := range newInstancesPager(newTestVpcService(server.URL)).All(context.Background()) {
+			Expect(err).To(BeNil())
+			ids = append(ids, *instance.ID)
+		}
+		Expect(ids).To(Equal([]string{"i-1", "i-2", "i-3"}))
+	})
+})
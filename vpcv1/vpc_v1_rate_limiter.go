@@ -0,0 +1,229 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimiter : A token bucket that limits the rate at which VpcV1 instances send requests.
+// The bucket holds up to "burst" tokens and is refilled at "requestsPerSecond" tokens per second;
+// every request, including every retry, takes one token and waits while the bucket is empty.
+//
+// A RateLimiter is safe for concurrent use. Set the same RateLimiter on several VpcV1 instances (and
+// their clones) to have them share one limit.
+//
+// When the service throttles a request, with a 429 Too Many Requests response carrying a
+// Retry-After header, or with a response whose X-RateLimit-Remaining (or RateLimit-Remaining)
+// header is 0, the RateLimiter holds back all requests until the time given by the Retry-After or
+// X-RateLimit-Reset (or RateLimit-Reset) header.
+type RateLimiter struct {
+	requestsPerSecond float64
+	burst             int
+	perRegion         bool
+
+	mutex   sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket is the state of one bucket of a RateLimiter.
+type tokenBucket struct {
+	tokens      float64
+	updated     time.Time
+	pausedUntil time.Time
+}
+
+// NewRateLimiter : Instantiate RateLimiter
+// A "burst" smaller than 1 is treated as 1.
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		requestsPerSecond: requestsPerSecond,
+		burst:             burst,
+		buckets:           make(map[string]*tokenBucket),
+	}
+}
+
+// SetPerRegion : Allow user to keep a separate bucket for each regional endpoint
+// Each region has its own endpoint (see GetServiceURLForRegion), so with per-region buckets the
+// requests sent to one region do not count against the limit of the others.
+func (limiter *RateLimiter) SetPerRegion(perRegion bool) *RateLimiter {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	limiter.perRegion = perRegion
+	return limiter
+}
+
+// Wait : Block until a request may be sent, or "ctx" ends
+// The requests sent by VpcV1 instances wait automatically; call Wait to have other work share the
+// limit. With per-region buckets, Wait uses the bucket of requests without a known endpoint.
+func (limiter *RateLimiter) Wait(ctx context.Context) error {
+	_, err := limiter.wait(ctx, "")
+	return err
+}
+
+// wait blocks until a token is available in the bucket for "host", and takes it. It returns the
+// time it waited, 0 if a token was available right away.
+func (limiter *RateLimiter) wait(ctx context.Context, host string) (time.Duration, error) {
+	start := time.Now()
+	delay := limiter.reserve(host, start)
+	if delay <= 0 {
+		return 0, nil
+	}
+	for delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return time.Since(start), ctx.Err()
+		case <-timer.C:
+		}
+		delay = limiter.reserve(host, time.Now())
+	}
+	return time.Since(start), nil
+}
+
+// reserve takes a token from the bucket for "host" and returns 0, or, if there is none, returns how
+// long to wait before trying again.
+func (limiter *RateLimiter) reserve(host string, now time.Time) time.Duration {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	bucket := limiter.bucket(host, now)
+	if now.Before(bucket.pausedUntil) {
+		return bucket.pausedUntil.Sub(now)
+	}
+
+	if limiter.requestsPerSecond > 0 {
+		bucket.tokens += now.Sub(bucket.updated).Seconds() * limiter.requestsPerSecond
+		if bucket.tokens > float64(limiter.burst) {
+			bucket.tokens = float64(limiter.burst)
+		}
+	}
+	bucket.updated = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+	if limiter.requestsPerSecond <= 0 {
+		// A limiter without a rate only delays requests while paused.
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / limiter.requestsPerSecond * float64(time.Second))
+}
+
+// pause holds back the requests to "host" until "until".
+func (limiter *RateLimiter) pause(host string, until time.Time) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	bucket := limiter.bucket(host, time.Now())
+	if until.After(bucket.pausedUntil) {
+		bucket.pausedUntil = until
+	}
+}
+
+// bucket returns the bucket for "host", creating it full if needed. The caller must hold the mutex.
+func (limiter *RateLimiter) bucket(host string, now time.Time) *tokenBucket {
+	if !limiter.perRegion {
+		host = ""
+	}
+	bucket, ok := limiter.buckets[host]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(limiter.burst), updated: now}
+		limiter.buckets[host] = bucket
+	}
+	return bucket
+}
+
+// roundTrip sends "req" with "next" once the limiter allows it, and pauses the limiter if the
+// response says the client is being throttled. If the limiter held back the request, the time it
+// waited is passed to "observeWait".
+func (limiter *RateLimiter) roundTrip(next http.RoundTripper, req *http.Request, observeWait func(wait time.Duration)) (*http.Response, error) {
+	host := req.URL.Host
+	waited, err := limiter.wait(req.Context(), host)
+	if waited > 0 {
+		observeWait(waited)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := next.RoundTrip(req)
+	if resp != nil {
+		if until, ok := throttledUntil(resp, time.Now()); ok {
+			limiter.pause(host, until)
+		}
+	}
+	return resp, err
+}
+
+// unixTimeThreshold is the smallest rate limit reset value that is taken as a Unix time (2001).
+const unixTimeThreshold = 1000000000
+
+// throttledUntil returns the time until which the service asks the client to stop sending
+// requests, according to the Retry-After header of a 429 response or to the rate limit headers.
+func throttledUntil(resp *http.Response, now time.Time) (time.Time, bool) {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return now.Add(time.Duration(seconds) * time.Second), true
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return date, true
+			}
+		}
+	}
+
+	for _, prefix := range []string{"X-RateLimit-", "RateLimit-"} {
+		if resp.Header.Get(prefix+"Remaining") != "0" {
+			continue
+		}
+		reset, err := strconv.ParseInt(resp.Header.Get(prefix+"Reset"), 10, 64)
+		if err != nil || reset < 0 {
+			continue
+		}
+		// Some services report the reset as a Unix time rather than as a number of seconds.
+		if reset > unixTimeThreshold {
+			return time.Unix(reset, 0), true
+		}
+		return now.Add(time.Duration(reset) * time.Second), true
+	}
+	return time.Time{}, false
+}
+
+// SetRateLimiter : Limit the rate of the requests sent by the service to "limiter"
+// A nil limiter removes the limit.
+func (vpc *VpcV1) SetRateLimiter(limiter *RateLimiter) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.rateLimiter = limiter
+	})
+}
+
+// GetRateLimiter : Return the rate limiter set with SetRateLimiter, or nil
+func (vpc *VpcV1) GetRateLimiter() *RateLimiter {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.rateLimiter
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newThrottlingServer returns a test server that answers the first request with 429 Too Many
+// Requests and "Retry-After: 1", and the rest with an empty instance.
+func newThrottlingServer() (*httptest.Server, *int32) {
+	count := new(int32)
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(count, 1) == 1 {
+			res.Header().Set("Retry-After", "1")
+			res.WriteHeader(429)
+			return
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{}`)
+	}))
+	return server, count
+}
+
+var _ = Describe(`RateLimiter`, func() {
+	It(`Should limit the requests of concurrent goroutines`, func() {
+		server, count := newSequenceServer(`{}`, `{}`, `{}`, `{}`, `{}`, `{}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRateLimiter(vpcv1.NewRateLimiter(50, 2))
+		Expect(vpcService.GetRateLimiter()).ToNot(BeNil())
+
+		start := time.Now()
+		var waitGroup sync.WaitGroup
+		for i := 0; i < 6; i++ {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				_, _, _ = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+			}()
+		}
+		waitGroup.Wait()
+
+		// Two requests use the burst, the other four wait 20ms each.
+		Expect(time.Since(start)).To(BeNumerically(">=", 75*time.Millisecond))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(6)))
+	})
+	It(`Should keep separate buckets per region`, func() {
+		server1, _ := newSequenceServer(`{}`, `{}`)
+		defer server1.Close()
+		server2, _ := newSequenceServer(`{}`, `{}`)
+		defer server2.Close()
+
+		limiter := vpcv1.NewRateLimiter(0.1, 1).SetPerRegion(true)
+		region1 := newTestVpcService(server1.URL)
+		region1.SetRateLimiter(limiter)
+		region2 := newTestVpcService(server2.URL)
+		region2.SetRateLimiter(limiter)
+
+		_, _, err := region1.GetInstance(region1.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		_, _, err = region2.GetInstance(region2.NewGetInstanceOptions("i-2"))
+		Expect(err).To(BeNil())
+
+		// The bucket of the first region is empty for the next ten seconds.
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, _, err = region1.GetInstanceWithContext(ctx, region1.NewGetInstanceOptions("i-1"))
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+	It(`Should hold back requests after a 429 response with Retry-After`, func() {
+		server, count := newThrottlingServer()
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRateLimiter(vpcv1.NewRateLimiter(1000, 10))
+
+		_, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(429))
+
+		start := time.Now()
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(time.Since(start)).To(BeNumerically(">=", 900*time.Millisecond))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+	})
+	It(`Should be set from the options`, func() {
+		limiter := vpcv1.NewRateLimiter(1, 1)
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:           "http://localhost",
+			Authenticator: &core.NoAuthAuthenticator{},
+			RateLimiter:   limiter,
+		})
+		Expect(err).To(BeNil())
+		Expect(vpcService.GetRateLimiter()).To(BeIdenticalTo(limiter))
+	})
+	It(`Should be removable`, func() {
+		vpcService := newTestVpcService("http://localhost")
+		vpcService.SetRateLimiter(vpcv1.NewRateLimiter(1, 1))
+		vpcService.SetRateLimiter(nil)
+		Expect(vpcService.GetRateLimiter()).To(BeNil())
+	})
+	It(`Should let other work share the limit`, func() {
+		limiter := vpcv1.NewRateLimiter(0.1, 1)
+		Expect(limiter.Wait(context.Background())).To(BeNil())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		Expect(limiter.Wait(ctx)).To(Equal(context.DeadlineExceeded))
+	})
+})
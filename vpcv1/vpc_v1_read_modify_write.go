@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// DefaultReadModifyWriteMaxRetries is the number of times ReadModifyWrite re-reads and re-applies
+// a change after a 412 Precondition Failed response, when no limit is specified.
+const DefaultReadModifyWriteMaxRetries = 3
+
+// Patcher : A patch model, such as VolumePatch, that builds the body of an update request
+type Patcher interface {
+	AsPatch() (map[string]interface{}, error)
+}
+
+// ReadModifyWrite : Update a resource with optimistic concurrency control
+// It retrieves the resource with "get", passes it to "mutate" to build the patch of the change,
+// and sends the patch with "update" along with the ETag of the retrieved resource as the If-Match
+// value. If the resource changed in the meantime, so that the service responds with 412
+// Precondition Failed, it starts over with the new version of the resource, up to "maxRetries"
+// times (DefaultReadModifyWriteMaxRetries if negative).
+//
+// "mutate" may be called several times, and must derive the patch from the resource it receives
+// only. If the patch is nil or empty, the resource is returned as retrieved, without an update.
+func ReadModifyWrite[T any, P Patcher](ctx context.Context, maxRetries int,
+	get func(ctx context.Context) (T, *core.DetailedResponse, error),
+	mutate func(current T) (P, error),
+	update func(ctx context.Context, patch map[string]interface{}, ifMatch string) (T, *core.DetailedResponse, error),
+) (result T, response *core.DetailedResponse, err error) {
+	if maxRetries < 0 {
+		maxRetries = DefaultReadModifyWriteMaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		var current T
+		current, response, err = get(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "get-error")
+			return
+		}
+		etag := response.GetHeaders().Get("ETag")
+		if etag == "" {
+			err = core.SDKErrorf(fmt.Errorf("the resource was retrieved without an ETag"), "", "missing-etag", common.GetComponentInfo())
+			return
+		}
+
+		var patcher P
+		patcher, err = mutate(current)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "mutate-error", common.GetComponentInfo())
+			return
+		}
+		var patch map[string]interface{}
+		if !core.IsNil(patcher) {
+			patch, err = patcher.AsPatch()
+			if err != nil {
+				err = core.SDKErrorf(err, "", "patch-error", common.GetComponentInfo())
+				return
+			}
+		}
+		if len(patch) == 0 {
+			return current, response, nil
+		}
+
+		result, response, err = update(ctx, patch, etag)
+		if err == nil || attempt >= maxRetries || response == nil || response.GetStatusCode() != http.StatusPreconditionFailed {
+			err = core.RepurposeSDKProblem(err, "update-error")
+			return
+		}
+	}
+}
+
+// ModifyVolume : Update a volume with optimistic concurrency control
+// Retrieves the volume, passes it to "mutate" to build the patch of the change, and updates the
+// volume with the patch if it has not changed in the meantime. Otherwise starts over, up to
+// DefaultReadModifyWriteMaxRetries times (see ReadModifyWrite).
+func (vpc *VpcV1) ModifyVolume(id string, mutate func(volume *Volume) (*VolumePatch, error)) (result *Volume, response *core.DetailedResponse, err error) {
+	result, response, err = vpc.ModifyVolumeWithContext(context.Background(), id, mutate)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ModifyVolumeWithContext is an alternate form of the ModifyVolume method which supports a Context parameter
+func (vpc *VpcV1) ModifyVolumeWithContext(ctx context.Context, id string, mutate func(volume *Volume) (*VolumePatch, error)) (result *Volume, response *core.DetailedResponse, err error) {
+	return ReadModifyWrite(ctx, DefaultReadModifyWriteMaxRetries,
+		func(ctx context.Context) (*Volume, *core.DetailedResponse, error) {
+			return vpc.GetVolumeWithContext(ctx, vpc.NewGetVolumeOptions(id))
+		},
+		mutate,
+		func(ctx context.Context, patch map[string]interface{}, ifMatch string) (*Volume, *core.DetailedResponse, error) {
+			return vpc.UpdateVolumeWithContext(ctx, vpc.NewUpdateVolumeOptions(id, patch).SetIfMatch(ifMatch))
+		},
+	)
+}
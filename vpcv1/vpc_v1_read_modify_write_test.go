@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// volumeServer serves a volume whose ETag changes with each update. The volume is also updated
+// concurrently, right after each of the first "conflicts" reads.
+type volumeServer struct {
+	*httptest.Server
+	mutex     sync.Mutex
+	version   int
+	capacity  int
+	conflicts int
+	patches   int
+}
+
+func newVolumeServer(conflicts int) *volumeServer {
+	server := &volumeServer{version: 1, capacity: 100, conflicts: conflicts}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		server.mutex.Lock()
+		defer server.mutex.Unlock()
+		res.Header().Set("Content-type", "application/json")
+		switch req.Method {
+		case http.MethodGet:
+			res.Header().Set("ETag", fmt.Sprintf(`W/"%d"`, server.version))
+			fmt.Fprintf(res, `{"id": "v-1", "capacity": %d}`, server.capacity)
+			if server.conflicts > 0 {
+				server.conflicts--
+				server.capacity += 10
+				server.version++
+			}
+		case http.MethodPatch:
+			server.patches++
+			if req.Header.Get("If-Match") != fmt.Sprintf(`W/"%d"`, server.version) {
+				res.WriteHeader(412)
+				fmt.Fprint(res, `{"errors": [{"code": "volume_precondition_failed", "message": "The volume has changed"}]}`)
+				return
+			}
+			var patch struct {
+				Capacity int `json:"capacity"`
+			}
+			_ = json.NewDecoder(req.Body).Decode(&patch)
+			server.capacity = patch.Capacity
+			server.version++
+			res.Header().Set("ETag", fmt.Sprintf(`W/"%d"`, server.version))
+			fmt.Fprintf(res, `{"id": "v-1", "capacity": %d}`, server.capacity)
+		}
+	}))
+	return server
+}
+
+// growVolume doubles the capacity of a volume.
+func growVolume(volume *vpcv1.Volume) (*vpcv1.VolumePatch, error) {
+	return &vpcv1.VolumePatch{Capacity: core.Int64Ptr(*volume.Capacity * 2)}, nil
+}
+
+var _ = Describe(`ReadModifyWrite`, func() {
+	It(`Should update with the ETag of the resource`, func() {
+		server := newVolumeServer(0)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		volume, response, err := vpcService.ModifyVolume("v-1", growVolume)
+		Expect(err).To(BeNil())
+		Expect(*volume.Capacity).To(Equal(int64(200)))
+		Expect(response.GetHeaders().Get("ETag")).To(Equal(`W/"2"`))
+		Expect(server.patches).To(Equal(1))
+	})
+	It(`Should start over after a concurrent change`, func() {
+		server := newVolumeServer(2)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		volume, _, err := vpcService.ModifyVolume("v-1", growVolume)
+		Expect(err).To(BeNil())
+		Expect(*volume.Capacity).To(Equal(int64(240)))
+		Expect(server.patches).To(Equal(3))
+	})
+	It(`Should give up after the maximum number of retries`, func() {
+		server := newVolumeServer(10)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, response, err := vpcService.ModifyVolume("v-1", growVolume)
+		Expect(response.GetStatusCode()).To(Equal(412))
+		apiErr, ok := vpcv1.ParseAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(errors.Is(apiErr, vpcv1.ErrPreconditionFailed)).To(BeTrue())
+		Expect(server.patches).To(Equal(vpcv1.DefaultReadModifyWriteMaxRetries + 1))
+	})
+	It(`Should not update without changes`, func() {
+		server := newVolumeServer(0)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		volume, _, err := vpcService.ModifyVolume("v-1", func(volume *vpcv1.Volume) (*vpcv1.VolumePatch, error) {
+			return nil, nil
+		})
+		Expect(err).To(BeNil())
+		Expect(*volume.Capacity).To(Equal(int64(100)))
+		Expect(server.patches).To(Equal(0))
+	})
+	It(`Should return the errors of the mutation`, func() {
+		server := newVolumeServer(0)
+		defer server.Close()
+
+		mutateErr := errors.New("volume is too large")
+		vpcService := newTestVpcService(server.URL)
+		_, _, err := vpcService.ModifyVolume("v-1", func(volume *vpcv1.Volume) (*vpcv1.VolumePatch, error) {
+			return nil, mutateErr
+		})
+		Expect(errors.Is(err, mutateErr)).To(BeTrue())
+		Expect(server.patches).To(Equal(0))
+	})
+})
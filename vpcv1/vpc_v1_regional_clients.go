@@ -0,0 +1,198 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// RegionalClients : A set of VpcV1 instances, one per region, derived from a base instance
+// The regional clients are clones of the base instance with the service URL of their region, so
+// they share its authenticator, and its configuration such as retries, rate limiting, tracing and
+// logging. They are created on first use.
+//
+// The regions are discovered with ListRegions on the base instance, unless they are set with
+// SetRegions. A RegionalClients is safe for concurrent use.
+type RegionalClients struct {
+	base *VpcV1
+
+	mutex     sync.Mutex
+	regions   []string
+	discovery *regionDiscovery
+	endpoints map[string]string
+	clients   map[string]*VpcV1
+}
+
+// regionDiscovery is a discovery of the regions in progress, which the concurrent calls of
+// RegionalClients.Regions wait for rather than discovering the regions again.
+type regionDiscovery struct {
+	// Closed when the discovery is over.
+	done chan struct{}
+
+	regions []string
+	err     error
+}
+
+// RegionResult : The result of a function run in one region by FanOut
+type RegionResult[T any] struct {
+	// The name of the region.
+	Region string
+
+	// The result of the function.
+	Result T
+
+	// The error of the function, or of the creation of the client of the region.
+	Err error
+}
+
+// NewRegionalClients : Instantiate RegionalClients derived from "base"
+func NewRegionalClients(base *VpcV1) *RegionalClients {
+	return &RegionalClients{
+		base:      base,
+		endpoints: make(map[string]string),
+		clients:   make(map[string]*VpcV1),
+	}
+}
+
+// SetRegions : Use the specified regions instead of discovering them
+func (regionalClients *RegionalClients) SetRegions(regions ...string) *RegionalClients {
+	regionalClients.mutex.Lock()
+	defer regionalClients.mutex.Unlock()
+	regionalClients.regions = slices.Clone(regions)
+	return regionalClients
+}
+
+// Regions : Return the names of the regions
+// On first use, unless the regions were set with SetRegions, the available regions are retrieved
+// with ListRegions. The concurrent calls wait for the same retrieval, or until their context is
+// done, and the calls following a failed retrieval try again.
+func (regionalClients *RegionalClients) Regions(ctx context.Context) ([]string, error) {
+	regionalClients.mutex.Lock()
+	if regionalClients.regions != nil {
+		defer regionalClients.mutex.Unlock()
+		return slices.Clone(regionalClients.regions), nil
+	}
+	discovery := regionalClients.discovery
+	if discovery == nil {
+		discovery = &regionDiscovery{done: make(chan struct{})}
+		regionalClients.discovery = discovery
+		regionalClients.mutex.Unlock()
+		regionalClients.discover(ctx, discovery)
+	} else {
+		regionalClients.mutex.Unlock()
+	}
+
+	select {
+	case <-discovery.done:
+	case <-ctx.Done():
+		return nil, core.SDKErrorf(ctx.Err(), "", "list-regions-cancelled", common.GetComponentInfo())
+	}
+	if discovery.err != nil {
+		return nil, discovery.err
+	}
+	return slices.Clone(discovery.regions), nil
+}
+
+// discover retrieves the available regions with ListRegions, outside of the mutex, and ends
+// "discovery" with them.
+func (regionalClients *RegionalClients) discover(ctx context.Context, discovery *regionDiscovery) {
+	defer close(discovery.done)
+	result, _, err := regionalClients.base.ListRegionsWithContext(ctx, regionalClients.base.NewListRegionsOptions())
+
+	regionalClients.mutex.Lock()
+	defer regionalClients.mutex.Unlock()
+	regionalClients.discovery = nil
+	if err != nil {
+		discovery.err = core.RepurposeSDKProblem(err, "list-regions-error")
+		return
+	}
+	discovery.regions = []string{}
+	for _, region := range result.Regions {
+		if region.Name == nil || (region.Status != nil && *region.Status != RegionStatusAvailableConst) {
+			continue
+		}
+		discovery.regions = append(discovery.regions, *region.Name)
+		if region.Endpoint != nil {
+			regionalClients.endpoints[*region.Name] = *region.Endpoint
+		}
+	}
+	// The regions set with SetRegions in the meantime are kept.
+	if regionalClients.regions == nil {
+		regionalClients.regions = discovery.regions
+	}
+}
+
+// Client : Return the client of "region", creating it on first use
+// The service URL of the region is the public endpoint returned by GetServiceURLForRegion, or else
+// the endpoint returned for the region by ListRegions.
+func (regionalClients *RegionalClients) Client(region string) (*VpcV1, error) {
+	regionalClients.mutex.Lock()
+	defer regionalClients.mutex.Unlock()
+	if client, found := regionalClients.clients[region]; found {
+		return client, nil
+	}
+
+	serviceURL, err := GetServiceURLForRegion(region)
+	if err != nil {
+		endpoint, found := regionalClients.endpoints[region]
+		if !found {
+			err = fmt.Errorf("no service URL is known for region '%s'", region)
+			return nil, core.SDKErrorf(err, "", "unknown-region", common.GetComponentInfo())
+		}
+		serviceURL = strings.TrimSuffix(endpoint, "/") + "/v1"
+	}
+	client := regionalClients.base.Clone()
+	if err = client.SetServiceURL(serviceURL); err != nil {
+		return nil, core.RepurposeSDKProblem(err, "url-set-error")
+	}
+	regionalClients.clients[region] = client
+	return client, nil
+}
+
+// FanOut : Run "fn" with the client of each region concurrently
+// The results are returned in the order of the regions, each with the error of its region. The
+// error returned is that of the discovery of the regions, in which case "fn" is not run.
+func FanOut[T any](ctx context.Context, regionalClients *RegionalClients,
+	fn func(ctx context.Context, region string, vpc *VpcV1) (T, error)) ([]RegionResult[T], error) {
+	regions, err := regionalClients.Regions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]RegionResult[T], len(regions))
+	var wg sync.WaitGroup
+	for i, region := range regions {
+		results[i].Region = region
+		client, err := regionalClients.Client(region)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		wg.Go(func() {
+			results[i].Result, results[i].Err = fn(ctx, region, client)
+		})
+	}
+	wg.Wait()
+	return results, nil
+}
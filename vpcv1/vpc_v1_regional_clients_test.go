@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RegionalClients`, func() {
+	It(`Should discover the regions and run a function in each of them`, func() {
+		east, _ := newSequenceServer(`{"id": "i-east"}`)
+		defer east.Close()
+		west, _ := newSequenceServer("")
+		defer west.Close()
+		base, count := newSequenceServer(fmt.Sprintf(`{"regions": [
+			{"name": "test-east", "endpoint": %q, "href": "", "status": "available"},
+			{"name": "test-west", "endpoint": %q, "href": "", "status": "available"},
+			{"name": "test-north", "endpoint": "https://test-north.example.com", "href": "", "status": "unavailable"}
+		]}`, east.URL, west.URL+"/"))
+		defer base.Close()
+
+		regionalClients := vpcv1.NewRegionalClients(newTestVpcService(base.URL))
+		results, err := vpcv1.FanOut(context.Background(), regionalClients,
+			func(ctx context.Context, region string, vpc *vpcv1.VpcV1) (string, error) {
+				instance, _, err := vpc.GetInstanceWithContext(ctx, vpc.NewGetInstanceOptions("i-1"))
+				if err != nil {
+					return "", err
+				}
+				return *instance.ID, nil
+			})
+		Expect(err).To(BeNil())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Region).To(Equal("test-east"))
+		Expect(results[0].Result).To(Equal("i-east"))
+		Expect(results[0].Err).To(BeNil())
+		Expect(results[1].Region).To(Equal("test-west"))
+		Expect(results[1].Err).ToNot(BeNil())
+
+		regions, err := regionalClients.Regions(context.Background())
+		Expect(err).To(BeNil())
+		Expect(regions).To(Equal([]string{"test-east", "test-west"}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		client, err := regionalClients.Client("test-west")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal(west.URL + "/v1"))
+		again, err := regionalClients.Client("test-west")
+		Expect(err).To(BeNil())
+		Expect(again).To(BeIdenticalTo(client))
+
+		_, err = regionalClients.Client("test-north")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Should use the regions that are set without discovering them`, func() {
+		base, count := newSequenceServer(`{"regions": []}`)
+		defer base.Close()
+
+		vpcService := newTestVpcService(base.URL)
+		regionalClients := vpcv1.NewRegionalClients(vpcService).SetRegions("us-south", "eu-de")
+		results, err := vpcv1.FanOut(context.Background(), regionalClients,
+			func(ctx context.Context, region string, vpc *vpcv1.VpcV1) (string, error) {
+				return vpc.GetServiceURL(), nil
+			})
+		Expect(err).To(BeNil())
+		Expect(results).To(Equal([]vpcv1.RegionResult[string]{
+			{Region: "us-south", Result: "https://us-south.iaas.cloud.ibm.com/v1"},
+			{Region: "eu-de", Result: "https://eu-de.iaas.cloud.ibm.com/v1"},
+		}))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(0)))
+		Expect(vpcService.GetServiceURL()).To(Equal(base.URL))
+	})
+	It(`Should return the error of the discovery`, func() {
+		base := newErrorServer(500, `{"errors": [{"code": "internal_error", "message": "Internal error"}]}`)
+		defer base.Close()
+
+		regionalClients := vpcv1.NewRegionalClients(newTestVpcService(base.URL))
+		results, err := vpcv1.FanOut(context.Background(), regionalClients,
+			func(ctx context.Context, region string, vpc *vpcv1.VpcV1) (string, error) {
+				Fail("unexpected call")
+				return "", nil
+			})
+		Expect(err).ToNot(BeNil())
+		Expect(results).To(BeNil())
+	})
+	It(`Should discover the regions once without blocking the other calls`, func() {
+		var count int32
+		received := make(chan struct{})
+		release := make(chan struct{})
+		base := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&count, 1) == 1 {
+				close(received)
+			}
+			<-release
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"regions": [{"name": "us-south", "endpoint": "", "href": "", "status": "available"}]}`)
+		}))
+		defer base.Close()
+
+		regionalClients := vpcv1.NewRegionalClients(newTestVpcService(base.URL))
+		discovered := make(chan []string, 2)
+		for range 2 {
+			go func() {
+				defer GinkgoRecover()
+				regions, err := regionalClients.Regions(context.Background())
+				Expect(err).To(BeNil())
+				discovered <- regions
+			}()
+		}
+		<-received
+
+		client, err := regionalClients.Client("eu-de")
+		Expect(err).To(BeNil())
+		Expect(client.GetServiceURL()).To(Equal("https://eu-de.iaas.cloud.ibm.com/v1"))
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = regionalClients.Regions(ctx)
+		Expect(err).ToNot(BeNil())
+
+		close(release)
+		Expect(<-discovered).To(Equal([]string{"us-south"}))
+		Expect(<-discovered).To(Equal([]string{"us-south"}))
+		Expect(atomic.LoadInt32(&count)).To(Equal(int32(1)))
+	})
+	It(`Should discover the regions again after a failure`, func() {
+		var count int32
+		base := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			if atomic.AddInt32(&count, 1) == 1 {
+				res.WriteHeader(403)
+				fmt.Fprint(res, `{"errors": [{"code": "forbidden", "message": "Forbidden"}]}`)
+				return
+			}
+			fmt.Fprint(res, `{"regions": [{"name": "us-south", "endpoint": "", "href": "", "status": "available"}]}`)
+		}))
+		defer base.Close()
+
+		regionalClients := vpcv1.NewRegionalClients(newTestVpcService(base.URL))
+		_, err := regionalClients.Regions(context.Background())
+		Expect(err).ToNot(BeNil())
+		regions, err := regionalClients.Regions(context.Background())
+		Expect(err).To(BeNil())
+		Expect(regions).To(Equal([]string{"us-south"}))
+		Expect(atomic.LoadInt32(&count)).To(Equal(int32(2)))
+	})
+})
@@ -0,0 +1,234 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"io"
+	"maps"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default values used by a RetryPolicy when the corresponding field is left unset.
+const (
+	DefaultRetryPolicyMaxRetries       = 4
+	DefaultRetryPolicyMinRetryInterval = 1 * time.Second
+	DefaultRetryPolicyMaxRetryInterval = 30 * time.Second
+)
+
+// NoRetries : The RetryPolicy.MaxRetries value that disables retries, as the zero value selects
+// DefaultRetryPolicyMaxRetries.
+const NoRetries = -1
+
+// RetryPolicy : Controls which failed requests a VpcV1 instance retries.
+// A request is retried when it fails to reach the service or the service responds with 429 Too
+// Many Requests or a 5xx status code other than 501 Not Implemented, but only if it is safe to
+// send again:
+//
+//   - GET, HEAD, PUT and DELETE requests are idempotent and are always retried.
+//   - PATCH requests are retried only if they carry an If-Match header, so that a repeated update
+//     cannot overwrite a change made in between.
+//   - POST requests (creates and actions) are never retried, because a repeated request can create
+//     a duplicate resource, unless their operation is listed with AllowNonIdempotentRetries.
+//
+// Operations are identified by the operation IDs used by the generated service methods, which
+// match the method names, for example "CreateFloatingIP" or "ListInstances".
+//
+// The delay between attempts grows exponentially and honors the Retry-After response header.
+type RetryPolicy struct {
+	// The maximum number of times a request is retried. Set NoRetries (or any negative value) to
+	// send each request only once.
+	MaxRetries int
+
+	// The delay before the first retry.
+	MinRetryInterval time.Duration
+
+	// The upper bound for the delay between two attempts.
+	MaxRetryInterval time.Duration
+
+	// The IDs of non-idempotent operations whose requests may nevertheless be retried.
+	NonIdempotentRetries map[string]bool
+
+	// The IDs of operations whose requests are never retried.
+	DisabledRetries map[string]bool
+}
+
+// NewRetryPolicy : Instantiate RetryPolicy with the default values.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:       DefaultRetryPolicyMaxRetries,
+		MinRetryInterval: DefaultRetryPolicyMinRetryInterval,
+		MaxRetryInterval: DefaultRetryPolicyMaxRetryInterval,
+	}
+}
+
+// SetMaxRetries : Allow user to set MaxRetries
+// A "maxRetries" of 0 disables retries (see NoRetries).
+func (policy *RetryPolicy) SetMaxRetries(maxRetries int) *RetryPolicy {
+	policy.MaxRetries = maxRetries
+	if maxRetries <= 0 {
+		policy.MaxRetries = NoRetries
+	}
+	return policy
+}
+
+// SetMinRetryInterval : Allow user to set MinRetryInterval
+func (policy *RetryPolicy) SetMinRetryInterval(minRetryInterval time.Duration) *RetryPolicy {
+	policy.MinRetryInterval = minRetryInterval
+	return policy
+}
+
+// SetMaxRetryInterval : Allow user to set MaxRetryInterval
+func (policy *RetryPolicy) SetMaxRetryInterval(maxRetryInterval time.Duration) *RetryPolicy {
+	policy.MaxRetryInterval = maxRetryInterval
+	return policy
+}
+
+// AllowNonIdempotentRetries : Allow the requests of the specified non-idempotent operations to be retried
+// Only opt in operations for which a duplicate is harmless or is detected by the caller, for
+// example creates with a unique name.
+func (policy *RetryPolicy) AllowNonIdempotentRetries(operationIDs ...string) *RetryPolicy {
+	if policy.NonIdempotentRetries == nil {
+		policy.NonIdempotentRetries = make(map[string]bool)
+	}
+	for _, operationID := range operationIDs {
+		policy.NonIdempotentRetries[operationID] = true
+	}
+	return policy
+}
+
+// DisableRetriesFor : Never retry the requests of the specified operations
+func (policy *RetryPolicy) DisableRetriesFor(operationIDs ...string) *RetryPolicy {
+	if policy.DisabledRetries == nil {
+		policy.DisabledRetries = make(map[string]bool)
+	}
+	for _, operationID := range operationIDs {
+		policy.DisabledRetries[operationID] = true
+	}
+	return policy
+}
+
+// withDefaults returns a copy of "policy" with every unset field defaulted.
+func (policy *RetryPolicy) withDefaults() *RetryPolicy {
+	resolved := NewRetryPolicy()
+	if policy.MaxRetries != 0 {
+		resolved.MaxRetries = max(policy.MaxRetries, NoRetries)
+	}
+	if policy.MinRetryInterval > 0 {
+		resolved.MinRetryInterval = policy.MinRetryInterval
+	}
+	if policy.MaxRetryInterval > 0 {
+		resolved.MaxRetryInterval = policy.MaxRetryInterval
+	}
+	if resolved.MaxRetryInterval < resolved.MinRetryInterval {
+		resolved.MaxRetryInterval = resolved.MinRetryInterval
+	}
+	resolved.NonIdempotentRetries = maps.Clone(policy.NonIdempotentRetries)
+	resolved.DisabledRetries = maps.Clone(policy.DisabledRetries)
+	return resolved
+}
+
+// SetRetryPolicy : Retry failed requests according to "policy"
+// A nil policy disables retries.
+//
+// SetRetryPolicy disables the automatic retries enabled with EnableRetries, which would otherwise
+// compound with the policy: their maximum number of retries and interval are dropped, and are not
+// restored by a later SetRetryPolicy(nil). Set them on the policy instead, and do not call
+// EnableRetries afterwards.
+func (vpc *VpcV1) SetRetryPolicy(policy *RetryPolicy) {
+	if vpc.retryableTransport() != nil {
+		core.GetLogger().Warn("SetRetryPolicy disabled the automatic retries enabled with EnableRetries")
+		vpc.Service.DisableRetries()
+	}
+	if policy != nil {
+		policy = policy.withDefaults()
+	}
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.retryPolicy = policy
+	})
+}
+
+// GetRetryPolicy : Return the retry policy set with SetRetryPolicy, or nil
+func (vpc *VpcV1) GetRetryPolicy() *RetryPolicy {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.retryPolicy
+	}
+	return nil
+}
+
+// isRetrySafe returns true if "req", sent on behalf of operation "operationID", may be sent again.
+func (policy *RetryPolicy) isRetrySafe(operationID string, req *http.Request) bool {
+	if policy.DisabledRetries[operationID] {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// The body cannot be replayed.
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPatch:
+		return req.Header.Get("If-Match") != "" || policy.NonIdempotentRetries[operationID]
+	default:
+		return policy.NonIdempotentRetries[operationID]
+	}
+}
+
+// roundTrip sends "req" with "next", retrying it as allowed by the policy.
+func (policy *RetryPolicy) roundTrip(next http.RoundTripper, operationID string, req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	canRetry := policy.isRetrySafe(operationID, req)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := next.RoundTrip(attemptReq)
+		if !canRetry || attempt >= policy.MaxRetries {
+			return resp, err
+		}
+		if retry, _ := core.IBMCloudSDKRetryPolicy(ctx, resp, err); !retry {
+			return resp, err
+		}
+
+		wait := core.IBMCloudSDKBackoffPolicy(policy.MinRetryInterval, policy.MaxRetryInterval, attempt, resp)
+		if resp != nil {
+			// Release the connection before the next attempt.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,172 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newFlakyServer returns a test server that fails the first "failures" requests with 503 Service
+// Unavailable and answers the rest with "body". The request bodies received are recorded.
+func newFlakyServer(failures int32, body string) (server *httptest.Server, count *int32, bodies *[]string) {
+	count = new(int32)
+	bodies = new([]string)
+	server = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requestBody, _ := io.ReadAll(req.Body)
+		*bodies = append(*bodies, string(requestBody))
+		if atomic.AddInt32(count, 1) <= failures {
+			res.WriteHeader(503)
+			return
+		}
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, body)
+	}))
+	return
+}
+
+var fastRetries = vpcv1.NewRetryPolicy().SetMinRetryInterval(time.Millisecond).SetMaxRetryInterval(time.Millisecond)
+
+var _ = Describe(`RetryPolicy`, func() {
+	It(`Should retry GET requests`, func() {
+		server, count, bodies := newFlakyServer(2, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(fastRetries)
+		instance, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(*instance.ID).To(Equal("i-1"))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+		Expect(*bodies).To(Equal([]string{"", "", ""}))
+	})
+	It(`Should give up after MaxRetries`, func() {
+		server, count, _ := newFlakyServer(10, `{}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(vpcv1.NewRetryPolicy().SetMaxRetries(2).SetMinRetryInterval(time.Millisecond))
+		_, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+	})
+	It(`Should not retry with NoRetries`, func() {
+		server, count, _ := newFlakyServer(10, `{}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		for _, policy := range []*vpcv1.RetryPolicy{
+			vpcv1.NewRetryPolicy().SetMaxRetries(0),
+			{MaxRetries: vpcv1.NoRetries},
+		} {
+			atomic.StoreInt32(count, 0)
+			vpcService.SetRetryPolicy(policy.SetMinRetryInterval(time.Millisecond))
+			Expect(vpcService.GetRetryPolicy().MaxRetries).To(Equal(vpcv1.NoRetries))
+			_, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(503))
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+			// The policy in effect can be set again.
+			vpcService.SetRetryPolicy(vpcService.GetRetryPolicy())
+			Expect(vpcService.GetRetryPolicy().MaxRetries).To(Equal(vpcv1.NoRetries))
+		}
+
+		vpcService.SetRetryPolicy(&vpcv1.RetryPolicy{})
+		Expect(vpcService.GetRetryPolicy().MaxRetries).To(Equal(vpcv1.DefaultRetryPolicyMaxRetries))
+	})
+	It(`Should not retry creates unless the operation opts in`, func() {
+		server, count, bodies := newFlakyServer(1, `{"id": "fip-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(fastRetries)
+		createOptions := vpcService.NewCreateFloatingIPOptions(&vpcv1.FloatingIPPrototypeFloatingIPByZone{
+			Name: core.StringPtr("my-fip"),
+			Zone: &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-1")},
+		})
+		_, response, err := vpcService.CreateFloatingIP(createOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(503))
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		atomic.StoreInt32(count, 0)
+		*bodies = nil
+		policy := vpcv1.NewRetryPolicy().SetMinRetryInterval(time.Millisecond).AllowNonIdempotentRetries("CreateFloatingIP")
+		vpcService.SetRetryPolicy(policy)
+		floatingIP, _, err := vpcService.CreateFloatingIP(createOptions)
+		Expect(err).To(BeNil())
+		Expect(*floatingIP.ID).To(Equal("fip-1"))
+		Expect(*bodies).To(HaveLen(2))
+		Expect((*bodies)[1]).To(Equal((*bodies)[0]))
+		Expect((*bodies)[1]).To(ContainSubstring("my-fip"))
+	})
+	It(`Should retry updates only when guarded by If-Match`, func() {
+		server, count, _ := newFlakyServer(1, `{"id": "v-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(fastRetries)
+		updateOptions := vpcService.NewUpdateVolumeOptions("v-1", map[string]interface{}{"name": "new-name"})
+		_, _, err := vpcService.UpdateVolume(updateOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		atomic.StoreInt32(count, 0)
+		_, _, err = vpcService.UpdateVolume(updateOptions.SetIfMatch(`W/"96d225c4-56bd-43d9-98fc-d7148e5c5028"`))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+	})
+	It(`Should honor DisableRetriesFor`, func() {
+		server, count, _ := newFlakyServer(1, `{}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		vpcService.SetRetryPolicy(vpcv1.NewRetryPolicy().DisableRetriesFor("GetInstance"))
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+	})
+	It(`Should not affect clones made earlier`, func() {
+		server, count, _ := newFlakyServer(1, `{}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		clone := vpcService.Clone()
+		vpcService.SetRetryPolicy(fastRetries)
+		Expect(vpcService.GetRetryPolicy()).ToNot(BeNil())
+		Expect(clone.GetRetryPolicy()).To(BeNil())
+
+		_, _, err := clone.GetInstance(clone.NewGetInstanceOptions("i-1"))
+		Expect(err).ToNot(BeNil())
+		Expect(atomic.LoadInt32(count)).To(Equal(int32(1)))
+
+		vpcService.SetRetryPolicy(nil)
+		Expect(vpcService.GetRetryPolicy()).To(BeNil())
+	})
+})
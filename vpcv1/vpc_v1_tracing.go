@@ -0,0 +1,68 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"net/http"
+)
+
+// RequestTracer : The tracer of the requests sent by a VpcV1 instance
+// The metadata of the operation a request belongs to is available with OperationInfoFromRequest.
+// The methods are called concurrently by the requests in flight.
+type RequestTracer interface {
+	// TraceOperation is called before the first attempt of an operation, with its request. It
+	// returns the request to send, whose context typically carries the trace of the operation, and
+	// the function called with the outcome of the operation once all of its attempts are made.
+	TraceOperation(req *http.Request) (*http.Request, func(resp *http.Response, err error))
+
+	// TraceAttempt is called before each attempt made by the retry policy (see SetRetryPolicy),
+	// numbered from 0, with the request of the operation. It returns the request to send, for
+	// example with the trace context headers of the attempt, and the function called with the
+	// outcome of the attempt.
+	TraceAttempt(req *http.Request, attempt int) (*http.Request, func(resp *http.Response, err error))
+}
+
+// SetRequestTracer : Trace the requests sent by the service with "tracer"
+// The vpcv1otel package provides a tracer that records the requests as OpenTelemetry spans. A nil
+// tracer stops the tracing.
+func (vpc *VpcV1) SetRequestTracer(tracer RequestTracer) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.tracer = tracer
+	})
+}
+
+// GetRequestTracer : Return the tracer set with SetRequestTracer, or nil
+func (vpc *VpcV1) GetRequestTracer() RequestTracer {
+	if transport := vpc.getTransport(); transport != nil {
+		return transport.tracer
+	}
+	return nil
+}
+
+// traceAttempts returns a transport that sends each attempt of an operation with "next" in the
+// trace that "tracer" starts for it.
+func traceAttempts(tracer RequestTracer, next http.RoundTripper) http.RoundTripper {
+	attempt := 0
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req, end := tracer.TraceAttempt(req, attempt)
+		attempt++
+
+		resp, err := next.RoundTrip(req)
+		end(resp, err)
+		return resp, err
+	})
+}
@@ -0,0 +1,286 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// clientTransport is the http.RoundTripper that a VpcV1 instance installs on its HTTP client to
+// apply the client-side request policies configured on it (see SetRetryPolicy, SetRateLimiter,
+// SetRequestTracer, SetMetricsRecorder, Use, SetRequestLogger and SetResponseCache), the endpoint
+// selection (see SetEndpointResolver) and its application identity (see SetApplication). The
+// generated service methods pass the ID of their operation on the context of their requests (see
+// vpc_v1_generate.go).
+//
+// The generated service methods cannot carry per-instance state, so the policies live on the
+// transport itself. A clientTransport is never modified once installed: configuring a policy
+// installs an updated copy on a copy of the HTTP client, so that clones made earlier with Clone()
+// keep their own configuration.
+//
+// When automatic retries are enabled with EnableRetries, the transport is installed on the HTTP
+// client embedded in the retryable client, and so sees each attempt. The retryable client is
+// copied as well, since the clones share it. Because the transport wraps the original one,
+// configure the HTTP client (for example with DisableSSLVerification) before configuring any
+// policy.
+type clientTransport struct {
+	next http.RoundTripper
+
+	retryPolicy   *RetryPolicy
+	rateLimiter   *RateLimiter
+	tracer        RequestTracer
+	metrics       MetricsRecorder
+	userAgent     string
+	middlewares   []Middleware
+	requestLogger *RequestLogger
+	dryRun        *dryRun
+	responseCache *ResponseCache
+
+	endpointResolver *EndpointResolver
+
+	// The settings of the client for the values unknown to the SDK, applied by unmarshalModel.
+	retainUnknownProperties bool
+	unknownVariantHandler   UnknownVariantHandler
+}
+
+// roundTripperFunc is an adapter to allow the use of ordinary functions as http.RoundTrippers.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// clone returns a shallow copy of "transport".
+func (transport *clientTransport) clone() *clientTransport {
+	clone := *transport
+	return &clone
+}
+
+// RoundTrip implements http.RoundTripper.
+func (transport *clientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operationID := operationIDFromContext(req.Context())
+	req = transport.prepare(req)
+	if transport.dryRun != nil && isMutating(req.Method) {
+		return nil, transport.dryRun.plan(operationID, req)
+	}
+
+	if transport.responseCache != nil {
+		return transport.responseCache.roundTrip(operationID, req, transport.traceOperation)
+	}
+	return transport.traceOperation(operationID, req)
+}
+
+// prepare returns a copy of "req" as it is sent: with the application identity, the endpoint
+// selected for it, and the metadata of its operation.
+func (transport *clientTransport) prepare(req *http.Request) *http.Request {
+	if transport.userAgent != "" {
+		// A RoundTripper must not modify the caller's request.
+		req = req.Clone(req.Context())
+		req.Header.Set(common.HEADER_NAME_USER_AGENT, transport.userAgent)
+	}
+
+	if transport.endpointResolver != nil {
+		req = transport.endpointResolver.rewrite(req)
+	}
+	return req.WithContext(withOperationInfo(req.Context(), newOperationInfo(req)))
+}
+
+// traceOperation sends "req", on behalf of operation "operationID", in the trace of the operation.
+func (transport *clientTransport) traceOperation(operationID string, req *http.Request) (*http.Response, error) {
+	if transport.tracer == nil {
+		return transport.observe(operationID, req)
+	}
+	req, end := transport.tracer.TraceOperation(req)
+	resp, err := transport.observe(operationID, req)
+	end(resp, err)
+	return resp, err
+}
+
+// observe sends "req", on behalf of operation "operationID", and reports it to the metrics recorder,
+// unless VpcV1.request reports it once all of its attempts are made.
+func (transport *clientTransport) observe(operationID string, req *http.Request) (*http.Response, error) {
+	if transport.metrics == nil || operationAttemptsFromContext(req.Context()) != nil {
+		return transport.send(operationID, req)
+	}
+	return observeOperation(transport.metrics, operationID, req, transport.send)
+}
+
+// send sends "req", on behalf of operation "operationID", through the configured policies: each
+// attempt made by the retry policy is reported to the metrics recorder, waits for the rate limiter,
+// which reports the wait to the metrics recorder, is traced, goes through the middlewares,
+// is logged as sent, and fails over to the other endpoint of its region if it cannot connect.
+func (transport *clientTransport) send(operationID string, req *http.Request) (*http.Response, error) {
+	send := transport.next
+	if transport.endpointResolver != nil {
+		send = transport.endpointResolver.failover(send)
+	}
+	if transport.requestLogger != nil {
+		send = logAttempts(transport.requestLogger, operationID, send)
+	}
+	send = chain(transport.middlewares, send)
+	if transport.tracer != nil {
+		send = traceAttempts(transport.tracer, send)
+	}
+	if limiter := transport.rateLimiter; limiter != nil {
+		next, recorder := send, transport.metrics
+		send = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return limiter.roundTrip(next, req, func(wait time.Duration) {
+				if recorder != nil {
+					recorder.ObserveRateLimitWait(operationID, wait)
+				}
+			})
+		})
+	}
+	if transport.metrics != nil {
+		send = observeAttempts(transport.metrics, operationID, operationAttemptsFromContext(req.Context()), send)
+	}
+
+	if transport.retryPolicy != nil {
+		return transport.retryPolicy.roundTrip(send, operationID, req)
+	}
+	return send.RoundTrip(req)
+}
+
+// getTransport returns the clientTransport installed on "vpc", or nil if there is none.
+func (vpc *VpcV1) getTransport() *clientTransport {
+	httpClient := vpc.Service.GetHTTPClient()
+	if httpClient == nil {
+		return nil
+	}
+	transport, _ := httpClient.Transport.(*clientTransport)
+	return transport
+}
+
+// configureTransport installs an updated clientTransport on "vpc", after passing it to "configure".
+func (vpc *VpcV1) configureTransport(configure func(transport *clientTransport)) {
+	httpClient := vpc.Service.GetHTTPClient()
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	var transport *clientTransport
+	if current, ok := httpClient.Transport.(*clientTransport); ok {
+		transport = current.clone()
+	} else {
+		next := httpClient.Transport
+		if next == nil {
+			next = http.DefaultTransport
+		}
+		transport = &clientTransport{next: next}
+	}
+	configure(transport)
+
+	updatedClient := *httpClient
+	updatedClient.Transport = transport
+	vpc.setHTTPClient(&updatedClient)
+}
+
+// DisableSSLVerification : Skip the verification of the server SSL certificates
+// This is the same as vpc.Service.DisableSSLVerification, which no longer reaches the transport of
+// the HTTP client once a client-side request policy, such as an EndpointResolver, is configured.
+func (vpc *VpcV1) DisableSSLVerification() {
+	if vpc.getTransport() == nil {
+		vpc.Service.DisableSSLVerification()
+		return
+	}
+	vpc.configureTransport(func(transport *clientTransport) {
+		if next, ok := transport.next.(*http.Transport); ok {
+			// The transport may be shared, for example with the clones of "vpc".
+			next = next.Clone()
+			if next.TLSClientConfig == nil {
+				next.TLSClientConfig = &tls.Config{} // #nosec G402
+			}
+			next.TLSClientConfig.InsecureSkipVerify = true // #nosec G402
+			transport.next = next
+		}
+	})
+}
+
+// IsSSLDisabled : Return true if the verification of the server SSL certificates is skipped
+func (vpc *VpcV1) IsSSLDisabled() bool {
+	transport := vpc.getTransport()
+	if transport == nil {
+		return vpc.Service.IsSSLDisabled()
+	}
+	next, ok := transport.next.(*http.Transport)
+	return ok && next.TLSClientConfig != nil && next.TLSClientConfig.InsecureSkipVerify
+}
+
+// configureService configures "vpc" with the external configuration of the service "serviceName",
+// as vpc.Service.ConfigureService does, including the DISABLE_SSL property on the transport that
+// the options applied by NewVpcV1 may have wrapped. NewVpcV1UsingExternalConfig calls it (see
+// vpc_v1_generate.go).
+func (vpc *VpcV1) configureService(serviceName string) error {
+	err := vpc.Service.ConfigureService(serviceName)
+	if err != nil || vpc.getTransport() == nil {
+		return err
+	}
+	properties, err := core.GetServiceProperties(serviceName)
+	if err != nil {
+		return err
+	}
+	if disableSSL, _ := strconv.ParseBool(properties[core.PROPNAME_SVC_DISABLE_SSL]); disableSSL {
+		vpc.DisableSSLVerification()
+	}
+	return nil
+}
+
+// retryableTransport returns the transport of the retryable client of "vpc", or nil if automatic
+// retries are not enabled with EnableRetries.
+func (vpc *VpcV1) retryableTransport() *retryablehttp.RoundTripper {
+	if vpc.Service.Client == nil {
+		return nil
+	}
+	transport, _ := vpc.Service.Client.Transport.(*retryablehttp.RoundTripper)
+	return transport
+}
+
+// setHTTPClient sets "httpClient" as the HTTP client of "vpc". When automatic retries are enabled,
+// core.BaseService.SetHTTPClient updates the retryable client in place, which the clones of "vpc"
+// share, so it is replaced by a copy embedding "httpClient" instead.
+func (vpc *VpcV1) setHTTPClient(httpClient *http.Client) {
+	current := vpc.retryableTransport()
+	if current == nil {
+		vpc.Service.SetHTTPClient(httpClient)
+		return
+	}
+
+	retryable := &retryablehttp.Client{
+		HTTPClient:      httpClient,
+		Logger:          current.Client.Logger,
+		RetryWaitMin:    current.Client.RetryWaitMin,
+		RetryWaitMax:    current.Client.RetryWaitMax,
+		RetryMax:        current.Client.RetryMax,
+		RequestLogHook:  current.Client.RequestLogHook,
+		ResponseLogHook: current.Client.ResponseLogHook,
+		CheckRetry:      current.Client.CheckRetry,
+		Backoff:         current.Client.Backoff,
+		ErrorHandler:    current.Client.ErrorHandler,
+		PrepareRetry:    current.Client.PrepareRetry,
+	}
+	updatedShim := *vpc.Service.Client
+	updatedShim.Transport = &retryablehttp.RoundTripper{Client: retryable}
+	vpc.Service.Client = &updatedShim
+}
@@ -0,0 +1,159 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	common "github.com/IBM/vpc-go-sdk/common"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newHeaderServer returns a server that responds with "statusCode" and "body", without echoing
+// the request ID, and records the headers of the last request.
+func newHeaderServer(statusCode int, body string) (*httptest.Server, *http.Header) {
+	received := &http.Header{}
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		*received = req.Header.Clone()
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(statusCode)
+		fmt.Fprint(res, body)
+	}))
+	return server, received
+}
+
+var _ = Describe(`Context headers`, func() {
+	ctx := common.WithRequestID(common.WithHeaders(context.Background(), map[string]string{
+		"X-Tenant": "tenant-1",
+	}), "caller-request-id")
+
+	It(`Should send the request ID and headers of the context`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, response, err := vpcService.GetInstanceWithContext(ctx, vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("X-Request-Id")).To(Equal("caller-request-id"))
+		Expect(received.Get("X-Tenant")).To(Equal("tenant-1"))
+		Expect(common.GetRequestID(response)).To(Equal("caller-request-id"))
+	})
+	It(`Should surface the request ID of failed requests`, func() {
+		server, _ := newHeaderServer(404, `{"errors": [{"code": "not_found", "message": "Instance not found"}]}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, _, err := vpcService.GetInstanceWithContext(ctx, vpcService.NewGetInstanceOptions("i-1"))
+		apiErr, ok := vpcv1.ParseAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.RequestID).To(Equal("caller-request-id"))
+
+		vpcService.SetRetryPolicy(fastRetries)
+		_, _, err = vpcService.GetInstanceWithContext(ctx, vpcService.NewGetInstanceOptions("i-1"))
+		apiErr, ok = vpcv1.ParseAPIError(err)
+		Expect(ok).To(BeTrue())
+		Expect(apiErr.RequestID).To(Equal("caller-request-id"))
+	})
+	It(`Should surface the generated request ID`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("X-Request-Id")).ToNot(BeEmpty())
+		Expect(common.GetRequestID(response)).To(Equal(received.Get("X-Request-Id")))
+	})
+})
+
+var _ = Describe(`Client transport`, func() {
+	// sdkHeaders returns the names of the headers of "header" that are internal to the SDK.
+	sdkHeaders := func(header *http.Header) (names []string) {
+		for name := range *header {
+			if strings.HasPrefix(name, "X-Sdk-") {
+				names = append(names, name)
+			}
+		}
+		return
+	}
+
+	It(`Should not send headers internal to the SDK`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(sdkHeaders(received)).To(BeEmpty())
+
+		vpcService.Service.SetHTTPClient(&http.Client{})
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(sdkHeaders(received)).To(BeEmpty())
+
+		vpcService.SetApplication("my-cli", "")
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(sdkHeaders(received)).To(BeEmpty())
+	})
+	It(`Should pass the operation ID to the policies`, func() {
+		server, _ := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		var operationID string
+		vpcService := newTestVpcService(server.URL)
+		vpcService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripper(func(req *http.Request) (*http.Response, error) {
+				operationID = vpcv1.OperationInfoFromRequest(req).ID
+				return next.RoundTrip(req)
+			})
+		})
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(operationID).To(Equal("GetInstance"))
+	})
+	It(`Should keep the configuration of earlier clones with automatic retries`, func() {
+		server, _ := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		var count int32
+		vpcService := newTestVpcService(server.URL)
+		vpcService.EnableRetries(2, time.Millisecond)
+		clone := vpcService.Clone()
+		vpcService.Use(func(next http.RoundTripper) http.RoundTripper {
+			return roundTripper(func(req *http.Request) (*http.Response, error) {
+				atomic.AddInt32(&count, 1)
+				return next.RoundTrip(req)
+			})
+		})
+
+		_, _, err := clone.GetInstance(clone.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&count)).To(Equal(int32(0)))
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(atomic.LoadInt32(&count)).To(Equal(int32(1)))
+	})
+})
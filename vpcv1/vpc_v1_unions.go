@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+// The Unmarshal functions of the discriminated unions (the ...Intf types whose variant is selected
+// by a discriminator property, such as SecurityGroupRuleIntf by "protocol") fall back to
+// unmarshalUnknownVariant for the discriminator values they do not know. apigen rewrites them to do
+// so after the service code is regenerated (see vpc_v1_generate.go).
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// UnknownVariantError : A discriminated union value whose discriminator is unknown to the SDK
+// The API adds variants to discriminated unions over time, for example new security group rule
+// protocols. Such values are unmarshalled as the base model of the union, with the discriminator and
+// the other properties of the base model, rather than failing the whole response. The
+// UnknownVariantError describing each of them is passed to the handler set with
+// VpcV1.SetUnknownVariantHandler.
+type UnknownVariantError struct {
+	// The base model of the union, for example "SecurityGroupRule".
+	Model string
+
+	// The discriminator property, for example "protocol".
+	Discriminator string
+
+	// The value of the discriminator property.
+	Value string
+}
+
+// Error implements the error interface.
+func (e *UnknownVariantError) Error() string {
+	return fmt.Sprintf("unrecognized value for discriminator property '%s' of %s: %s", e.Discriminator, e.Model, e.Value)
+}
+
+// UnknownVariantHandler : A function called with each discriminated union value unmarshalled as its
+// base model because its discriminator is unknown
+// It is called once the response of a method is unmarshalled, possibly concurrently. If it returns
+// an error, the method fails with that error instead.
+type UnknownVariantHandler func(err *UnknownVariantError) error
+
+// SetUnknownVariantHandler : Report the discriminated union values whose discriminator is unknown,
+// in the responses of the methods of the client, to "handler", for example to log them or count
+// them
+// A handler returning the error it receives restores strict unmarshalling. A nil handler stops the
+// reporting.
+func (vpc *VpcV1) SetUnknownVariantHandler(handler UnknownVariantHandler) {
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.unknownVariantHandler = handler
+	})
+}
+
+// unknownVariantDiscriminators holds the discriminator property of the base models of the unions
+// unmarshalled for unknown discriminator values, by pointer type (for example *SecurityGroupRule).
+var unknownVariantDiscriminators sync.Map
+
+// unknownVariantOf returns the UnknownVariantError describing "value", or nil if it is not a base
+// model unmarshalled for an unknown discriminator value.
+func unknownVariantOf(value reflect.Value) *UnknownVariantError {
+	discriminator, found := unknownVariantDiscriminators.Load(value.Type())
+	if !found || value.IsNil() {
+		return nil
+	}
+	unknown := &UnknownVariantError{
+		Model:         value.Type().Elem().Name(),
+		Discriminator: discriminator.(string),
+	}
+	model := value.Elem()
+	for i := 0; i < model.NumField(); i++ {
+		name, _, _ := strings.Cut(model.Type().Field(i).Tag.Get("json"), ",")
+		if name == unknown.Discriminator {
+			if property, ok := model.Field(i).Interface().(*string); ok && property != nil {
+				unknown.Value = *property
+				return unknown
+			}
+		}
+	}
+	if extra, ok := model.FieldByName("Extra").Interface().(map[string]json.RawMessage); ok {
+		if raw, found := extra[unknown.Discriminator]; found && json.Unmarshal(raw, &unknown.Value) != nil {
+			unknown.Value = string(raw)
+		}
+	}
+	return unknown
+}
+
+// markUnknownVariant records that the base model T of a discriminated union with the discriminator
+// property "discriminator" is unmarshalled for an unknown discriminator value, so that the value is
+// reported to the handler of the client by VpcV1.unmarshalModel. The Unmarshal functions falling
+// back to a function unmarshalling the base model, such as UnmarshalSecurityGroupRuleGeneric, call
+// it (see vpc_v1_generate.go).
+func markUnknownVariant[T any](discriminator string) {
+	unknownVariantDiscriminators.Store(reflect.TypeOf(new(T)), discriminator)
+	unknownVariantsFound.Add(1)
+}
+
+// unmarshalUnknownVariant unmarshals "m", a value of a discriminated union with the discriminator
+// property "discriminator" whose value is unknown, into a new instance of the base model T, and
+// stores it in "result", a pointer to the union interface or to *T. The value is reported to the
+// handler of the client by VpcV1.unmarshalModel (see VpcV1.SetUnknownVariantHandler).
+//
+// Each property of the base model is unmarshalled independently, so that a property that cannot be
+// unmarshalled, such as a nested union, is left unset rather than failing the whole value. The other
+// properties are kept in the Extra field of the base model, if it has one (see
+// VpcV1.SetRetainUnknownProperties).
+func unmarshalUnknownVariant[T any](m map[string]json.RawMessage, discriminator string, result interface{}) (err error) {
+	markUnknownVariant[T](discriminator)
+	obj := new(T)
+
+	value := reflect.ValueOf(obj).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		raw, found := m[name]
+		if name == "" || name == "-" || !found || !field.IsExported() {
+			continue
+		}
+		property := reflect.New(field.Type)
+		if json.Unmarshal(raw, property.Interface()) == nil {
+			value.Field(i).Set(property.Elem())
+		}
+	}
+	if extra := value.FieldByName("Extra"); extra.IsValid() && extra.Type() == reflect.TypeOf(m) {
+		extra.Set(reflect.ValueOf(unknownProperties(m, obj)))
+	}
+
+	target := reflect.ValueOf(result)
+	if target.Kind() != reflect.Pointer || target.IsNil() || !reflect.TypeOf(obj).AssignableTo(target.Elem().Type()) {
+		err = fmt.Errorf("cannot store a %T in a %T", obj, result)
+		return core.SDKErrorf(err, "", "unknown-variant-result", common.GetComponentInfo())
+	}
+	target.Elem().Set(reflect.ValueOf(obj))
+	return nil
+}
@@ -0,0 +1,134 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// testUnion is the base model of a discriminated union, in the shape of the generated ones.
+type testUnion struct {
+	Kind   *string       `json:"kind"`
+	Name   *string       `json:"name,omitempty"`
+	Size   *int64        `json:"size,omitempty"`
+	Nested testUnionIntf `json:"nested,omitempty"`
+}
+
+type testUnionIntf interface {
+	isaTestUnion() bool
+}
+
+func (*testUnion) isaTestUnion() bool {
+	return true
+}
+
+// unmarshalTestUnion unmarshals a testUnion whose discriminator is unknown.
+func unmarshalTestUnion(m map[string]json.RawMessage, result interface{}) error {
+	return unmarshalUnknownVariant[testUnion](m, "kind", result)
+}
+
+var _ = Describe(`unmarshalUnknownVariant`, func() {
+	var m map[string]json.RawMessage
+	var vpc *VpcV1
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(`{"kind": "teleport", "name": "t-1", "size": 3, "nested": {"kind": "a"}, "extra": true}`), &m)).To(Succeed())
+		vpc = newUnknownTestClient("http://localhost")
+	})
+
+	It(`Should unmarshal the base model with the discriminator`, func() {
+		var reported []*UnknownVariantError
+		vpc.SetUnknownVariantHandler(func(err *UnknownVariantError) error {
+			reported = append(reported, err)
+			return nil
+		})
+
+		var result testUnionIntf
+		Expect(vpc.unmarshalModel(m, "", &result, unmarshalTestUnion)).To(Succeed())
+		union, ok := result.(*testUnion)
+		Expect(ok).To(BeTrue())
+		Expect(*union.Kind).To(Equal("teleport"))
+		Expect(*union.Name).To(Equal("t-1"))
+		Expect(*union.Size).To(Equal(int64(3)))
+		Expect(union.Nested).To(BeNil())
+		Expect(reported).To(Equal([]*UnknownVariantError{{Model: "testUnion", Discriminator: "kind", Value: "teleport"}}))
+		Expect(reported[0].Error()).To(Equal("unrecognized value for discriminator property 'kind' of testUnion: teleport"))
+	})
+	It(`Should store the base model in a pointer to it`, func() {
+		var result *testUnion
+		Expect(unmarshalUnknownVariant[testUnion](m, "kind", &result)).To(Succeed())
+		Expect(*result.Name).To(Equal("t-1"))
+
+		var wrong *string
+		Expect(unmarshalUnknownVariant[testUnion](m, "kind", &wrong)).ToNot(Succeed())
+	})
+	It(`Should report the values in the nested unions and slices`, func() {
+		var reported []string
+		vpc.SetUnknownVariantHandler(func(err *UnknownVariantError) error {
+			reported = append(reported, err.Value)
+			return nil
+		})
+
+		var result []testUnionIntf
+		Expect(vpc.unmarshalModel(map[string]json.RawMessage{"unions": json.RawMessage(`[{"kind": "a"}, {"kind": "b"}]`)},
+			"unions", &result, unmarshalTestUnion)).To(Succeed())
+		Expect(result).To(HaveLen(2))
+		Expect(reported).To(Equal([]string{"a", "b"}))
+	})
+	It(`Should fail with the error of the handler`, func() {
+		vpc.SetUnknownVariantHandler(func(err *UnknownVariantError) error {
+			return err
+		})
+
+		var result testUnionIntf
+		err := vpc.unmarshalModel(m, "", &result, unmarshalTestUnion)
+		var unknown *UnknownVariantError
+		Expect(errors.As(err, &unknown)).To(BeTrue())
+		Expect(unknown.Value).To(Equal("teleport"))
+
+		// The handler of a client does not apply to the others.
+		other := newUnknownTestClient("http://localhost")
+		Expect(other.unmarshalModel(m, "", &result, unmarshalTestUnion)).To(Succeed())
+	})
+	It(`Should report the unknown protocols of the security group rules`, func() {
+		var reported []*UnknownVariantError
+		vpc.SetUnknownVariantHandler(func(err *UnknownVariantError) error {
+			reported = append(reported, err)
+			return nil
+		})
+
+		var rules map[string]json.RawMessage
+		Expect(json.Unmarshal([]byte(`{"rules": [
+			{"id": "r-1", "direction": "inbound", "protocol": "tcp", "port_min": 22, "port_max": 22, "remote": {"cidr_block": "10.0.0.0/8"}},
+			{"id": "r-2", "direction": "inbound", "protocol": "quic", "remote": {"cidr_block": "192.168.0.0/16"}}
+		]}`), &rules)).To(Succeed())
+		var result []SecurityGroupRuleIntf
+		Expect(vpc.unmarshalModel(rules, "rules", &result, UnmarshalSecurityGroupRule)).To(Succeed())
+		Expect(result).To(HaveLen(2))
+		Expect(result[0]).To(BeAssignableToTypeOf(&SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{}))
+		rule, ok := result[1].(*SecurityGroupRule)
+		Expect(ok).To(BeTrue())
+		Expect(*rule.Protocol).To(Equal("quic"))
+		remote, ok := rule.Remote.(*SecurityGroupRuleRemote)
+		Expect(ok).To(BeTrue())
+		Expect(*remote.CIDRBlock).To(Equal("192.168.0.0/16"))
+		Expect(reported).To(Equal([]*UnknownVariantError{{Model: "SecurityGroupRule", Discriminator: "protocol", Value: "quic"}}))
+	})
+})
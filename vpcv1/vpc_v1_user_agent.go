@@ -0,0 +1,44 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// SetApplication : Identify the application using the service in the User-Agent of its requests
+// The application "name" at "version", and any additional product tokens (for example
+// "terraform/1.9.0"), are appended to the SDK's User-Agent, so that several tools in one process
+// can be told apart in support traces. The identity applies to this instance and to the clones
+// made from it afterwards. An empty name restores the SDK's User-Agent.
+func (vpc *VpcV1) SetApplication(name string, version string, products ...string) {
+	userAgent := ""
+	if name != "" {
+		userAgent = common.BuildUserAgent(name, version, products...)
+	}
+	vpc.configureTransport(func(transport *clientTransport) {
+		transport.userAgent = userAgent
+	})
+}
+
+// GetUserAgent : Return the User-Agent of the requests sent by the service
+func (vpc *VpcV1) GetUserAgent() string {
+	if transport := vpc.getTransport(); transport != nil && transport.userAgent != "" {
+		return transport.userAgent
+	}
+	return common.GetUserAgentInfo()
+}
@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Application identity`, func() {
+	It(`Should identify each client separately`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService := newTestVpcService(server.URL)
+		Expect(vpcService.GetUserAgent()).To(Equal(common.GetUserAgentInfo()))
+		vpcService.SetApplication("my-controller", "2.0.1", "kubernetes/1.31")
+		otherService := newTestVpcService(server.URL)
+		otherService.SetApplication("my-cli", "")
+		clone := vpcService.Clone()
+
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("User-Agent")).To(Equal(common.GetUserAgentInfo() + " my-controller/2.0.1 kubernetes/1.31"))
+		Expect(vpcService.GetUserAgent()).To(Equal(received.Get("User-Agent")))
+
+		_, _, err = clone.GetInstance(clone.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("User-Agent")).To(Equal(common.GetUserAgentInfo() + " my-controller/2.0.1 kubernetes/1.31"))
+
+		_, _, err = otherService.GetInstance(otherService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("User-Agent")).To(Equal(common.GetUserAgentInfo() + " my-cli"))
+
+		vpcService.SetApplication("", "")
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("User-Agent")).To(Equal(common.GetUserAgentInfo()))
+	})
+	It(`Should identify the application set in the options`, func() {
+		server, received := newHeaderServer(200, `{"id": "i-1"}`)
+		defer server.Close()
+
+		vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:                 server.URL,
+			Authenticator:       &core.NoAuthAuthenticator{},
+			Application:         "my-controller",
+			ApplicationVersion:  "2.0.1",
+			ApplicationProducts: []string{"kubernetes/1.31"},
+		})
+		Expect(err).To(BeNil())
+		_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		Expect(err).To(BeNil())
+		Expect(received.Get("User-Agent")).To(Equal(common.GetUserAgentInfo() + " my-controller/2.0.1 kubernetes/1.31"))
+	})
+})
@@ -0,0 +1,526 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/vpc-go-sdk/common"
+)
+
+// Default values used by the WaitFor* methods when the corresponding
+// WaiterOptions field is left unset.
+const (
+	DefaultWaiterTimeout  = 30 * time.Minute
+	DefaultWaiterMinDelay = 5 * time.Second
+	DefaultWaiterMaxDelay = 60 * time.Second
+	DefaultWaiterJitter   = 0.2
+)
+
+// WaiterOptions : Options that control how the WaitFor* methods poll a resource.
+// A nil *WaiterOptions, or any field left at its zero value, selects the defaults.
+type WaiterOptions struct {
+	// The maximum amount of time to wait before giving up.
+	Timeout time.Duration
+
+	// The delay before the second poll. Each later delay doubles, up to MaxDelay.
+	MinDelay time.Duration
+
+	// The upper bound for the delay between two polls.
+	MaxDelay time.Duration
+
+	// The fraction (between 0 and 1) by which each delay is randomly shortened or
+	// lengthened, so that many concurrent waiters do not poll in lockstep.
+	Jitter float64
+}
+
+// NewWaiterOptions : Instantiate WaiterOptions with the default values.
+func NewWaiterOptions() *WaiterOptions {
+	return &WaiterOptions{
+		Timeout:  DefaultWaiterTimeout,
+		MinDelay: DefaultWaiterMinDelay,
+		MaxDelay: DefaultWaiterMaxDelay,
+		Jitter:   DefaultWaiterJitter,
+	}
+}
+
+// SetTimeout : Allow user to set Timeout
+func (options *WaiterOptions) SetTimeout(timeout time.Duration) *WaiterOptions {
+	options.Timeout = timeout
+	return options
+}
+
+// SetMinDelay : Allow user to set MinDelay
+func (options *WaiterOptions) SetMinDelay(minDelay time.Duration) *WaiterOptions {
+	options.MinDelay = minDelay
+	return options
+}
+
+// SetMaxDelay : Allow user to set MaxDelay
+func (options *WaiterOptions) SetMaxDelay(maxDelay time.Duration) *WaiterOptions {
+	options.MaxDelay = maxDelay
+	return options
+}
+
+// SetJitter : Allow user to set Jitter
+func (options *WaiterOptions) SetJitter(jitter float64) *WaiterOptions {
+	options.Jitter = jitter
+	return options
+}
+
+// withDefaults returns a copy of "options" with every unset field defaulted.
+func (options *WaiterOptions) withDefaults() *WaiterOptions {
+	resolved := NewWaiterOptions()
+	if options == nil {
+		return resolved
+	}
+	if options.Timeout > 0 {
+		resolved.Timeout = options.Timeout
+	}
+	if options.MinDelay > 0 {
+		resolved.MinDelay = options.MinDelay
+	}
+	if options.MaxDelay > 0 {
+		resolved.MaxDelay = options.MaxDelay
+	}
+	if resolved.MaxDelay < resolved.MinDelay {
+		resolved.MaxDelay = resolved.MinDelay
+	}
+	if options.Jitter > 0 {
+		resolved.Jitter = min(options.Jitter, 1)
+	}
+	return resolved
+}
+
+// delay returns the (jittered) time to sleep after poll number "attempt" (0-based).
+func (options *WaiterOptions) delay(attempt int) time.Duration {
+	delay := options.MaxDelay
+	if attempt < 32 {
+		delay = min(options.MinDelay<<attempt, options.MaxDelay)
+	}
+	if options.Jitter > 0 {
+		delay += time.Duration((rand.Float64()*2 - 1) * options.Jitter * float64(delay))
+	}
+	return delay
+}
+
+// ResourceStateError : Returned (wrapped in a core.SDKProblem) by the WaitFor* methods when a resource
+// enters a state that it will not leave on its own, such as "failed", or settles in a degraded
+// health state. Use errors.As to retrieve it.
+type ResourceStateError struct {
+	// The kind of resource being waited on, for example "instance".
+	ResourceType string
+
+	// The identifier of the resource.
+	ID string
+
+	// The name of the property that holds the offending state, for example "status" or "health_state".
+	Property string
+
+	// The offending state.
+	State string
+}
+
+// Error implements the error interface.
+func (e *ResourceStateError) Error() string {
+	return fmt.Sprintf("%s '%s' has %s '%s'", e.ResourceType, e.ID, e.Property, e.State)
+}
+
+// refreshFunc reports the current state of a resource being waited on: the latest copy of the
+// resource, whether the wait is over, and the error (if any) that ended it.
+type refreshFunc[T any] func(ctx context.Context) (result T, done bool, err error)
+
+// waitFor invokes "refresh" until it reports that the wait is over, sleeping between
+// invocations according to "options". The transient errors of "refresh" (see isTransient) are
+// retried. The wait ends early if "refresh" fails otherwise, or if "ctx" is cancelled or the
+// configured timeout expires; the error then mentions the last transient error, if any.
+func waitFor[T any](ctx context.Context, options *WaiterOptions, refresh refreshFunc[T]) (result T, err error) {
+	options = options.withDefaults()
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
+	defer cancel()
+
+	var transientErr error
+	for attempt := 0; ; attempt++ {
+		var done bool
+		result, done, err = refresh(ctx)
+		if done || (err != nil && !isTransient(err)) {
+			return
+		}
+		// The error of a request interrupted by the end of the wait does not tell what went wrong.
+		if err == nil || ctx.Err() == nil {
+			transientErr = err
+		}
+
+		timer := time.NewTimer(options.delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			summary := ""
+			if transientErr != nil {
+				// The problem of the error would replace ctx.Err() as the cause.
+				summary = fmt.Sprintf("%s (last error: %s)", ctx.Err(), transientErr)
+			}
+			err = core.SDKErrorf(ctx.Err(), summary, "wait-ended", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// isTransient returns true if "err", the error of a request, may not happen again: an error
+// response with the status code 429 Too Many Requests or 5xx, or a failure to get a response. The
+// other error responses, such as 403 Forbidden, and the errors raised before sending the request
+// are not.
+func isTransient(err error) bool {
+	if apiErr, ok := ParseAPIError(err); ok {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isNotFound returns true if "response" indicates that the requested resource does not exist.
+func isNotFound(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+// stateErrorf returns a ResourceStateError wrapped in a core.SDKProblem.
+func stateErrorf(resourceType string, id *string, property string, state *string) error {
+	stateErr := &ResourceStateError{
+		ResourceType: resourceType,
+		ID:           core.StringNilMapper(id),
+		Property:     property,
+		State:        core.StringNilMapper(state),
+	}
+	return core.SDKErrorf(stateErr, "", "resource-state-error", common.GetComponentInfo())
+}
+
+// hasState returns true if "state" is non-nil and equal to one of "states".
+func hasState(state *string, states ...string) bool {
+	if state == nil {
+		return false
+	}
+	for _, s := range states {
+		if *state == s {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForDeleted : Wait for a resource to be deleted
+// Invokes "get" until it fails with a 404 Not Found response. The transient errors returned by
+// "get", such as 503 Service Unavailable responses, are retried until the timeout expires; any
+// other error ends the wait. Use this method for resource types that have no dedicated
+// WaitFor*Deleted method, for example:
+//
+//	err := vpcService.WaitForDeleted(ctx, func(ctx context.Context) (*core.DetailedResponse, error) {
+//		_, response, err := vpcService.GetSubnetWithContext(ctx, vpcService.NewGetSubnetOptions(id))
+//		return response, err
+//	}, nil)
+func (vpc *VpcV1) WaitForDeleted(ctx context.Context, get func(ctx context.Context) (*core.DetailedResponse, error), options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, func(ctx context.Context) (_ any, done bool, err error) {
+		response, err := get(ctx)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		return nil, false, err
+	})
+	return
+}
+
+// WaitForInstanceStatus : Wait for an instance to reach a status
+// Polls the instance until its `status` equals "status" (see the InstanceStatus*Const constants),
+// and returns it. The wait fails if the instance's `status` or `lifecycle_state` becomes `failed`.
+// If the instance reaches the status with a `health_state` of `degraded` or `faulted`, the
+// instance is returned along with a ResourceStateError.
+func (vpc *VpcV1) WaitForInstanceStatus(ctx context.Context, id string, status string, options *WaiterOptions) (result *Instance, err error) {
+	return waitFor(ctx, options, vpc.instanceStatusRefresh(id, status))
+}
+
+// WaitForInstanceDeleted : Wait for an instance to be deleted
+// The wait fails if the instance's `status` or `lifecycle_state` becomes `failed` first.
+func (vpc *VpcV1) WaitForInstanceDeleted(ctx context.Context, id string, options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, vpc.instanceDeletedRefresh(id))
+	return
+}
+
+// instanceFailed returns a ResourceStateError if "instance" has failed.
+func instanceFailed(instance *Instance) error {
+	if hasState(instance.Status, InstanceStatusFailedConst) {
+		return stateErrorf("instance", instance.ID, "status", instance.Status)
+	}
+	if hasState(instance.LifecycleState, InstanceLifecycleStateFailedConst) {
+		return stateErrorf("instance", instance.ID, "lifecycle_state", instance.LifecycleState)
+	}
+	return nil
+}
+
+func (vpc *VpcV1) instanceStatusRefresh(id string, status string) refreshFunc[*Instance] {
+	getInstanceOptions := vpc.NewGetInstanceOptions(id)
+	return func(ctx context.Context) (instance *Instance, done bool, err error) {
+		instance, _, err = vpc.GetInstanceWithContext(ctx, getInstanceOptions)
+		if err != nil {
+			return
+		}
+		if hasState(instance.Status, status) {
+			if hasState(instance.HealthState, InstanceHealthStateDegradedConst, InstanceHealthStateFaultedConst) {
+				return instance, true, stateErrorf("instance", instance.ID, "health_state", instance.HealthState)
+			}
+			return instance, true, nil
+		}
+		err = instanceFailed(instance)
+		return instance, err != nil, err
+	}
+}
+
+func (vpc *VpcV1) instanceDeletedRefresh(id string) refreshFunc[*Instance] {
+	getInstanceOptions := vpc.NewGetInstanceOptions(id)
+	return func(ctx context.Context) (instance *Instance, done bool, err error) {
+		instance, response, err := vpc.GetInstanceWithContext(ctx, getInstanceOptions)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		if err != nil {
+			return
+		}
+		err = instanceFailed(instance)
+		return instance, err != nil, err
+	}
+}
+
+// WaitForVolumeAvailable : Wait for a volume to become available
+// Polls the volume until its `status` is `available`, and returns it. The wait fails if the
+// `status` becomes `failed` or `unusable`. If the volume becomes available with a `health_state`
+// of `degraded` or `faulted`, the volume is returned along with a ResourceStateError.
+func (vpc *VpcV1) WaitForVolumeAvailable(ctx context.Context, id string, options *WaiterOptions) (result *Volume, err error) {
+	return waitFor(ctx, options, vpc.volumeAvailableRefresh(id))
+}
+
+// WaitForVolumeDeleted : Wait for a volume to be deleted
+// The wait fails if the volume's `status` becomes `failed` first.
+func (vpc *VpcV1) WaitForVolumeDeleted(ctx context.Context, id string, options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, vpc.volumeDeletedRefresh(id))
+	return
+}
+
+func (vpc *VpcV1) volumeAvailableRefresh(id string) refreshFunc[*Volume] {
+	getVolumeOptions := vpc.NewGetVolumeOptions(id)
+	return func(ctx context.Context) (volume *Volume, done bool, err error) {
+		volume, _, err = vpc.GetVolumeWithContext(ctx, getVolumeOptions)
+		if err != nil {
+			return
+		}
+		if hasState(volume.Status, VolumeStatusFailedConst, VolumeStatusUnusableConst) {
+			return volume, true, stateErrorf("volume", volume.ID, "status", volume.Status)
+		}
+		if !hasState(volume.Status, VolumeStatusAvailableConst) {
+			return
+		}
+		if hasState(volume.HealthState, VolumeHealthStateDegradedConst, VolumeHealthStateFaultedConst) {
+			return volume, true, stateErrorf("volume", volume.ID, "health_state", volume.HealthState)
+		}
+		return volume, true, nil
+	}
+}
+
+func (vpc *VpcV1) volumeDeletedRefresh(id string) refreshFunc[*Volume] {
+	getVolumeOptions := vpc.NewGetVolumeOptions(id)
+	return func(ctx context.Context) (volume *Volume, done bool, err error) {
+		volume, response, err := vpc.GetVolumeWithContext(ctx, getVolumeOptions)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		if err != nil {
+			return
+		}
+		if hasState(volume.Status, VolumeStatusFailedConst) {
+			return volume, true, stateErrorf("volume", volume.ID, "status", volume.Status)
+		}
+		return
+	}
+}
+
+// WaitForLoadBalancerActive : Wait for a load balancer to become active
+// Polls the load balancer until its `provisioning_status` is `active`, and returns it. The wait
+// fails if the `provisioning_status` becomes `failed`.
+func (vpc *VpcV1) WaitForLoadBalancerActive(ctx context.Context, id string, options *WaiterOptions) (result *LoadBalancer, err error) {
+	return waitFor(ctx, options, vpc.loadBalancerActiveRefresh(id))
+}
+
+// WaitForLoadBalancerDeleted : Wait for a load balancer to be deleted
+// The wait fails if the load balancer's `provisioning_status` becomes `failed` first.
+func (vpc *VpcV1) WaitForLoadBalancerDeleted(ctx context.Context, id string, options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, vpc.loadBalancerDeletedRefresh(id))
+	return
+}
+
+func (vpc *VpcV1) loadBalancerActiveRefresh(id string) refreshFunc[*LoadBalancer] {
+	getLoadBalancerOptions := vpc.NewGetLoadBalancerOptions(id)
+	return func(ctx context.Context) (loadBalancer *LoadBalancer, done bool, err error) {
+		loadBalancer, _, err = vpc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
+		if err != nil {
+			return
+		}
+		if hasState(loadBalancer.ProvisioningStatus, LoadBalancerProvisioningStatusFailedConst) {
+			return loadBalancer, true, stateErrorf("load balancer", loadBalancer.ID, "provisioning_status", loadBalancer.ProvisioningStatus)
+		}
+		return loadBalancer, hasState(loadBalancer.ProvisioningStatus, LoadBalancerProvisioningStatusActiveConst), nil
+	}
+}
+
+func (vpc *VpcV1) loadBalancerDeletedRefresh(id string) refreshFunc[*LoadBalancer] {
+	getLoadBalancerOptions := vpc.NewGetLoadBalancerOptions(id)
+	return func(ctx context.Context) (loadBalancer *LoadBalancer, done bool, err error) {
+		loadBalancer, response, err := vpc.GetLoadBalancerWithContext(ctx, getLoadBalancerOptions)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		if err != nil {
+			return
+		}
+		if hasState(loadBalancer.ProvisioningStatus, LoadBalancerProvisioningStatusFailedConst) {
+			return loadBalancer, true, stateErrorf("load balancer", loadBalancer.ID, "provisioning_status", loadBalancer.ProvisioningStatus)
+		}
+		return
+	}
+}
+
+// vpnGatewayFields returns the common properties of a VPN gateway, whichever type of the union
+// holds it: VPNGateway, VPNGatewayRouteMode, VPNGatewayPolicyMode, or a variant added later.
+func vpnGatewayFields(vpnGateway VPNGatewayIntf) (id, href, lifecycleState, healthState *string) {
+	value := reflect.Indirect(reflect.ValueOf(vpnGateway))
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	field := func(name string) *string {
+		property := value.FieldByName(name)
+		if !property.IsValid() {
+			return nil
+		}
+		result, _ := property.Interface().(*string)
+		return result
+	}
+	return field("ID"), field("Href"), field("LifecycleState"), field("HealthState")
+}
+
+// WaitForVPNGatewayStable : Wait for a VPN gateway to become stable
+// Polls the VPN gateway until its `lifecycle_state` is `stable`, and returns it. The wait fails if
+// the `lifecycle_state` becomes `failed`. If the gateway becomes stable with a `health_state` of
+// `degraded` or `faulted`, the gateway is returned along with a ResourceStateError.
+func (vpc *VpcV1) WaitForVPNGatewayStable(ctx context.Context, id string, options *WaiterOptions) (result VPNGatewayIntf, err error) {
+	return waitFor(ctx, options, vpc.vpnGatewayStableRefresh(id))
+}
+
+// WaitForVPNGatewayDeleted : Wait for a VPN gateway to be deleted
+// The wait fails if the VPN gateway's `lifecycle_state` becomes `failed` first.
+func (vpc *VpcV1) WaitForVPNGatewayDeleted(ctx context.Context, id string, options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, vpc.vpnGatewayDeletedRefresh(id))
+	return
+}
+
+func (vpc *VpcV1) vpnGatewayStableRefresh(id string) refreshFunc[VPNGatewayIntf] {
+	getVPNGatewayOptions := vpc.NewGetVPNGatewayOptions(id)
+	return func(ctx context.Context) (vpnGateway VPNGatewayIntf, done bool, err error) {
+		vpnGateway, _, err = vpc.GetVPNGatewayWithContext(ctx, getVPNGatewayOptions)
+		if err != nil {
+			return
+		}
+		gatewayID, _, lifecycleState, healthState := vpnGatewayFields(vpnGateway)
+		if hasState(lifecycleState, VPNGatewayLifecycleStateFailedConst) {
+			return vpnGateway, true, stateErrorf("VPN gateway", gatewayID, "lifecycle_state", lifecycleState)
+		}
+		if !hasState(lifecycleState, VPNGatewayLifecycleStateStableConst) {
+			return
+		}
+		if hasState(healthState, VPNGatewayHealthStateDegradedConst, VPNGatewayHealthStateFaultedConst) {
+			return vpnGateway, true, stateErrorf("VPN gateway", gatewayID, "health_state", healthState)
+		}
+		return vpnGateway, true, nil
+	}
+}
+
+func (vpc *VpcV1) vpnGatewayDeletedRefresh(id string) refreshFunc[VPNGatewayIntf] {
+	getVPNGatewayOptions := vpc.NewGetVPNGatewayOptions(id)
+	return func(ctx context.Context) (vpnGateway VPNGatewayIntf, done bool, err error) {
+		vpnGateway, response, err := vpc.GetVPNGatewayWithContext(ctx, getVPNGatewayOptions)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		if err != nil {
+			return
+		}
+		gatewayID, _, lifecycleState, _ := vpnGatewayFields(vpnGateway)
+		if hasState(lifecycleState, VPNGatewayLifecycleStateFailedConst) {
+			return vpnGateway, true, stateErrorf("VPN gateway", gatewayID, "lifecycle_state", lifecycleState)
+		}
+		return
+	}
+}
+
+// WaitForSnapshotStable : Wait for a snapshot to become stable
+// Polls the snapshot until its `lifecycle_state` is `stable`, and returns it. The wait fails if
+// the `lifecycle_state` becomes `failed`.
+func (vpc *VpcV1) WaitForSnapshotStable(ctx context.Context, id string, options *WaiterOptions) (result *Snapshot, err error) {
+	return waitFor(ctx, options, vpc.snapshotStableRefresh(id))
+}
+
+// WaitForSnapshotDeleted : Wait for a snapshot to be deleted
+// The wait fails if the snapshot's `lifecycle_state` becomes `failed` first.
+func (vpc *VpcV1) WaitForSnapshotDeleted(ctx context.Context, id string, options *WaiterOptions) (err error) {
+	_, err = waitFor(ctx, options, vpc.snapshotDeletedRefresh(id))
+	return
+}
+
+func (vpc *VpcV1) snapshotStableRefresh(id string) refreshFunc[*Snapshot] {
+	getSnapshotOptions := vpc.NewGetSnapshotOptions(id)
+	return func(ctx context.Context) (snapshot *Snapshot, done bool, err error) {
+		snapshot, _, err = vpc.GetSnapshotWithContext(ctx, getSnapshotOptions)
+		if err != nil {
+			return
+		}
+		if hasState(snapshot.LifecycleState, SnapshotLifecycleStateFailedConst) {
+			return snapshot, true, stateErrorf("snapshot", snapshot.ID, "lifecycle_state", snapshot.LifecycleState)
+		}
+		return snapshot, hasState(snapshot.LifecycleState, SnapshotLifecycleStateStableConst), nil
+	}
+}
+
+func (vpc *VpcV1) snapshotDeletedRefresh(id string) refreshFunc[*Snapshot] {
+	getSnapshotOptions := vpc.NewGetSnapshotOptions(id)
+	return func(ctx context.Context) (snapshot *Snapshot, done bool, err error) {
+		snapshot, response, err := vpc.GetSnapshotWithContext(ctx, getSnapshotOptions)
+		if isNotFound(response) {
+			return nil, true, nil
+		}
+		if err != nil {
+			return
+		}
+		if hasState(snapshot.LifecycleState, SnapshotLifecycleStateFailedConst) {
+			return snapshot, true, stateErrorf("snapshot", snapshot.ID, "lifecycle_state", snapshot.LifecycleState)
+		}
+		return
+	}
+}
@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// newSequenceServer returns a test server that answers the n-th request with bodies[n]
+// (repeating the last entry). An empty body is answered with 404 Not Found.
+func newSequenceServer(bodies ...string) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		n := int(atomic.AddInt32(&count, 1)) - 1
+		body := bodies[min(n, len(bodies)-1)]
+		res.Header().Set("Content-type", "application/json")
+		if body == "" {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Resource not found"}]}`)
+			return
+		}
+		res.WriteHeader(200)
+		fmt.Fprint(res, body)
+	}))
+	return server, &count
+}
+
+func newTestVpcService(url string) *vpcv1.VpcV1 {
+	vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	Expect(err).To(BeNil())
+	return vpcService
+}
+
+var fastWaiter = vpcv1.NewWaiterOptions().SetMinDelay(time.Millisecond).SetMaxDelay(2 * time.Millisecond)
+
+var _ = Describe(`Waiters`, func() {
+	Describe(`WaiterOptions`, func() {
+		It(`Should fill in defaults and allow overrides`, func() {
+			options := vpcv1.NewWaiterOptions()
+			Expect(options.Timeout).To(Equal(vpcv1.DefaultWaiterTimeout))
+			Expect(options.MinDelay).To(Equal(vpcv1.DefaultWaiterMinDelay))
+			Expect(options.MaxDelay).To(Equal(vpcv1.DefaultWaiterMaxDelay))
+			Expect(options.Jitter).To(Equal(vpcv1.DefaultWaiterJitter))
+
+			options.SetTimeout(time.Second).SetJitter(0.5)
+			Expect(options.Timeout).To(Equal(time.Second))
+			Expect(options.Jitter).To(Equal(0.5))
+		})
+	})
+	Describe(`WaitForInstanceStatus`, func() {
+		It(`Should poll until the requested status is reached`, func() {
+			server, count := newSequenceServer(
+				`{"id": "i-1", "status": "pending", "lifecycle_state": "pending"}`,
+				`{"id": "i-1", "status": "starting", "lifecycle_state": "pending"}`,
+				`{"id": "i-1", "status": "running", "lifecycle_state": "stable"}`,
+			)
+			defer server.Close()
+
+			instance, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, fastWaiter)
+			Expect(err).To(BeNil())
+			Expect(*instance.Status).To(Equal("running"))
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(3)))
+		})
+		It(`Should fail when the instance fails`, func() {
+			server, _ := newSequenceServer(
+				`{"id": "i-1", "status": "pending", "lifecycle_state": "pending"}`,
+				`{"id": "i-1", "status": "failed", "lifecycle_state": "failed"}`,
+			)
+			defer server.Close()
+
+			instance, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, fastWaiter)
+			Expect(err).ToNot(BeNil())
+			Expect(instance).ToNot(BeNil())
+
+			var stateErr *vpcv1.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.ID).To(Equal("i-1"))
+			Expect(stateErr.Property).To(Equal("status"))
+			Expect(stateErr.State).To(Equal("failed"))
+		})
+		It(`Should report a degraded instance`, func() {
+			server, _ := newSequenceServer(
+				`{"id": "i-1", "status": "starting", "lifecycle_state": "pending", "health_state": "inapplicable"}`,
+				`{"id": "i-1", "status": "running", "lifecycle_state": "stable", "health_state": "degraded"}`,
+			)
+			defer server.Close()
+
+			instance, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, fastWaiter)
+			Expect(instance).ToNot(BeNil())
+			var stateErr *vpcv1.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Property).To(Equal("health_state"))
+			Expect(stateErr.State).To(Equal("degraded"))
+		})
+		It(`Should retry the transient errors`, func() {
+			var count int32
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				switch atomic.AddInt32(&count, 1) {
+				case 1:
+					res.WriteHeader(503)
+				case 2:
+					res.WriteHeader(429)
+				default:
+					res.Header().Set("Content-type", "application/json")
+					fmt.Fprint(res, `{"id": "i-1", "status": "running", "lifecycle_state": "stable"}`)
+				}
+			}))
+			defer server.Close()
+
+			instance, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, fastWaiter)
+			Expect(err).To(BeNil())
+			Expect(*instance.Status).To(Equal("running"))
+			Expect(atomic.LoadInt32(&count)).To(Equal(int32(3)))
+		})
+		It(`Should retry the connection errors until the timeout expires`, func() {
+			server := httptest.NewServer(http.NotFoundHandler())
+			server.Close()
+
+			options := vpcv1.NewWaiterOptions().SetTimeout(20 * time.Millisecond).SetMinDelay(time.Millisecond)
+			_, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, options)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("connection refused"))
+		})
+		It(`Should give up when the timeout expires`, func() {
+			server, _ := newSequenceServer(`{"id": "i-1", "status": "pending", "lifecycle_state": "pending"}`)
+			defer server.Close()
+
+			options := vpcv1.NewWaiterOptions().SetTimeout(20 * time.Millisecond).SetMinDelay(time.Millisecond)
+			_, err := newTestVpcService(server.URL).WaitForInstanceStatus(context.Background(), "i-1", vpcv1.InstanceStatusRunningConst, options)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+		})
+	})
+	Describe(`WaitForVolumeAvailable`, func() {
+		It(`Should report a degraded volume`, func() {
+			server, _ := newSequenceServer(
+				`{"id": "v-1", "status": "pending", "health_state": "inapplicable"}`,
+				`{"id": "v-1", "status": "available", "health_state": "degraded"}`,
+			)
+			defer server.Close()
+
+			volume, err := newTestVpcService(server.URL).WaitForVolumeAvailable(context.Background(), "v-1", fastWaiter)
+			Expect(volume).ToNot(BeNil())
+			var stateErr *vpcv1.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.Property).To(Equal("health_state"))
+			Expect(stateErr.State).To(Equal("degraded"))
+		})
+	})
+	Describe(`WaitForLoadBalancerActive`, func() {
+		It(`Should poll until the load balancer is active`, func() {
+			server, _ := newSequenceServer(
+				`{"id": "lb-1", "provisioning_status": "create_pending"}`,
+				`{"id": "lb-1", "provisioning_status": "active"}`,
+			)
+			defer server.Close()
+
+			loadBalancer, err := newTestVpcService(server.URL).WaitForLoadBalancerActive(context.Background(), "lb-1", fastWaiter)
+			Expect(err).To(BeNil())
+			Expect(*loadBalancer.ProvisioningStatus).To(Equal("active"))
+		})
+	})
+	Describe(`WaitForVPNGatewayStable`, func() {
+		for _, mode := range []string{"route", "policy"} {
+			It(fmt.Sprintf(`Should return the %s mode gateway once stable`, mode), func() {
+				server, _ := newSequenceServer(
+					fmt.Sprintf(`{"id": "gw-1", "mode": "%s", "lifecycle_state": "pending", "health_state": "inapplicable"}`, mode),
+					fmt.Sprintf(`{"id": "gw-1", "mode": "%s", "lifecycle_state": "stable", "health_state": "ok"}`, mode),
+				)
+				defer server.Close()
+
+				vpnGateway, err := newTestVpcService(server.URL).WaitForVPNGatewayStable(context.Background(), "gw-1", fastWaiter)
+				Expect(err).To(BeNil())
+				Expect(vpnGateway).ToNot(BeNil())
+				data, err := json.Marshal(vpnGateway)
+				Expect(err).To(BeNil())
+				var properties map[string]interface{}
+				Expect(json.Unmarshal(data, &properties)).To(Succeed())
+				Expect(properties["id"]).To(Equal("gw-1"))
+				Expect(properties["lifecycle_state"]).To(Equal("stable"))
+			})
+		}
+		It(`Should fail when the gateway fails`, func() {
+			server, _ := newSequenceServer(
+				`{"id": "gw-1", "mode": "route", "lifecycle_state": "failed", "health_state": "faulted"}`,
+			)
+			defer server.Close()
+
+			_, err := newTestVpcService(server.URL).WaitForVPNGatewayStable(context.Background(), "gw-1", fastWaiter)
+			var stateErr *vpcv1.ResourceStateError
+			Expect(errors.As(err, &stateErr)).To(BeTrue())
+			Expect(stateErr.ID).To(Equal("gw-1"))
+			Expect(stateErr.State).To(Equal("failed"))
+		})
+	})
+	Describe(`WaitForDeleted`, func() {
+		It(`Should treat 404 Not Found as success`, func() {
+			server, count := newSequenceServer(
+				`{"id": "s-1", "lifecycle_state": "deleting"}`,
+				``,
+			)
+			defer server.Close()
+
+			err := newTestVpcService(server.URL).WaitForSnapshotDeleted(context.Background(), "s-1", fastWaiter)
+			Expect(err).To(BeNil())
+			Expect(atomic.LoadInt32(count)).To(Equal(int32(2)))
+		})
+		It(`Should work with any getter`, func() {
+			server, _ := newSequenceServer(`{"id": "i-1", "status": "deleting"}`, ``)
+			defer server.Close()
+
+			vpcService := newTestVpcService(server.URL)
+			err := vpcService.WaitForDeleted(context.Background(), func(ctx context.Context) (*core.DetailedResponse, error) {
+				_, response, err := vpcService.GetInstanceWithContext(ctx, vpcService.NewGetInstanceOptions("i-1"))
+				return response, err
+			}, fastWaiter)
+			Expect(err).To(BeNil())
+		})
+		It(`Should stop on errors other than 404`, func() {
+			var count int32
+			server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				atomic.AddInt32(&count, 1)
+				res.WriteHeader(403)
+			}))
+			defer server.Close()
+
+			err := newTestVpcService(server.URL).WaitForVolumeDeleted(context.Background(), "v-1", fastWaiter)
+			Expect(err).ToNot(BeNil())
+			Expect(atomic.LoadInt32(&count)).To(Equal(int32(1)))
+		})
+	})
+})
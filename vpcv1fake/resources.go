@@ -0,0 +1,178 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1fake
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// The collections served by a Server, named after their API paths.
+const (
+	CollectionVPCs           = "vpcs"
+	CollectionSubnets        = "subnets"
+	CollectionInstances      = "instances"
+	CollectionVolumes        = "volumes"
+	CollectionSecurityGroups = "security_groups"
+	CollectionFloatingIPs    = "floating_ips"
+	CollectionLoadBalancers  = "load_balancers"
+)
+
+// transition is a property whose value changes when a resource settles.
+type transition struct {
+	property string
+	pending  string
+	settled  string
+}
+
+// kind describes the resources of one collection.
+type kind struct {
+	collection   string
+	resourceType string
+
+	// Whether the resources belong to a zone rather than to the region.
+	zonal bool
+
+	// The properties that a create request must specify. Zonal resources created without a zone are
+	// placed in DefaultZone.
+	required []string
+
+	// The properties that refer to resources of other collections, by collection.
+	references map[string]string
+
+	// The properties that change while a new resource is provisioned.
+	created []transition
+
+	// The properties set while a resource is deleted. Resources without them are deleted at once.
+	deleting map[string]string
+
+	// The properties that a new resource has unless the create request specifies them.
+	defaults func(sequence int) map[string]interface{}
+}
+
+var kinds = map[string]*kind{
+	CollectionVPCs: {
+		collection:   CollectionVPCs,
+		resourceType: "vpc",
+		created: []transition{
+			{"status", "pending", "available"},
+			{"health_state", "inapplicable", "ok"},
+		},
+		deleting: map[string]string{"status": "deleting"},
+		defaults: func(int) map[string]interface{} {
+			return map[string]interface{}{"classic_access": false}
+		},
+	},
+	CollectionSubnets: {
+		collection:   CollectionSubnets,
+		resourceType: "subnet",
+		zonal:        true,
+		required:     []string{"vpc", "zone"},
+		references:   map[string]string{"vpc": CollectionVPCs},
+		created:      []transition{{"status", "pending", "available"}},
+		deleting:     map[string]string{"status": "deleting"},
+		defaults: func(sequence int) map[string]interface{} {
+			return map[string]interface{}{
+				"ip_version":                   "ipv4",
+				"ipv4_cidr_block":              fmt.Sprintf("10.240.%d.0/24", sequence%256),
+				"total_ipv4_address_count":     256,
+				"available_ipv4_address_count": 251,
+			}
+		},
+	},
+	CollectionInstances: {
+		collection:   CollectionInstances,
+		resourceType: "instance",
+		zonal:        true,
+		required:     []string{"zone"},
+		references:   map[string]string{"vpc": CollectionVPCs},
+		created: []transition{
+			{"status", "pending", "running"},
+			{"lifecycle_state", "pending", "stable"},
+		},
+		deleting: map[string]string{"status": "deleting", "lifecycle_state": "deleting"},
+		defaults: func(int) map[string]interface{} {
+			return map[string]interface{}{
+				"profile":        map[string]interface{}{"name": "bx2-2x8"},
+				"vcpu":           map[string]interface{}{"architecture": "amd64", "count": 2},
+				"memory":         8,
+				"startable":      true,
+				"status_reasons": []interface{}{},
+			}
+		},
+	},
+	CollectionVolumes: {
+		collection:   CollectionVolumes,
+		resourceType: "volume",
+		zonal:        true,
+		required:     []string{"zone"},
+		created:      []transition{{"status", "pending", "available"}},
+		deleting:     map[string]string{"status": "deleting"},
+		defaults: func(int) map[string]interface{} {
+			return map[string]interface{}{
+				"capacity":       100,
+				"iops":           3000,
+				"encryption":     "provider_managed",
+				"health_state":   "ok",
+				"profile":        map[string]interface{}{"name": "general-purpose"},
+				"status_reasons": []interface{}{},
+				"user_tags":      []interface{}{},
+			}
+		},
+	},
+	CollectionSecurityGroups: {
+		collection:   CollectionSecurityGroups,
+		resourceType: "security_group",
+		required:     []string{"vpc"},
+		references:   map[string]string{"vpc": CollectionVPCs},
+		defaults: func(int) map[string]interface{} {
+			return map[string]interface{}{"rules": []interface{}{}, "targets": []interface{}{}}
+		},
+	},
+	CollectionFloatingIPs: {
+		collection:   CollectionFloatingIPs,
+		resourceType: "floating_ip",
+		zonal:        true,
+		created:      []transition{{"status", "pending", "available"}},
+		deleting:     map[string]string{"status": "deleting"},
+		defaults: func(int) map[string]interface{} {
+			return map[string]interface{}{
+				"address": fmt.Sprintf("169.%d.%d.%d", 45+rand.IntN(20), rand.IntN(256), 1+rand.IntN(254)),
+			}
+		},
+	},
+	CollectionLoadBalancers: {
+		collection:   CollectionLoadBalancers,
+		resourceType: "load_balancer",
+		required:     []string{"subnets"},
+		references:   map[string]string{"subnets": CollectionSubnets},
+		created: []transition{
+			{"provisioning_status", "create_pending", "active"},
+			{"operating_status", "offline", "online"},
+		},
+		deleting: map[string]string{"provisioning_status": "delete_pending"},
+		defaults: func(sequence int) map[string]interface{} {
+			return map[string]interface{}{
+				"is_public": true,
+				"hostname":  fmt.Sprintf("%08x-us-south.lb.appdomain.cloud", sequence),
+				"listeners": []interface{}{},
+				"pools":     []interface{}{},
+				"profile":   map[string]interface{}{"family": "application", "name": "dynamic"},
+			}
+		},
+	},
+}
@@ -0,0 +1,761 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcv1fake provides an in-memory fake of the VPC API, for testing code that uses the
+// vpcv1 package without an IBM Cloud account or network access.
+//
+// A Server keeps VPCs, subnets, instances, volumes, security groups, floating IPs and load
+// balancers in memory, and serves the list, create, get, update and delete operations of each, as
+// well as instance actions. Point a VpcV1 instance at it with SetServiceURL:
+//
+//	server := vpcv1fake.NewServer()
+//	defer server.Close()
+//
+//	vpcService.SetServiceURL(server.URL)
+//
+// New resources get generated IDs, CRNs, hrefs and ETags, and go through the same lifecycle states
+// as in the real service: a new instance is "pending" for the first reads and then "running", and
+// a deleted instance is "deleting" for the first reads and then gone (see SetTransitionReads).
+// Updates and deletes honor the If-Match header, and errors are reported in the VPC API format.
+package vpcv1fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/google/uuid"
+)
+
+// The location and account of the resources of a Server.
+const (
+	Region        = "us-south"
+	DefaultZone   = "us-south-1"
+	AccountID     = "aa2432b1fa4d4ace891e9b80fc104e34"
+	BasePath      = "/v1"
+	DefaultLimit  = 50
+	MaximumLimit  = 100
+	resourceGroup = "fee82deba12e4c0fb69c3b09d1f12345"
+)
+
+// Server : An in-memory fake of the VPC API.
+// A Server is safe for concurrent use.
+type Server struct {
+	// The service URL of the fake, for use with VpcV1.SetServiceURL.
+	URL string
+
+	httpServer *httptest.Server
+
+	mutex           sync.Mutex
+	collections     map[string]*collection
+	sequence        int
+	transitionReads int
+	injectedErrors  []injectedError
+}
+
+// collection holds the resources of one kind, in creation order.
+type collection struct {
+	kind  *kind
+	order []string
+	items map[string]*resource
+}
+
+// resource is a stored resource.
+type resource struct {
+	data map[string]interface{}
+
+	// The properties to set, and the number of reads to answer before setting them, when the
+	// resource is in a transitional state.
+	pending      map[string]interface{}
+	pendingReads int
+
+	// Whether the resource is removed once its pending properties are set.
+	removing bool
+}
+
+// injectedError is an error response to send to the next matching request.
+type injectedError struct {
+	method     string
+	collection string
+	statusCode int
+	code       string
+}
+
+// NewServer : Start a Server on a local port
+// The resources go through one read in each transitional state, as with SetTransitionReads(1).
+// Call Close when done.
+func NewServer() *Server {
+	server := &Server{
+		collections:     make(map[string]*collection),
+		transitionReads: 1,
+	}
+	for name, kind := range kinds {
+		server.collections[name] = &collection{kind: kind, items: make(map[string]*resource)}
+	}
+	server.httpServer = httptest.NewServer(server)
+	server.URL = server.httpServer.URL + BasePath
+	return server
+}
+
+// Close : Shut the Server down
+func (server *Server) Close() {
+	server.httpServer.Close()
+}
+
+// NewService : Instantiate a VpcV1 that uses the Server, without authentication
+func (server *Server) NewService() (*vpcv1.VpcV1, error) {
+	return vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+}
+
+// SetTransitionReads : Set the number of reads a resource answers in a transitional state
+// Reads include gets and lists. With 0, resources are created and deleted at once.
+func (server *Server) SetTransitionReads(reads int) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.transitionReads = max(reads, 0)
+}
+
+// InjectError : Answer the next request with "method" on "collection" with an error
+// The error has "statusCode" and the error code "code". Injected errors are used in order, once
+// each; an empty method or collection matches any.
+func (server *Server) InjectError(method string, collection string, statusCode int, code string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.injectedErrors = append(server.injectedErrors, injectedError{method, collection, statusCode, code})
+}
+
+// Add : Store a resource in "collection", bypassing validation and lifecycle transitions
+// The missing ID, CRN, href, name and default properties are generated. Returns the stored resource.
+func (server *Server) Add(collectionName string, properties map[string]interface{}) (map[string]interface{}, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	collection, ok := server.collections[collectionName]
+	if !ok {
+		return nil, fmt.Errorf("vpcv1fake: unknown collection %q", collectionName)
+	}
+	data := clone(properties)
+	server.populate(collection, data, server.URL)
+	for _, transition := range collection.kind.created {
+		if _, ok := data[transition.property]; !ok {
+			data[transition.property] = transition.settled
+		}
+	}
+	collection.add(&resource{data: data})
+	return clone(data), nil
+}
+
+// Get : Return a copy of a stored resource, without counting it as a read
+func (server *Server) Get(collectionName string, id string) (map[string]interface{}, bool) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if collection, ok := server.collections[collectionName]; ok {
+		if resource, ok := collection.items[id]; ok {
+			return clone(resource.data), true
+		}
+	}
+	return nil, false
+}
+
+// Len : Return the number of resources stored in "collection"
+func (server *Server) Len(collectionName string) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if collection, ok := server.collections[collectionName]; ok {
+		return len(collection.order)
+	}
+	return 0
+}
+
+// add stores "resource" at the end of the collection.
+func (collection *collection) add(resource *resource) {
+	id := resource.data["id"].(string)
+	collection.items[id] = resource
+	collection.order = append(collection.order, id)
+}
+
+// remove deletes the resource with "id" from the collection.
+func (collection *collection) remove(id string) {
+	delete(collection.items, id)
+	collection.order = slices.DeleteFunc(collection.order, func(item string) bool { return item == id })
+}
+
+// populate sets the generated properties of the new resource "data" of "collection".
+func (server *Server) populate(collection *collection, data map[string]interface{}, baseURL string) {
+	kind := collection.kind
+	server.sequence++
+
+	zone := ""
+	if kind.zonal {
+		zone = DefaultZone
+		if ref, ok := data["zone"].(map[string]interface{}); ok {
+			if name, ok := ref["name"].(string); ok && name != "" {
+				zone = name
+			}
+		}
+		data["zone"] = map[string]interface{}{
+			"href": fmt.Sprintf("%s/regions/%s/zones/%s", baseURL, Region, zone),
+			"name": zone,
+		}
+	}
+
+	for property, value := range kind.defaults(server.sequence) {
+		if _, ok := data[property]; !ok {
+			data[property] = value
+		}
+	}
+	if _, ok := data["id"].(string); !ok {
+		prefix := "r006"
+		if kind.zonal {
+			prefix = "0717"
+		}
+		data["id"] = prefix + "-" + uuid.New().String()
+	}
+	id := data["id"].(string)
+	if _, ok := data["name"].(string); !ok {
+		data["name"] = fmt.Sprintf("%s-%d", strings.ReplaceAll(kind.resourceType, "_", "-"), server.sequence)
+	}
+
+	location := Region
+	if zone != "" {
+		location = zone
+	}
+	data["crn"] = fmt.Sprintf("crn:v1:bluemix:public:is:%s:a/%s::%s:%s", location, AccountID, kind.resourceType, id)
+	data["href"] = fmt.Sprintf("%s/%s/%s", baseURL, kind.collection, id)
+	data["resource_type"] = kind.resourceType
+	data["created_at"] = time.Now().UTC().Format(time.RFC3339)
+	if _, ok := data["resource_group"]; !ok {
+		data["resource_group"] = map[string]interface{}{
+			"href": "https://resource-controller.cloud.ibm.com/v2/resource_groups/" + resourceGroup,
+			"id":   resourceGroup,
+			"name": "Default",
+		}
+	}
+	data["etag"] = newETag()
+}
+
+// newETag returns a new weak entity tag.
+func newETag() string {
+	return `W/"` + uuid.New().String() + `"`
+}
+
+// ServeHTTP implements http.Handler.
+func (server *Server) ServeHTTP(res http.ResponseWriter, req *http.Request) {
+	trace := req.Header.Get("X-Request-Id")
+	if trace == "" {
+		trace = uuid.New().String()
+	}
+	res.Header().Set("X-Request-Id", trace)
+	call := &call{server: server, res: res, req: req, trace: trace}
+
+	path, ok := strings.CutPrefix(req.URL.Path, BasePath+"/")
+	if !ok {
+		call.fail(http.StatusNotFound, "not_found", "The requested path does not exist.", "")
+		return
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	collection, ok := server.collections[segments[0]]
+	if !ok {
+		call.fail(http.StatusNotFound, "not_found", fmt.Sprintf("The collection '%s' is not supported by the fake.", segments[0]), "")
+		return
+	}
+	if server.injectError(call, req.Method, segments[0]) {
+		return
+	}
+	call.baseURL = "http://" + req.Host + BasePath
+
+	switch {
+	case len(segments) == 1 && req.Method == http.MethodGet:
+		call.list(collection)
+	case len(segments) == 1 && req.Method == http.MethodPost:
+		call.create(collection)
+	case len(segments) == 2 && req.Method == http.MethodGet:
+		call.get(collection, segments[1])
+	case len(segments) == 2 && req.Method == http.MethodPatch:
+		call.update(collection, segments[1])
+	case len(segments) == 2 && req.Method == http.MethodDelete:
+		call.delete(collection, segments[1])
+	case len(segments) == 3 && segments[0] == CollectionInstances && segments[2] == "actions" && req.Method == http.MethodPost:
+		call.instanceAction(collection, segments[1])
+	default:
+		call.fail(http.StatusMethodNotAllowed, "method_not_allowed", fmt.Sprintf("%s %s is not supported by the fake.", req.Method, req.URL.Path), "")
+	}
+}
+
+// injectError answers the request with the first matching injected error, if any.
+func (server *Server) injectError(call *call, method string, collection string) bool {
+	for i, injected := range server.injectedErrors {
+		if (injected.method == "" || injected.method == method) && (injected.collection == "" || injected.collection == collection) {
+			server.injectedErrors = slices.Delete(server.injectedErrors, i, i+1)
+			call.fail(injected.statusCode, injected.code, "Injected error.", "")
+			return true
+		}
+	}
+	return false
+}
+
+// call is a request being served. The server mutex is held while it is served.
+type call struct {
+	server  *Server
+	res     http.ResponseWriter
+	req     *http.Request
+	trace   string
+	baseURL string
+}
+
+// read returns the resource with "id" after counting the read, or nil if there is none.
+func (call *call) read(collection *collection, id string) *resource {
+	resource, ok := collection.items[id]
+	if !ok {
+		return nil
+	}
+	if resource.pending == nil {
+		return resource
+	}
+	if resource.pendingReads > 0 {
+		resource.pendingReads--
+		return resource
+	}
+	call.settle(collection, resource)
+	if resource.removing {
+		return nil
+	}
+	return resource
+}
+
+// settle completes the transition of "resource".
+func (call *call) settle(collection *collection, resource *resource) {
+	if resource.removing {
+		collection.remove(resource.data["id"].(string))
+		return
+	}
+	for property, value := range resource.pending {
+		resource.data[property] = value
+	}
+	resource.pending = nil
+	resource.data["etag"] = newETag()
+}
+
+// transition puts "resource" in a transitional state, with "current" set now and "next" set after
+// the configured number of reads.
+func (call *call) transition(collection *collection, resource *resource, current map[string]interface{}, next map[string]interface{}, removing bool) {
+	for property, value := range current {
+		resource.data[property] = value
+	}
+	resource.data["etag"] = newETag()
+	resource.pending = next
+	resource.pendingReads = call.server.transitionReads
+	resource.removing = removing
+	if call.server.transitionReads == 0 {
+		call.settle(collection, resource)
+	}
+}
+
+func (call *call) list(collection *collection) {
+	query := call.req.URL.Query()
+	limit := DefaultLimit
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 || limit > MaximumLimit {
+			call.fail(http.StatusBadRequest, "validation_invalid_argument", fmt.Sprintf("The limit must be between 1 and %d.", MaximumLimit), "limit")
+			return
+		}
+	}
+
+	var matches []*resource
+	for _, id := range slices.Clone(collection.order) {
+		if resource := call.read(collection, id); resource != nil && matchesFilters(resource.data, query) {
+			matches = append(matches, resource)
+		}
+	}
+
+	start := 0
+	if token := query.Get("start"); token != "" {
+		start = slices.IndexFunc(matches, func(resource *resource) bool { return resource.data["id"] == token })
+		if start < 0 {
+			call.fail(http.StatusBadRequest, "validation_invalid_argument", "The start token is not valid.", "start")
+			return
+		}
+	}
+	end := min(start+limit, len(matches))
+
+	page := make([]interface{}, 0, end-start)
+	for _, resource := range matches[start:end] {
+		page = append(page, call.output(resource))
+	}
+	pageURL := func(start string) string {
+		pageQuery := url.Values{"limit": {strconv.Itoa(limit)}}
+		if start != "" {
+			pageQuery.Set("start", start)
+		}
+		return fmt.Sprintf("%s/%s?%s", call.baseURL, collection.kind.collection, pageQuery.Encode())
+	}
+	body := map[string]interface{}{
+		collection.kind.collection: page,
+		"first":                    map[string]interface{}{"href": pageURL("")},
+		"limit":                    limit,
+		"total_count":              len(matches),
+	}
+	if end < len(matches) {
+		body["next"] = map[string]interface{}{"href": pageURL(matches[end].data["id"].(string))}
+	}
+	call.respond(http.StatusOK, body, "")
+}
+
+// reservedQueryParameters are the query parameters that are not list filters.
+var reservedQueryParameters = []string{"version", "generation", "start", "limit", "sort"}
+
+// matchesFilters returns true if "data" has the values of the filter query parameters, such as
+// "name" or "vpc.id".
+func matchesFilters(data map[string]interface{}, query url.Values) bool {
+	for parameter, values := range query {
+		if slices.Contains(reservedQueryParameters, parameter) {
+			continue
+		}
+		var value interface{} = data
+		for _, name := range strings.Split(parameter, ".") {
+			object, _ := value.(map[string]interface{})
+			value = object[name]
+		}
+		if fmt.Sprint(value) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+func (call *call) create(collection *collection) {
+	kind := collection.kind
+	var data map[string]interface{}
+	if err := json.NewDecoder(call.req.Body).Decode(&data); err != nil || data == nil {
+		call.fail(http.StatusBadRequest, "bad_request", "The request body must be a JSON object.", "")
+		return
+	}
+	for _, property := range kind.required {
+		if _, ok := data[property]; !ok {
+			call.fail(http.StatusBadRequest, "validation_required_field", fmt.Sprintf("The '%s' property is required.", property), property)
+			return
+		}
+	}
+	for _, property := range []string{"id", "crn", "href", "created_at"} {
+		delete(data, property)
+	}
+	for property, referenced := range kind.references {
+		if !call.resolveReference(data, property, call.server.collections[referenced]) {
+			return
+		}
+	}
+	if name, ok := data["name"].(string); ok && slices.ContainsFunc(collection.order, func(id string) bool {
+		return collection.items[id].data["name"] == name
+	}) {
+		call.fail(http.StatusConflict, kind.resourceType+"_name_duplicate", fmt.Sprintf("The name '%s' is already in use.", name), "name")
+		return
+	}
+
+	call.server.populate(collection, data, call.baseURL)
+	resource := &resource{data: data}
+	collection.add(resource)
+
+	current := make(map[string]interface{})
+	next := make(map[string]interface{})
+	for _, transition := range kind.created {
+		current[transition.property] = transition.pending
+		next[transition.property] = transition.settled
+	}
+	call.transition(collection, resource, current, next, false)
+	call.respond(http.StatusCreated, call.output(resource), resource.data["etag"].(string))
+}
+
+// resolveReference replaces the identity in the "property" of "data" (or each identity, if the
+// property is an array) with a reference to the identified resource of "referenced".
+func (call *call) resolveReference(data map[string]interface{}, property string, referenced *collection) bool {
+	resolve := func(identity interface{}) (interface{}, bool) {
+		fields, _ := identity.(map[string]interface{})
+		for _, id := range referenced.order {
+			target := referenced.items[id].data
+			for _, key := range []string{"id", "crn", "href"} {
+				if value, ok := fields[key]; ok && value == target[key] {
+					return reference(target), true
+				}
+			}
+		}
+		call.fail(http.StatusNotFound, referenced.kind.resourceType+"_not_found", fmt.Sprintf("The %s identity does not match any %s.", property, referenced.kind.resourceType), property)
+		return nil, false
+	}
+
+	switch value := data[property].(type) {
+	case nil:
+		return true
+	case []interface{}:
+		for i, identity := range value {
+			ref, ok := resolve(identity)
+			if !ok {
+				return false
+			}
+			value[i] = ref
+		}
+		return true
+	default:
+		ref, ok := resolve(value)
+		data[property] = ref
+		return ok
+	}
+}
+
+// reference returns the reference to the resource with "data".
+func reference(data map[string]interface{}) map[string]interface{} {
+	ref := make(map[string]interface{})
+	for _, key := range []string{"crn", "href", "id", "name", "resource_type"} {
+		ref[key] = data[key]
+	}
+	return ref
+}
+
+func (call *call) get(collection *collection, id string) {
+	resource := call.read(collection, id)
+	if resource == nil {
+		call.notFound(collection, id)
+		return
+	}
+	call.respond(http.StatusOK, call.output(resource), resource.data["etag"].(string))
+}
+
+func (call *call) update(collection *collection, id string) {
+	resource := call.read(collection, id)
+	if resource == nil {
+		call.notFound(collection, id)
+		return
+	}
+	if !call.checkIfMatch(resource) {
+		return
+	}
+	var patch map[string]interface{}
+	if err := json.NewDecoder(call.req.Body).Decode(&patch); err != nil || patch == nil {
+		call.fail(http.StatusBadRequest, "bad_request", "The request body must be a JSON merge patch.", "")
+		return
+	}
+	for property := range patch {
+		if slices.Contains([]string{"id", "crn", "href", "created_at", "resource_type", "etag"}, property) {
+			call.fail(http.StatusBadRequest, "validation_invalid_argument", fmt.Sprintf("The '%s' property cannot be updated.", property), property)
+			return
+		}
+	}
+	mergePatch(resource.data, patch)
+	resource.data["etag"] = newETag()
+	call.respond(http.StatusOK, call.output(resource), resource.data["etag"].(string))
+}
+
+// mergePatch applies the JSON merge patch (RFC 7396) "patch" to "data".
+func mergePatch(data map[string]interface{}, patch map[string]interface{}) {
+	for property, value := range patch {
+		switch value := value.(type) {
+		case nil:
+			delete(data, property)
+		case map[string]interface{}:
+			target, ok := data[property].(map[string]interface{})
+			if !ok {
+				target = make(map[string]interface{})
+				data[property] = target
+			}
+			mergePatch(target, value)
+		default:
+			data[property] = value
+		}
+	}
+}
+
+func (call *call) delete(collection *collection, id string) {
+	kind := collection.kind
+	resource := call.read(collection, id)
+	if resource == nil {
+		call.notFound(collection, id)
+		return
+	}
+	if !call.checkIfMatch(resource) {
+		return
+	}
+	if resource.removing {
+		call.respond(http.StatusAccepted, nil, "")
+		return
+	}
+	for _, other := range call.server.collections {
+		for _, otherID := range other.order {
+			if other.kind.references != nil && referencesResource(other.items[otherID].data, other.kind, id) {
+				call.fail(http.StatusConflict, kind.resourceType+"_in_use", fmt.Sprintf("The %s is in use by %s %s.", kind.resourceType, other.kind.resourceType, otherID), "id")
+				return
+			}
+		}
+	}
+
+	if kind.deleting == nil {
+		collection.remove(id)
+		call.respond(http.StatusNoContent, nil, "")
+		return
+	}
+	current := make(map[string]interface{})
+	for property, value := range kind.deleting {
+		current[property] = value
+	}
+	call.transition(collection, resource, current, map[string]interface{}{}, true)
+	call.respond(http.StatusAccepted, nil, "")
+}
+
+// referencesResource returns true if one of the reference properties of "data" refers to "id".
+func referencesResource(data map[string]interface{}, kind *kind, id string) bool {
+	for property := range kind.references {
+		refs, ok := data[property].([]interface{})
+		if !ok {
+			refs = []interface{}{data[property]}
+		}
+		for _, ref := range refs {
+			if fields, ok := ref.(map[string]interface{}); ok && fields["id"] == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// instanceActionStates are the instance statuses during and after each instance action.
+var instanceActionStates = map[string][2]string{
+	"start":  {"starting", "running"},
+	"stop":   {"stopping", "stopped"},
+	"reboot": {"restarting", "running"},
+}
+
+func (call *call) instanceAction(collection *collection, id string) {
+	resource := call.read(collection, id)
+	if resource == nil {
+		call.notFound(collection, id)
+		return
+	}
+	var action map[string]interface{}
+	if err := json.NewDecoder(call.req.Body).Decode(&action); err != nil || action == nil {
+		call.fail(http.StatusBadRequest, "bad_request", "The request body must be a JSON object.", "")
+		return
+	}
+	actionType, _ := action["type"].(string)
+	states, ok := instanceActionStates[actionType]
+	if !ok {
+		call.fail(http.StatusBadRequest, "validation_invalid_argument", fmt.Sprintf("The action type '%s' is not valid.", actionType), "type")
+		return
+	}
+	if resource.pending != nil {
+		call.fail(http.StatusConflict, "instance_in_transition", "The instance is in a transitional state.", "id")
+		return
+	}
+
+	call.transition(collection, resource, map[string]interface{}{"status": states[0]}, map[string]interface{}{"status": states[1]}, false)
+	actionID := uuid.New().String()
+	call.respond(http.StatusCreated, map[string]interface{}{
+		"created_at": time.Now().UTC().Format(time.RFC3339),
+		"force":      action["force"] == true,
+		"href":       fmt.Sprintf("%s/instances/%s/actions/%s", call.baseURL, id, actionID),
+		"id":         actionID,
+		"status":     "pending",
+		"type":       actionType,
+	}, "")
+}
+
+// checkIfMatch answers the request with 412 Precondition Failed if its If-Match header does not
+// match the entity tag of "resource".
+func (call *call) checkIfMatch(resource *resource) bool {
+	ifMatch := call.req.Header.Get("If-Match")
+	if ifMatch == "" || ifMatch == "*" || ifMatch == resource.data["etag"] {
+		return true
+	}
+	call.fail(http.StatusPreconditionFailed, "precondition_failed", "The If-Match header does not match the current entity tag.", "If-Match")
+	return false
+}
+
+// output returns the representation of "resource" to send.
+func (call *call) output(resource *resource) map[string]interface{} {
+	data := clone(resource.data)
+	delete(data, "etag")
+	return data
+}
+
+func (call *call) notFound(collection *collection, id string) {
+	resourceType := collection.kind.resourceType
+	call.fail(http.StatusNotFound, "not_found", fmt.Sprintf("The %s with ID '%s' cannot be found.", resourceType, id), "id")
+}
+
+// fail sends an error response in the format of the VPC API.
+func (call *call) fail(statusCode int, code string, message string, target string) {
+	item := map[string]interface{}{
+		"code":      code,
+		"message":   message,
+		"more_info": "https://cloud.ibm.com/docs/vpc?topic=vpc-rias-error-messages#" + code,
+	}
+	if target != "" {
+		targetType := "field"
+		switch target {
+		case "id", "limit", "start":
+			targetType = "parameter"
+		case "If-Match":
+			targetType = "header"
+		}
+		item["target"] = map[string]interface{}{"name": target, "type": targetType}
+	}
+	call.respond(statusCode, map[string]interface{}{
+		"errors":      []interface{}{item},
+		"trace":       call.trace,
+		"status_code": statusCode,
+	}, "")
+}
+
+// respond sends "body" as JSON, with the ETag header set to "etag" if not empty.
+func (call *call) respond(statusCode int, body interface{}, etag string) {
+	if etag != "" {
+		call.res.Header().Set("ETag", etag)
+	}
+	if body == nil {
+		call.res.WriteHeader(statusCode)
+		return
+	}
+	call.res.Header().Set("Content-Type", "application/json")
+	call.res.WriteHeader(statusCode)
+	_ = json.NewEncoder(call.res).Encode(body)
+}
+
+// clone returns a deep copy of "data".
+func clone(data map[string]interface{}) map[string]interface{} {
+	var copied map[string]interface{}
+	bytes, _ := json.Marshal(data)
+	_ = json.Unmarshal(bytes, &copied)
+	if copied == nil {
+		copied = make(map[string]interface{})
+	}
+	return copied
+}
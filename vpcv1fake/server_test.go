@@ -0,0 +1,219 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1fake_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastWaiter = vpcv1.NewWaiterOptions().SetMinDelay(time.Millisecond).SetMaxDelay(time.Millisecond)
+
+// send sends a JSON request to the fake and decodes the JSON response, if any.
+func send(t *testing.T, server *vpcv1fake.Server, method string, path string, body interface{}) (int, map[string]interface{}) {
+	var reader bytes.Buffer
+	if body != nil {
+		require.Nil(t, json.NewEncoder(&reader).Encode(body))
+	}
+	req, err := http.NewRequest(method, server.URL+path, &reader)
+	require.Nil(t, err)
+	res, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer res.Body.Close()
+
+	var result map[string]interface{}
+	_ = json.NewDecoder(res.Body).Decode(&result)
+	return res.StatusCode, result
+}
+
+func TestVolumeLifecycle(t *testing.T) {
+	server := vpcv1fake.NewServer()
+	defer server.Close()
+	vpcService, err := server.NewService()
+	require.Nil(t, err)
+
+	volume, response, err := vpcService.CreateVolume(vpcService.NewCreateVolumeOptions(&vpcv1.VolumePrototypeVolumeByCapacity{
+		Capacity: core.Int64Ptr(250),
+		Name:     core.StringPtr("my-volume"),
+		Profile:  &vpcv1.VolumeProfileIdentityByName{Name: core.StringPtr("general-purpose")},
+		Zone:     &vpcv1.ZoneIdentityByName{Name: core.StringPtr("us-south-2")},
+	}))
+	require.Nil(t, err)
+	assert.Equal(t, 201, response.StatusCode)
+	assert.Equal(t, "pending", *volume.Status)
+	assert.Equal(t, int64(250), *volume.Capacity)
+	assert.True(t, strings.HasPrefix(*volume.ID, "0717-"))
+	assert.Equal(t, server.URL+"/volumes/"+*volume.ID, *volume.Href)
+	assert.Contains(t, *volume.CRN, ":is:us-south-2:")
+
+	volume, err = vpcService.WaitForVolumeAvailable(context.Background(), *volume.ID, fastWaiter)
+	require.Nil(t, err)
+	assert.Equal(t, "available", *volume.Status)
+
+	_, response, err = vpcService.GetVolume(vpcService.NewGetVolumeOptions(*volume.ID))
+	require.Nil(t, err)
+	etag := response.GetHeaders().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	// A stale entity tag is rejected.
+	updateOptions := vpcService.NewUpdateVolumeOptions(*volume.ID, map[string]interface{}{"name": "new-name"})
+	_, _, err = vpcService.UpdateVolume(updateOptions.SetIfMatch(`W/"stale"`))
+	apiErr, ok := vpcv1.ParseAPIError(err)
+	require.True(t, ok)
+	assert.True(t, errors.Is(apiErr, vpcv1.ErrPreconditionFailed))
+
+	volume, response, err = vpcService.UpdateVolume(updateOptions.SetIfMatch(etag))
+	require.Nil(t, err)
+	assert.Equal(t, "new-name", *volume.Name)
+	assert.NotEqual(t, etag, response.GetHeaders().Get("ETag"))
+
+	response, err = vpcService.DeleteVolume(vpcService.NewDeleteVolumeOptions(*volume.ID))
+	require.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+	require.Nil(t, vpcService.WaitForVolumeDeleted(context.Background(), *volume.ID, fastWaiter))
+	assert.Equal(t, 0, server.Len(vpcv1fake.CollectionVolumes))
+
+	_, _, err = vpcService.GetVolume(vpcService.NewGetVolumeOptions(*volume.ID))
+	apiErr, ok = vpcv1.ParseAPIError(err)
+	require.True(t, ok)
+	assert.True(t, errors.Is(apiErr, vpcv1.ErrNotFound))
+}
+
+func TestPagination(t *testing.T) {
+	server := vpcv1fake.NewServer()
+	defer server.Close()
+	vpcService, err := server.NewService()
+	require.Nil(t, err)
+
+	vpc, err := server.Add(vpcv1fake.CollectionVPCs, map[string]interface{}{"name": "my-vpc"})
+	require.Nil(t, err)
+	for i := 0; i < 5; i++ {
+		_, err = server.Add(vpcv1fake.CollectionInstances, map[string]interface{}{"vpc": map[string]interface{}{"id": vpc["id"]}})
+		require.Nil(t, err)
+	}
+	_, err = server.Add(vpcv1fake.CollectionInstances, nil)
+	require.Nil(t, err)
+
+	pager, err := vpcService.NewInstancesPager(vpcService.NewListInstancesOptions().SetLimit(2))
+	require.Nil(t, err)
+	instances, err := pager.GetAll()
+	require.Nil(t, err)
+	assert.Len(t, instances, 6)
+	assert.Equal(t, "running", *instances[0].Status)
+
+	// List filters match nested properties.
+	status, result := send(t, server, http.MethodGet, "/instances?vpc.id="+vpc["id"].(string), nil)
+	assert.Equal(t, 200, status)
+	assert.Equal(t, float64(5), result["total_count"])
+}
+
+func TestReferencesAndValidation(t *testing.T) {
+	server := vpcv1fake.NewServer()
+	defer server.Close()
+	server.SetTransitionReads(0)
+
+	status, result := send(t, server, http.MethodPost, "/subnets", map[string]interface{}{"zone": map[string]interface{}{"name": "us-south-1"}})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "validation_required_field", result["errors"].([]interface{})[0].(map[string]interface{})["code"])
+
+	status, vpc := send(t, server, http.MethodPost, "/vpcs", map[string]interface{}{"name": "my-vpc"})
+	assert.Equal(t, 201, status)
+	assert.Equal(t, "available", vpc["status"])
+
+	status, _ = send(t, server, http.MethodPost, "/vpcs", map[string]interface{}{"name": "my-vpc"})
+	assert.Equal(t, 409, status)
+
+	status, _ = send(t, server, http.MethodPost, "/subnets", map[string]interface{}{
+		"vpc":  map[string]interface{}{"id": "r006-unknown"},
+		"zone": map[string]interface{}{"name": "us-south-1"},
+	})
+	assert.Equal(t, 404, status)
+
+	status, subnet := send(t, server, http.MethodPost, "/subnets", map[string]interface{}{
+		"vpc":  map[string]interface{}{"id": vpc["id"]},
+		"zone": map[string]interface{}{"name": "us-south-1"},
+	})
+	assert.Equal(t, 201, status)
+	assert.Equal(t, vpc["crn"], subnet["vpc"].(map[string]interface{})["crn"])
+
+	// A VPC that still has subnets cannot be deleted.
+	status, result = send(t, server, http.MethodDelete, "/vpcs/"+vpc["id"].(string), nil)
+	assert.Equal(t, 409, status)
+	assert.Equal(t, "vpc_in_use", result["errors"].([]interface{})[0].(map[string]interface{})["code"])
+
+	status, _ = send(t, server, http.MethodDelete, "/subnets/"+subnet["id"].(string), nil)
+	assert.Equal(t, 202, status)
+	status, _ = send(t, server, http.MethodDelete, "/vpcs/"+vpc["id"].(string), nil)
+	assert.Equal(t, 202, status)
+	assert.Equal(t, 0, server.Len(vpcv1fake.CollectionVPCs))
+}
+
+func TestInstanceActions(t *testing.T) {
+	server := vpcv1fake.NewServer()
+	defer server.Close()
+	vpcService, err := server.NewService()
+	require.Nil(t, err)
+
+	instance, err := server.Add(vpcv1fake.CollectionInstances, nil)
+	require.Nil(t, err)
+	id := instance["id"].(string)
+
+	status, action := send(t, server, http.MethodPost, "/instances/"+id+"/actions", map[string]interface{}{"type": "stop"})
+	assert.Equal(t, 201, status)
+	assert.Equal(t, "stop", action["type"])
+	stored, _ := server.Get(vpcv1fake.CollectionInstances, id)
+	assert.Equal(t, "stopping", stored["status"])
+
+	result, err := vpcService.WaitForInstanceStatus(context.Background(), id, "stopped", fastWaiter)
+	require.Nil(t, err)
+	assert.Equal(t, "stopped", *result.Status)
+
+	status, _ = send(t, server, http.MethodPost, "/instances/"+id+"/actions", map[string]interface{}{"type": "explode"})
+	assert.Equal(t, 400, status)
+}
+
+func TestInjectError(t *testing.T) {
+	server := vpcv1fake.NewServer()
+	defer server.Close()
+	vpcService, err := server.NewService()
+	require.Nil(t, err)
+
+	instance, err := server.Add(vpcv1fake.CollectionInstances, nil)
+	require.Nil(t, err)
+
+	server.InjectError(http.MethodGet, vpcv1fake.CollectionInstances, 429, "rate_limit_exceeded")
+	_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions(instance["id"].(string)))
+	apiErr, ok := vpcv1.ParseAPIError(err)
+	require.True(t, ok)
+	assert.True(t, errors.Is(apiErr, vpcv1.ErrTooManyRequests))
+	assert.NotEmpty(t, apiErr.Trace)
+
+	_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions(instance["id"].(string)))
+	assert.Nil(t, err)
+}
@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcv1otel traces the requests sent by VpcV1 clients as OpenTelemetry spans.
+//
+// A Tracer is installed on any number of clients:
+//
+//	tracer := vpcv1otel.NewTracer(otel.GetTracerProvider())
+//	tracer.Install(vpcService)
+//
+// Each operation, for example GetInstance, gets a client span named after it that covers all of
+// its attempts, with one child span per attempt made by the retry policy set with SetRetryPolicy.
+// The spans record the operation ID, the region, the ID of the resource operated on, the HTTP
+// status code and the transaction ID of the request (its X-Request-Id). The span of the caller's
+// context is the parent of the operation span, and the W3C trace context of each attempt is sent in
+// the traceparent header.
+package vpcv1otel
+
+import (
+	"net/http"
+	"strings"
+
+	common "github.com/IBM/vpc-go-sdk/common"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer that creates the spans of VpcV1 requests.
+const TracerName = "github.com/IBM/vpc-go-sdk/vpcv1otel"
+
+// The attributes recorded on the spans of VpcV1 requests, in addition to the OpenTelemetry HTTP
+// client attributes.
+const (
+	AttributeOperationID   = attribute.Key("vpc.operation_id")
+	AttributeRegion        = attribute.Key("cloud.region")
+	AttributeResourceID    = attribute.Key("vpc.resource_id")
+	AttributeTransactionID = attribute.Key("vpc.transaction_id")
+)
+
+// traceContext propagates the W3C trace context of each request.
+var traceContext = propagation.TraceContext{}
+
+// Tracer : A tracer of the requests sent by VpcV1 clients
+// It implements vpcv1.RequestTracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+var _ vpcv1.RequestTracer = (*Tracer)(nil)
+
+// NewTracer : Return a tracer creating its spans with "provider"
+func NewTracer(provider trace.TracerProvider) *Tracer {
+	return &Tracer{
+		tracer: provider.Tracer(TracerName, trace.WithInstrumentationVersion(common.Version)),
+	}
+}
+
+// Install : Trace the requests sent by "vpc" with the tracer
+func (tracer *Tracer) Install(vpc *vpcv1.VpcV1) {
+	vpc.SetRequestTracer(tracer)
+}
+
+// TraceOperation implements vpcv1.RequestTracer.
+func (tracer *Tracer) TraceOperation(req *http.Request) (*http.Request, func(resp *http.Response, err error)) {
+	operation := vpcv1.OperationInfoFromRequest(req)
+	if operation == nil {
+		operation = &vpcv1.OperationInfo{Method: req.Method}
+	}
+	name := operation.ID
+	if name == "" {
+		name = req.Method
+	}
+	attributes := []attribute.KeyValue{
+		AttributeOperationID.String(operation.ID),
+		attribute.String("http.request.method", req.Method),
+		attribute.String("url.full", req.URL.String()),
+		attribute.String("server.address", req.URL.Hostname()),
+		AttributeTransactionID.String(req.Header.Get(common.X_REQUEST_ID)),
+	}
+	if region := regionFromHost(req.URL.Hostname()); region != "" {
+		attributes = append(attributes, AttributeRegion.String(region))
+	}
+	if operation.ResourceID != "" {
+		attributes = append(attributes, AttributeResourceID.String(operation.ResourceID))
+	}
+
+	ctx, span := tracer.tracer.Start(req.Context(), name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	return req.WithContext(ctx), func(resp *http.Response, err error) {
+		endSpan(span, resp, err)
+	}
+}
+
+// TraceAttempt implements vpcv1.RequestTracer.
+func (tracer *Tracer) TraceAttempt(req *http.Request, attempt int) (*http.Request, func(resp *http.Response, err error)) {
+	ctx, span := tracer.tracer.Start(req.Context(), "HTTP "+req.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("http.request.method", req.Method),
+		attribute.Int("http.request.resend_count", attempt),
+	))
+
+	req = req.Clone(ctx)
+	traceContext.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return req, func(resp *http.Response, err error) {
+		endSpan(span, resp, err)
+	}
+}
+
+// endSpan records the outcome of a request on "span" and ends it.
+func endSpan(span trace.Span, resp *http.Response, err error) {
+	switch {
+	case err != nil:
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	case resp != nil:
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if transactionID := resp.Header.Get(common.X_REQUEST_ID); transactionID != "" {
+			span.SetAttributes(AttributeTransactionID.String(transactionID))
+		}
+		if resp.StatusCode >= 400 {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+	span.End()
+}
+
+// regionFromHost returns the region of a regional VPC endpoint such as
+// "us-south.iaas.cloud.ibm.com" or "eu-de.private.iaas.cloud.ibm.com", or "".
+func regionFromHost(host string) string {
+	region, rest, ok := strings.Cut(host, ".")
+	if !ok || !strings.HasSuffix(rest, "iaas.cloud.ibm.com") {
+		return ""
+	}
+	return region
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1otel_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1otel"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newService(t *testing.T, url string) *vpcv1.VpcV1 {
+	vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return vpcService
+}
+
+// newProvider returns a tracer provider recording its spans.
+func newProvider() (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	return sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)), recorder
+}
+
+// spanAttributes returns the attributes of "span" by key.
+func spanAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attributes[kv.Key] = kv.Value
+	}
+	return attributes
+}
+
+func TestTracerOperation(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("traceparent")
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("X-Request-Id", req.Header.Get("X-Request-Id"))
+		fmt.Fprint(res, `{"id": "i-1"}`)
+	}))
+	defer server.Close()
+
+	provider, recorder := newProvider()
+	tracer := vpcv1otel.NewTracer(provider)
+	vpcService := newService(t, server.URL)
+	tracer.Install(vpcService)
+	assert.Equal(t, tracer, vpcService.GetRequestTracer())
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, response, err := vpcService.GetInstanceWithContext(ctx, vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	attempt, operation := spans[0], spans[1]
+	assert.Equal(t, "GetInstance", operation.Name())
+	assert.Equal(t, trace.SpanKindClient, operation.SpanKind())
+	assert.Equal(t, parent.SpanContext().SpanID(), operation.Parent().SpanID())
+	assert.Equal(t, operation.SpanContext().SpanID(), attempt.Parent().SpanID())
+	assert.Contains(t, traceparent, parent.SpanContext().TraceID().String())
+	assert.Contains(t, traceparent, attempt.SpanContext().SpanID().String())
+
+	attributes := spanAttributes(operation)
+	assert.Equal(t, "GetInstance", attributes[vpcv1otel.AttributeOperationID].AsString())
+	assert.Equal(t, "i-1", attributes[vpcv1otel.AttributeResourceID].AsString())
+	assert.Equal(t, int64(200), attributes["http.response.status_code"].AsInt64())
+	assert.Equal(t, response.GetHeaders().Get("X-Request-Id"), attributes[vpcv1otel.AttributeTransactionID].AsString())
+	assert.Equal(t, codes.Unset, operation.Status().Code)
+}
+
+func TestTracerRetries(t *testing.T) {
+	// The first two attempts fail.
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&count, 1) <= 2 {
+			res.WriteHeader(503)
+			fmt.Fprint(res, `{"errors": [{"code": "service_unavailable"}]}`)
+			return
+		}
+		fmt.Fprint(res, `{"id": "i-1"}`)
+	}))
+	defer server.Close()
+
+	provider, recorder := newProvider()
+	vpcService := newService(t, server.URL)
+	vpcService.SetRetryPolicy(vpcv1.NewRetryPolicy().SetMinRetryInterval(time.Millisecond).SetMaxRetryInterval(time.Millisecond))
+	vpcv1otel.NewTracer(provider).Install(vpcService)
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 4)
+	operation := spans[3]
+	assert.Equal(t, "GetInstance", operation.Name())
+	for i, attempt := range spans[:3] {
+		assert.Equal(t, "HTTP GET", attempt.Name())
+		assert.Equal(t, operation.SpanContext().SpanID(), attempt.Parent().SpanID())
+		assert.Equal(t, int64(i), spanAttributes(attempt)["http.request.resend_count"].AsInt64())
+	}
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, codes.Unset, operation.Status().Code)
+}
+
+func TestTracerFailedOperation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(404)
+		fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Instance not found"}]}`)
+	}))
+	defer server.Close()
+
+	provider, recorder := newProvider()
+	vpcService := newService(t, server.URL+"/v1")
+	vpcv1otel.NewTracer(provider).Install(vpcService)
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-2"))
+	require.NotNil(t, err)
+
+	operation := recorder.Ended()[1]
+	assert.Equal(t, codes.Error, operation.Status().Code)
+	attributes := spanAttributes(operation)
+	assert.Equal(t, int64(404), attributes["http.response.status_code"].AsInt64())
+	assert.Equal(t, "i-2", attributes[vpcv1otel.AttributeResourceID].AsString())
+	assert.Contains(t, attributes["url.full"].AsString(), server.URL+"/v1/instances/i-2?")
+}
+
+func TestTracerRegion(t *testing.T) {
+	provider, recorder := newProvider()
+	vpcService := newService(t, "https://eu-de.private.iaas.cloud.ibm.com/v1")
+	vpcService.Use(func(http.RoundTripper) http.RoundTripper {
+		return roundTripper(func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("not sent")
+		})
+	})
+	vpcv1otel.NewTracer(provider).Install(vpcService)
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.NotNil(t, err)
+
+	operation := recorder.Ended()[1]
+	assert.Equal(t, "eu-de", spanAttributes(operation)[vpcv1otel.AttributeRegion].AsString())
+	assert.Equal(t, codes.Error, operation.Status().Code)
+}
+
+func TestTracerUninstalled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{}`)
+	}))
+	defer server.Close()
+
+	provider, recorder := newProvider()
+	vpcService := newService(t, server.URL)
+	vpcv1otel.NewTracer(provider).Install(vpcService)
+	vpcService.SetRequestTracer(nil)
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	assert.Empty(t, recorder.Ended())
+}
+
+// roundTripper is an http.RoundTripper function.
+type roundTripper func(req *http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
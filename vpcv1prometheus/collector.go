@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcv1prometheus exposes the usage of VpcV1 clients as Prometheus metrics.
+//
+// A Collector is installed on any number of clients and registered on a registry:
+//
+//	collector := vpcv1prometheus.NewCollector(nil)
+//	prometheus.MustRegister(collector)
+//	collector.Install(vpcService)
+//
+// It exports, labeled with the operation IDs of the requests (for example "GetInstance"):
+//
+//   - <namespace>_requests_total, the number of operations by status code ("error" for the
+//     operations that got no response);
+//   - <namespace>_request_duration_seconds, the duration of the operations, including retries;
+//   - <namespace>_retries_total, the number of retried attempts, including the automatic retries
+//     enabled with EnableRetries;
+//   - <namespace>_rate_limited_total, the number of responses throttled by the service;
+//   - <namespace>_rate_limit_wait_seconds, the time the attempts held back by the client-side rate
+//     limiter waited.
+package vpcv1prometheus
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DefaultNamespace is the prefix of the metric names when CollectorOptions.Namespace is not set.
+const DefaultNamespace = "vpc_sdk"
+
+// The labels of the metrics.
+const (
+	LabelOperation = "operation"
+	LabelCode      = "code"
+)
+
+// CodeError is the code label of the operations that got no response.
+const CodeError = "error"
+
+// CollectorOptions : The options of NewCollector
+type CollectorOptions struct {
+	// The prefix of the metric names (default: DefaultNamespace).
+	Namespace string
+
+	// The upper bounds of the buckets of the duration histogram, in seconds
+	// (default: prometheus.DefBuckets).
+	Buckets []float64
+
+	// Labels added to all metrics, for example to tell apart the clients of several accounts.
+	ConstLabels prometheus.Labels
+}
+
+// Collector : A prometheus.Collector of the usage of VpcV1 clients
+// It implements vpcv1.MetricsRecorder.
+type Collector struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	rateLimited   *prometheus.CounterVec
+	rateLimitWait *prometheus.HistogramVec
+}
+
+var _ prometheus.Collector = (*Collector)(nil)
+var _ vpcv1.MetricsRecorder = (*Collector)(nil)
+
+// NewCollector : Instantiate Collector
+// "options" may be nil.
+func NewCollector(options *CollectorOptions) *Collector {
+	if options == nil {
+		options = &CollectorOptions{}
+	}
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	buckets := options.Buckets
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "requests_total",
+			Help:        "Number of VPC API operations, by operation and status code.",
+			ConstLabels: options.ConstLabels,
+		}, []string{LabelOperation, LabelCode}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "request_duration_seconds",
+			Help:        "Duration of VPC API operations, including retries, by operation.",
+			Buckets:     buckets,
+			ConstLabels: options.ConstLabels,
+		}, []string{LabelOperation}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "retries_total",
+			Help:        "Number of retried VPC API requests, by operation.",
+			ConstLabels: options.ConstLabels,
+		}, []string{LabelOperation}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Name:        "rate_limited_total",
+			Help:        "Number of VPC API responses throttled by the service, by operation.",
+			ConstLabels: options.ConstLabels,
+		}, []string{LabelOperation}),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Name:        "rate_limit_wait_seconds",
+			Help:        "Time VPC API requests waited for the client-side rate limiter, by operation.",
+			Buckets:     buckets,
+			ConstLabels: options.ConstLabels,
+		}, []string{LabelOperation}),
+	}
+}
+
+// Install : Report the requests sent by "vpc" to the collector
+func (collector *Collector) Install(vpc *vpcv1.VpcV1) {
+	vpc.SetMetricsRecorder(collector)
+}
+
+// Describe implements prometheus.Collector.
+func (collector *Collector) Describe(descs chan<- *prometheus.Desc) {
+	collector.requests.Describe(descs)
+	collector.duration.Describe(descs)
+	collector.retries.Describe(descs)
+	collector.rateLimited.Describe(descs)
+	collector.rateLimitWait.Describe(descs)
+}
+
+// Collect implements prometheus.Collector.
+func (collector *Collector) Collect(metrics chan<- prometheus.Metric) {
+	collector.requests.Collect(metrics)
+	collector.duration.Collect(metrics)
+	collector.retries.Collect(metrics)
+	collector.rateLimited.Collect(metrics)
+	collector.rateLimitWait.Collect(metrics)
+}
+
+// ObserveOperation implements vpcv1.MetricsRecorder.
+func (collector *Collector) ObserveOperation(operationID string, statusCode int, err error, duration time.Duration) {
+	code := CodeError
+	if statusCode != 0 {
+		code = strconv.Itoa(statusCode)
+	}
+	collector.requests.WithLabelValues(operationID, code).Inc()
+	collector.duration.WithLabelValues(operationID).Observe(duration.Seconds())
+}
+
+// ObserveRetry implements vpcv1.MetricsRecorder.
+func (collector *Collector) ObserveRetry(operationID string) {
+	collector.retries.WithLabelValues(operationID).Inc()
+}
+
+// ObserveRateLimited implements vpcv1.MetricsRecorder.
+func (collector *Collector) ObserveRateLimited(operationID string) {
+	collector.rateLimited.WithLabelValues(operationID).Inc()
+}
+
+// ObserveRateLimitWait implements vpcv1.MetricsRecorder.
+func (collector *Collector) ObserveRateLimitWait(operationID string, wait time.Duration) {
+	collector.rateLimitWait.WithLabelValues(operationID).Observe(wait.Seconds())
+}
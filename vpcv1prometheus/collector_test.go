@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1prometheus_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1prometheus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newService(t *testing.T, url string) *vpcv1.VpcV1 {
+	vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return vpcService
+}
+
+func TestCollector(t *testing.T) {
+	// The first instance request is throttled, the second fails, and the third succeeds.
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if strings.HasPrefix(req.URL.Path, "/volumes/") {
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "Volume not found"}]}`)
+			return
+		}
+		switch atomic.AddInt32(&count, 1) {
+		case 1:
+			res.WriteHeader(429)
+			fmt.Fprint(res, `{"errors": [{"code": "too_many_requests"}]}`)
+		case 2:
+			res.WriteHeader(503)
+			fmt.Fprint(res, `{"errors": [{"code": "service_unavailable"}]}`)
+		default:
+			fmt.Fprint(res, `{"id": "i-1"}`)
+		}
+	}))
+	defer server.Close()
+
+	collector := vpcv1prometheus.NewCollector(&vpcv1prometheus.CollectorOptions{
+		ConstLabels: prometheus.Labels{"account": "test"},
+	})
+	registry := prometheus.NewPedanticRegistry()
+	require.Nil(t, registry.Register(collector))
+
+	vpcService := newService(t, server.URL)
+	vpcService.SetRetryPolicy(vpcv1.NewRetryPolicy().SetMinRetryInterval(time.Millisecond).SetMaxRetryInterval(time.Millisecond))
+	collector.Install(vpcService)
+	assert.Equal(t, collector, vpcService.GetMetricsRecorder())
+
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	_, _, err = vpcService.GetVolume(vpcService.NewGetVolumeOptions("v-1"))
+	require.NotNil(t, err)
+
+	// An operation without a response.
+	closed := newService(t, "http://127.0.0.1:1")
+	collector.Install(closed)
+	_, _, err = closed.GetInstance(closed.NewGetInstanceOptions("i-1"))
+	require.NotNil(t, err)
+
+	expected := `
+# HELP vpc_sdk_rate_limited_total Number of VPC API responses throttled by the service, by operation.
+# TYPE vpc_sdk_rate_limited_total counter
+vpc_sdk_rate_limited_total{account="test",operation="GetInstance"} 1
+# HELP vpc_sdk_requests_total Number of VPC API operations, by operation and status code.
+# TYPE vpc_sdk_requests_total counter
+vpc_sdk_requests_total{account="test",code="200",operation="GetInstance"} 1
+vpc_sdk_requests_total{account="test",code="404",operation="GetVolume"} 1
+vpc_sdk_requests_total{account="test",code="error",operation="GetInstance"} 1
+# HELP vpc_sdk_retries_total Number of retried VPC API requests, by operation.
+# TYPE vpc_sdk_retries_total counter
+vpc_sdk_retries_total{account="test",operation="GetInstance"} 2
+`
+	assert.Nil(t, testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"vpc_sdk_rate_limited_total", "vpc_sdk_requests_total", "vpc_sdk_retries_total"))
+	assert.Equal(t, 2, testutil.CollectAndCount(collector, "vpc_sdk_request_duration_seconds"))
+}
+
+func TestCollectorNamespace(t *testing.T) {
+	collector := vpcv1prometheus.NewCollector(&vpcv1prometheus.CollectorOptions{Namespace: "controller"})
+	collector.ObserveRetry("ListInstances")
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "controller_retries_total"))
+
+	vpcService := newService(t, "http://127.0.0.1:1")
+	collector.Install(vpcService)
+	vpcService.SetMetricsRecorder(nil)
+	assert.Nil(t, vpcService.GetMetricsRecorder())
+}
+
+func TestCollectorAutomaticRetries(t *testing.T) {
+	// The first attempt fails, and the automatic retry succeeds.
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&count, 1) == 1 {
+			res.WriteHeader(503)
+			fmt.Fprint(res, `{"errors": [{"code": "service_unavailable"}]}`)
+			return
+		}
+		fmt.Fprint(res, `{"id": "i-1"}`)
+	}))
+	defer server.Close()
+
+	collector := vpcv1prometheus.NewCollector(nil)
+	vpcService := newService(t, server.URL)
+	collector.Install(vpcService)
+	vpcService.EnableRetries(2, time.Millisecond)
+
+	_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&count))
+
+	expected := `
+# HELP vpc_sdk_requests_total Number of VPC API operations, by operation and status code.
+# TYPE vpc_sdk_requests_total counter
+vpc_sdk_requests_total{code="200",operation="GetInstance"} 1
+# HELP vpc_sdk_retries_total Number of retried VPC API requests, by operation.
+# TYPE vpc_sdk_retries_total counter
+vpc_sdk_retries_total{operation="GetInstance"} 1
+`
+	assert.Nil(t, testutil.CollectAndCompare(collector, strings.NewReader(expected),
+		"vpc_sdk_requests_total", "vpc_sdk_retries_total"))
+}
+
+func TestCollectorRateLimitWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"id": "i-1"}`)
+	}))
+	defer server.Close()
+
+	collector := vpcv1prometheus.NewCollector(nil)
+	vpcService := newService(t, server.URL)
+	collector.Install(vpcService)
+	vpcService.SetRateLimiter(vpcv1.NewRateLimiter(100, 1))
+
+	// The second request waits for the bucket to be refilled.
+	for range 2 {
+		_, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 1, testutil.CollectAndCount(collector, "vpc_sdk_rate_limit_wait_seconds"))
+	assert.Equal(t, 0, testutil.CollectAndCount(collector, "vpc_sdk_rate_limited_total"))
+}
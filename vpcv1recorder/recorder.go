@@ -0,0 +1,385 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package vpcv1recorder provides an HTTP transport that records the requests sent by a VpcV1
+// instance, and their responses, to a cassette file, and that can later replay the cassette
+// without network access. This lets integration flows run deterministically and offline:
+//
+//	recorder, err := vpcv1recorder.New("testdata/create_instance.json", vpcv1recorder.ModeAuto)
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer recorder.Stop()
+//	recorder.Install(vpcService)
+//
+// The sensitive headers and properties of vpcv1 (vpcv1.DefaultSensitiveHeaders and
+// vpcv1.DefaultSensitiveProperties), such as credentials, tokens and user data, and the request
+// IDs are redacted before they are written to a cassette, as a vpcv1.RequestLogger redacts them
+// (see RedactHeaders and RedactFields). A
+// replayed request matches a recorded one with the same method, path, query and body, where JSON
+// bodies are compared after normalization and redaction.
+//
+// Only the requests sent by the service go through the Recorder: the requests of its authenticator,
+// such as the token requests of a core.IamAuthenticator to iam.cloud.ibm.com, are neither recorded
+// nor replayed, and would still need network access when replaying. Services under test should
+// therefore use a core.NoAuthAuthenticator or a core.BearerTokenAuthenticator, whose Authorization
+// header is redacted.
+package vpcv1recorder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// Mode : How a Recorder serves requests.
+type Mode int
+
+const (
+	// ModeRecord sends every request and records it, replacing the cassette when stopped.
+	ModeRecord Mode = iota
+
+	// ModeReplay serves every request from the cassette, and never sends one.
+	ModeReplay
+
+	// ModeAuto replays the cassette if it exists, and records it otherwise.
+	ModeAuto
+)
+
+// Redacted is the value that replaces redacted headers and fields.
+const Redacted = vpcv1.RedactedValue
+
+// requestIDHeaders and requestIDFields are the headers and JSON properties holding the IDs of a
+// request, which a Recorder redacts, in addition to the sensitive ones, so that the cassettes do
+// not change each time they are recorded.
+var (
+	requestIDHeaders = []string{"X-Correlation-Id", "X-Request-Id", "Transaction-Id"}
+	requestIDFields  = []string{"trace"}
+)
+
+// ErrInteractionNotFound is returned, wrapped, for a request that the cassette being replayed does
+// not contain.
+var ErrInteractionNotFound = errors.New("vpcv1recorder: no recorded interaction matches the request")
+
+// Cassette : The interactions recorded by a Recorder, as stored in a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction : A recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest : A redacted request.
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+// RecordedResponse : A redacted response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Recorder : An http.RoundTripper that records or replays a cassette.
+// A Recorder is safe for concurrent use.
+type Recorder struct {
+	path      string
+	recording bool
+
+	// The transport that sends the requests being recorded.
+	next http.RoundTripper
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	used     []bool
+
+	// The headers and properties redacted, in the way they are redacted from the logs.
+	redactor *vpcv1.RequestLogger
+}
+
+// New : Instantiate a Recorder for the cassette file at "path"
+// In ModeReplay the cassette must exist; in ModeAuto it is replayed if it exists.
+func New(path string, mode Mode) (*Recorder, error) {
+	recorder := &Recorder{
+		path:     path,
+		next:     http.DefaultTransport,
+		cassette: &Cassette{},
+		redactor: vpcv1.NewRequestLogger(nil).RedactHeaders(requestIDHeaders...).RedactProperties(requestIDFields...),
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case mode == ModeRecord || (mode == ModeAuto && errors.Is(err, os.ErrNotExist)):
+		recorder.recording = true
+		return recorder, nil
+	case err != nil:
+		return nil, fmt.Errorf("vpcv1recorder: reading cassette: %w", err)
+	}
+
+	if err = json.Unmarshal(data, recorder.cassette); err != nil {
+		return nil, fmt.Errorf("vpcv1recorder: parsing cassette %s: %w", path, err)
+	}
+	recorder.used = make([]bool, len(recorder.cassette.Interactions))
+	return recorder, nil
+}
+
+// RedactHeaders : Redact the specified headers, in addition to vpcv1.DefaultSensitiveHeaders and
+// the request IDs
+func (recorder *Recorder) RedactHeaders(names ...string) *Recorder {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.redactor.RedactHeaders(names...)
+	return recorder
+}
+
+// RedactFields : Redact the specified body properties, in addition to
+// vpcv1.DefaultSensitiveProperties and the request IDs
+func (recorder *Recorder) RedactFields(names ...string) *Recorder {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.redactor.RedactProperties(names...)
+	return recorder
+}
+
+// SetTransport : Set the transport that sends the requests being recorded by RoundTrip
+// Defaults to http.DefaultTransport. The requests of the services that the Recorder is installed
+// on are sent with the transport of the service instead.
+func (recorder *Recorder) SetTransport(next http.RoundTripper) *Recorder {
+	recorder.next = next
+	return recorder
+}
+
+// IsRecording : Return true if the Recorder records rather than replays
+func (recorder *Recorder) IsRecording() bool {
+	return recorder.recording
+}
+
+// Install : Have "service" send its requests through the Recorder
+// The Recorder is added to the middlewares of the service (see vpcv1.VpcV1.Use), so that the
+// client-side policies of the service, such as its retry policy or rate limiter, apply on top of
+// it, and the requests being recorded are sent with the transport of the service. The middlewares
+// added afterwards are between the Recorder and the network, and do not see the replayed requests.
+// The requests of the authenticator of the service are not sent through the Recorder, so the
+// service should use a core.NoAuthAuthenticator or a core.BearerTokenAuthenticator.
+func (recorder *Recorder) Install(service *vpcv1.VpcV1) {
+	service.Use(func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			return recorder.roundTrip(next, req)
+		})
+	})
+}
+
+// roundTripperFunc is an adapter to allow the use of ordinary functions as http.RoundTrippers.
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Stop : Write the recorded cassette
+// Does nothing when replaying.
+func (recorder *Recorder) Stop() error {
+	if !recorder.recording {
+		return nil
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	data, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("vpcv1recorder: encoding cassette: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(recorder.path), 0o755); err != nil {
+		return fmt.Errorf("vpcv1recorder: writing cassette: %w", err)
+	}
+	if err = os.WriteFile(recorder.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("vpcv1recorder: writing cassette: %w", err)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (recorder *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	return recorder.roundTrip(recorder.next, req)
+}
+
+// roundTrip records "req", sent with "next", or replays it.
+func (recorder *Recorder) roundTrip(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if recorder.recording {
+		return recorder.record(next, req, body)
+	}
+	return recorder.replay(req, body)
+}
+
+// record sends "req", whose body has been read as "body", with "next", and records it with its
+// response.
+func (recorder *Recorder) record(next http.RoundTripper, req *http.Request, body []byte) (*http.Response, error) {
+	sent := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		sent.Body = io.NopCloser(bytes.NewReader(body))
+		sent.Header.Del("Content-Encoding")
+	}
+	resp, err := next.RoundTrip(sent)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	responseHeaders := recorder.redactHeaders(resp.Header)
+	// The replayed body is normalized, so its length can differ.
+	responseHeaders.Del("Content-Length")
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, &Interaction{
+		Request: RecordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: recorder.redactHeaders(sent.Header),
+			Body:    recorder.normalizeBody(body, req.Header.Get("Content-Type")),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    responseHeaders,
+			Body:       recorder.normalizeBody(responseBody, resp.Header.Get("Content-Type")),
+		},
+	})
+	return resp, nil
+}
+
+// replay returns the response of the first unused recorded interaction that matches "req".
+func (recorder *Recorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	normalized := recorder.normalizeBody(body, req.Header.Get("Content-Type"))
+	for i, interaction := range recorder.cassette.Interactions {
+		if recorder.used[i] || !matches(req, normalized, &interaction.Request) {
+			continue
+		}
+		recorder.used[i] = true
+
+		recorded := interaction.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Headers.Clone(),
+			Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+			ContentLength: int64(len(recorded.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL.RequestURI())
+}
+
+// matches returns true if "req", with the normalized body "body", matches "recorded".
+func matches(req *http.Request, body string, recorded *RecordedRequest) bool {
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	return req.Method == recorded.Method &&
+		req.URL.Path == recordedURL.Path &&
+		req.URL.Query().Encode() == recordedURL.Query().Encode() &&
+		body == recorded.Body
+}
+
+// readRequestBody reads and closes the body of "req", and returns it decompressed.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil || req.Header.Get("Content-Encoding") != "gzip" {
+		return body, err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
+
+// redactHeaders returns a copy of "headers" with the redacted headers replaced.
+func (recorder *Recorder) redactHeaders(headers http.Header) http.Header {
+	redacted := headers.Clone()
+	for _, name := range recorder.redactor.SensitiveHeaders {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, Redacted)
+		}
+	}
+	return redacted
+}
+
+// normalizeBody returns "body" redacted and, if it is JSON, in a canonical form.
+func (recorder *Recorder) normalizeBody(body []byte, contentType string) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		if form, err := url.ParseQuery(string(body)); err == nil {
+			for name := range recorder.redactor.SensitiveProperties {
+				if form.Has(name) {
+					form.Set(name, Redacted)
+				}
+			}
+			return form.Encode()
+		}
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if decoder.Decode(&value) != nil {
+		return string(body)
+	}
+	normalized, err := json.Marshal(recorder.redactor.RedactJSON(value))
+	if err != nil {
+		return string(body)
+	}
+	return string(normalized)
+}
@@ -0,0 +1,213 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vpcv1recorder_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1recorder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newService(t *testing.T, url string) *vpcv1.VpcV1 {
+	vpcService, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+		URL:           url,
+		Authenticator: &core.BearerTokenAuthenticator{BearerToken: "secret-token"},
+	})
+	require.Nil(t, err)
+	return vpcService
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("X-Request-Id", "live-request-id")
+		if req.Method == http.MethodPost {
+			res.WriteHeader(201)
+			fmt.Fprint(res, `{"id": "i-2", "user_data": "#cloud-config"}`)
+			return
+		}
+		fmt.Fprintf(res, `{"id": "%s", "name": "my-instance"}`, strings.TrimPrefix(req.URL.Path, "/instances/"))
+	}))
+	path := filepath.Join(t.TempDir(), "cassettes", "instances.json")
+
+	// Record.
+	recorder, err := vpcv1recorder.New(path, vpcv1recorder.ModeAuto)
+	require.Nil(t, err)
+	assert.True(t, recorder.IsRecording())
+	vpcService := newService(t, server.URL)
+	recorder.Install(vpcService)
+
+	instance, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	assert.Equal(t, "my-instance", *instance.Name)
+
+	client := &http.Client{Transport: recorder}
+	res, err := client.Post(server.URL+"/instances?version=2026-10-01", "application/json",
+		strings.NewReader(`{"name": "other", "user_data": "#cloud-config\npassword: hunter2"}`))
+	require.Nil(t, err)
+	res.Body.Close()
+	require.Nil(t, recorder.Stop())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	cassette := string(data)
+	assert.NotContains(t, cassette, "secret-token")
+	assert.NotContains(t, cassette, "live-request-id")
+	assert.NotContains(t, cassette, "hunter2")
+	assert.NotContains(t, cassette, "#cloud-config")
+	assert.Contains(t, cassette, vpcv1recorder.Redacted)
+
+	// Replay, with the server gone.
+	recorder, err = vpcv1recorder.New(path, vpcv1recorder.ModeAuto)
+	require.Nil(t, err)
+	assert.False(t, recorder.IsRecording())
+	vpcService = newService(t, server.URL)
+	recorder.Install(vpcService)
+
+	instance, response, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, "my-instance", *instance.Name)
+
+	// Bodies match regardless of property order and redacted values.
+	client = &http.Client{Transport: recorder}
+	res, err = client.Post(server.URL+"/instances?version=2026-10-01", "application/json",
+		strings.NewReader(`{"user_data": "different", "name": "other"}`))
+	require.Nil(t, err)
+	assert.Equal(t, 201, res.StatusCode)
+	res.Body.Close()
+
+	// Each interaction is replayed once.
+	_, _, err = vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	assert.True(t, errors.Is(err, vpcv1recorder.ErrInteractionNotFound))
+}
+
+func TestInstallKeepsRetryPolicy(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		requests++
+		res.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			res.WriteHeader(503)
+			fmt.Fprint(res, `{"errors": [{"code": "service_unavailable"}]}`)
+			return
+		}
+		fmt.Fprint(res, `{"id": "i-1", "name": "my-instance"}`)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "retried.json")
+
+	recorder, err := vpcv1recorder.New(path, vpcv1recorder.ModeRecord)
+	require.Nil(t, err)
+	vpcService := newService(t, server.URL)
+	vpcService.SetRetryPolicy(&vpcv1.RetryPolicy{MaxRetries: 1, MinRetryInterval: time.Millisecond})
+	recorder.Install(vpcService)
+
+	instance, _, err := vpcService.GetInstance(vpcService.NewGetInstanceOptions("i-1"))
+	require.Nil(t, err)
+	assert.Equal(t, "my-instance", *instance.Name)
+	assert.Equal(t, 1, vpcService.GetRetryPolicy().MaxRetries)
+	require.Nil(t, recorder.Stop())
+
+	// Each attempt is recorded.
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	cassette := &vpcv1recorder.Cassette{}
+	require.Nil(t, json.Unmarshal(data, cassette))
+	assert.Len(t, cassette.Interactions, 2)
+}
+
+func TestReplayRequiresCassette(t *testing.T) {
+	_, err := vpcv1recorder.New(filepath.Join(t.TempDir(), "missing.json"), vpcv1recorder.ModeReplay)
+	assert.NotNil(t, err)
+}
+
+func TestRedactCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.Header().Set("X-Tenant", "tenant-42")
+		fmt.Fprint(res, `{"id": "v-1", "name": "payroll-db"}`)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "volume.json")
+
+	recorder, err := vpcv1recorder.New(path, vpcv1recorder.ModeRecord)
+	require.Nil(t, err)
+	recorder.RedactFields("name").RedactHeaders("X-Tenant")
+	vpcService := newService(t, server.URL)
+	recorder.Install(vpcService)
+
+	volume, _, err := vpcService.GetVolume(vpcService.NewGetVolumeOptions("v-1"))
+	require.Nil(t, err)
+	assert.Equal(t, "payroll-db", *volume.Name)
+	require.Nil(t, recorder.Stop())
+
+	data, err := os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "payroll-db")
+	assert.NotContains(t, string(data), "tenant-42")
+}
+
+func TestRedactSensitiveProperties(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(res, `{"id": "i-1"}`)
+	}))
+	defer server.Close()
+	path := filepath.Join(t.TempDir(), "sensitive.json")
+
+	recorder, err := vpcv1recorder.New(path, vpcv1recorder.ModeRecord)
+	require.Nil(t, err)
+	request, err := http.NewRequest(http.MethodPost, server.URL+"/instances", nil)
+	require.Nil(t, err)
+	body := make(map[string]interface{})
+	for _, name := range vpcv1.DefaultSensitiveProperties {
+		body[name] = "secret-" + name
+	}
+	for _, name := range vpcv1.DefaultSensitiveHeaders {
+		request.Header.Set(name, "secret-"+name)
+	}
+	data, err := json.Marshal(map[string]interface{}{"initialization": body})
+	require.Nil(t, err)
+	request.Body = io.NopCloser(bytes.NewReader(data))
+	request.Header.Set("Content-Type", "application/json")
+
+	res, err := (&http.Client{Transport: recorder}).Do(request)
+	require.Nil(t, err)
+	res.Body.Close()
+	require.Nil(t, recorder.Stop())
+
+	data, err = os.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(data), "secret-")
+}